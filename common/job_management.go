@@ -18,7 +18,9 @@ limitations under the License.
 package common
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -42,44 +44,96 @@ const (
 	Percentage int = 100
 )
 
+// ErrJobTimeout is returned when a job is still running once the timeout is reached.
+var ErrJobTimeout = errors.New("timeout waiting for the job to finish")
+
 // WaitForTaskToFinish waits for a redfish job to finish.
 // Parameters:
 //   - jobURI -> URI for the job to check.
 //   - timeBetweenAttempts -> time to wait between attempts. I.e. 30 means 30 seconds.
 //   - timeout -> maximun time to wait until job is considered failed.
 func WaitForTaskToFinish(service *gofish.Service, jobURI string, timeBetweenAttempts int64, timeout int64) error {
-	// Create tickers
-	attemptTick := time.NewTicker(time.Duration(timeBetweenAttempts) * time.Second)
+	return WaitForTaskToFinishWithContext(context.Background(), service, jobURI, timeBetweenAttempts, timeout)
+}
+
+// WaitForTaskToFinishWithContext waits for a redfish job to finish or for the context to be done.
+// Parameters:
+//   - ctx -> context whose cancellation stops the wait.
+//   - jobURI -> URI for the job to check.
+//   - timeBetweenAttempts -> time to wait between attempts. I.e. 30 means 30 seconds.
+//   - timeout -> maximun time to wait until job is considered failed.
+func WaitForTaskToFinishWithContext(ctx context.Context, service *gofish.Service, jobURI string, timeBetweenAttempts int64, timeout int64) error {
+	return WaitForTaskToFinishWithBackoff(ctx, service, jobURI, timeBetweenAttempts, timeBetweenAttempts, timeout)
+}
+
+// WaitForTaskToFinishWithBackoff waits for a redfish job to finish, doubling the time between two checks
+// after every check up to maxTimeBetweenAttempts, so that long jobs are polled less often.
+// Parameters:
+//   - jobURI -> URI for the job to check.
+//   - timeBetweenAttempts -> time to wait before the first check. I.e. 30 means 30 seconds.
+//   - maxTimeBetweenAttempts -> maximum time to wait between two checks. The interval is fixed when it is not above timeBetweenAttempts.
+//   - timeout -> maximun time to wait until job is considered failed.
+func WaitForTaskToFinishWithBackoff(ctx context.Context, service *gofish.Service, jobURI string,
+	timeBetweenAttempts int64, maxTimeBetweenAttempts int64, timeout int64,
+) error {
+	interval := time.Duration(timeBetweenAttempts) * time.Second
+	maxInterval := time.Duration(maxTimeBetweenAttempts) * time.Second
+	// Create timers
+	attemptTimer := time.NewTimer(interval)
 	timeoutTick := time.NewTicker(time.Duration(timeout) * time.Second)
+	defer attemptTimer.Stop()
+	defer timeoutTick.Stop()
 	// Below 17G device returns location as /redfish/v1/TaskService/Tasks/JOB_ID for same GET call return status as 200 with all the job status.
 	// where as 17G device returns location as /redfish/v1/TaskService/TaskMonitors/JOB_ID for same GET call return no content hence
 	// we are replacing TaskMonitors to Tasks.
 	jobURI = strings.Replace(jobURI, "TaskMonitors", "Tasks", 1)
+	var lastJob *redfish.Task
 	for {
 		select {
-		case <-attemptTick.C:
+		case <-attemptTimer.C:
 			// For some reason iDRAC 4.40.00.0 from time to time gives the following error:
 			// iDRAC is not ready. The configuration values cannot be accessed. Please retry after a few minutes.
 			job, err := redfish.GetTask(service.GetClient(), jobURI)
 			if err == nil {
+				lastJob = job
 				log.Printf("[DEBUG] - Attempting one more time... Job state is %s\n", job.TaskState)
 				// Check if job has finished
 				switch status := job.TaskState; status {
 				case redfish.CompletedTaskState:
 					return nil
 				case redfish.KilledTaskState:
-					return fmt.Errorf(JobErrorWithState, job.TaskState)
+					return taskError(job)
 				case redfish.ExceptionTaskState:
-					return fmt.Errorf(JobErrorWithState, job.TaskState)
+					return taskError(job)
 				}
 			}
+			interval = nextPollInterval(interval, maxInterval)
+			attemptTimer.Reset(interval)
 		case <-timeoutTick.C:
 			log.Printf("[DEBUG] - Error. Timeout reached\n")
-			return fmt.Errorf("timeout waiting for the job to finish")
+			if messages := taskMessages(lastJob); messages != "" {
+				return fmt.Errorf("%w, last reported: %s", ErrJobTimeout, messages)
+			}
+			return ErrJobTimeout
+		case <-ctx.Done():
+			log.Printf("[DEBUG] - Error. Context done while waiting for the job\n")
+			return fmt.Errorf("stopped waiting for the job to finish: %w", ctx.Err())
 		}
 	}
 }
 
+// nextPollInterval doubles the interval, without going above maxInterval. The interval is kept when it
+// already reached maxInterval, so a maxInterval not above the interval means a fixed interval.
+func nextPollInterval(interval, maxInterval time.Duration) time.Duration {
+	if interval >= maxInterval {
+		return interval
+	}
+	if interval*2 > maxInterval {
+		return maxInterval
+	}
+	return interval * 2
+}
+
 // WaitForJobToFinish waits for a redfish job to finish.
 // Parameters:
 //   - jobURI -> URI for the job to check.
@@ -214,6 +268,64 @@ func GetJobAttachment(service *gofish.Service, jobURI string, timeBetweenAttempt
 	}
 }
 
+// WaitForJobAttachmentWithContext waits for a redfish job to finish and returns the job attachment, whatever its format.
+// While the job is running the task itself is returned, so the attachment is the first response that isn't a task.
+// Parameters:
+//   - ctx -> context whose cancellation stops the wait.
+//   - jobURI -> URI for the job to check.
+//   - timeBetweenAttempts -> time to wait between attempts. I.e. 30 means 30 seconds.
+//   - timeout -> maximun time to wait until job is considered failed.
+func WaitForJobAttachmentWithContext(ctx context.Context, service *gofish.Service, jobURI string, timeBetweenAttempts int64, timeout int64) ([]byte, error) {
+	attemptTick := time.NewTicker(time.Duration(timeBetweenAttempts) * time.Second)
+	timeoutTick := time.NewTicker(time.Duration(timeout) * time.Second)
+	defer attemptTick.Stop()
+	defer timeoutTick.Stop()
+	// 17G devices return a TaskMonitors location which has no content, the attachment is served under Tasks
+	jobURI = strings.Replace(jobURI, "TaskMonitors", "Tasks", 1)
+	for {
+		select {
+		case <-attemptTick.C:
+			resp, err := service.GetClient().Get(jobURI)
+			if err != nil {
+				// iDRAC may answer that it is not ready while the job runs
+				log.Printf("[DEBUG] - Attempting one more time... %s\n", err)
+				continue
+			}
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close() // #nosec G104
+			if err != nil {
+				return nil, fmt.Errorf("error reading body: %w", err)
+			}
+			if resp.StatusCode != StatusCodeSuccess {
+				continue
+			}
+			state, isTask := jobAttachmentTaskState(body)
+			if !isTask {
+				return body, nil
+			}
+			log.Printf("[DEBUG] - Attempting one more time... Job state is %s\n", state)
+			if state == redfish.KilledTaskState || state == redfish.ExceptionTaskState {
+				return nil, fmt.Errorf(JobErrorWithState, state)
+			}
+		case <-timeoutTick.C:
+			return nil, fmt.Errorf("job wait timed out after %d minutes", timeout/60)
+		case <-ctx.Done():
+			return nil, fmt.Errorf("stopped waiting for the job to finish: %w", ctx.Err())
+		}
+	}
+}
+
+// jobAttachmentTaskState returns the state of the task when the body is a task rather than the job attachment.
+func jobAttachmentTaskState(body []byte) (redfish.TaskState, bool) {
+	var task struct {
+		TaskState *redfish.TaskState
+	}
+	if err := json.Unmarshal(body, &task); err != nil || task.TaskState == nil {
+		return "", false
+	}
+	return *task.TaskState, true
+}
+
 // OEMJob contains the job details
 type OEMJob struct {
 	JobState       string `json:"JobState"`
@@ -260,14 +372,14 @@ func WaitForDellJobToFinish(service *gofish.Service, jobURI string, timeBetweenA
 							return err
 						}
 						if oemJob.Dell.JobState == "Failed" {
-							return fmt.Errorf("job failed with message: %s", oemJob.Dell.Message)
+							return fmt.Errorf("job failed with message: %s", taskMessages(job))
 						}
 					}
 					return nil
 				case redfish.KilledTaskState:
-					return fmt.Errorf(JobErrorWithState, job.TaskState)
+					return taskError(job)
 				case redfish.ExceptionTaskState:
-					return fmt.Errorf(JobErrorWithState, job.TaskState)
+					return taskError(job)
 				}
 			}
 		case <-timeoutTick.C:
@@ -276,6 +388,41 @@ func WaitForDellJobToFinish(service *gofish.Service, jobURI string, timeBetweenA
 	}
 }
 
+// taskError returns the error of a task that finished unsuccessfully, with the messages it reported.
+func taskError(task *redfish.Task) error {
+	if messages := taskMessages(task); messages != "" {
+		return fmt.Errorf(JobErrorWithState+": %s", task.TaskState, messages)
+	}
+	return fmt.Errorf(JobErrorWithState, task.TaskState)
+}
+
+// taskMessages joins the messages reported by the task and by the Dell job behind it, if any.
+func taskMessages(task *redfish.Task) string {
+	if task == nil {
+		return ""
+	}
+	messages := []string{}
+	seen := map[string]bool{}
+	add := func(messageID, message string) {
+		if message == "" || seen[messageID+message] {
+			return
+		}
+		seen[messageID+message] = true
+		if messageID != "" {
+			message = fmt.Sprintf("%s (%s)", message, messageID)
+		}
+		messages = append(messages, message)
+	}
+	for _, message := range task.Messages {
+		add(message.MessageID, message.Message)
+	}
+	var oemJob DellJob
+	if len(task.Oem) > 0 && json.Unmarshal(task.Oem, &oemJob) == nil {
+		add(oemJob.Dell.MessageId, oemJob.Dell.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
 // DeleteDellJob is intended to delete a task schedules in a Dell system.
 // This function is only a workaround until HTTP DELETE is supported under each task o taskmonitor
 //
@@ -293,3 +440,87 @@ func DeleteDellJob(service *gofish.Service, taskID string) error {
 	}
 	return nil
 }
+
+// dellJobPendingStates are the states of the Dell jobs that are queued but not started yet
+var dellJobPendingStates = map[string]bool{
+	"New":               true,
+	"Scheduling":        true,
+	"Scheduled":         true,
+	"ReadyForExecution": true,
+	"Waiting":           true,
+}
+
+// DellJobQueueEntry contains the details of a job of the Dell job queue
+type DellJobQueueEntry struct {
+	ODataID           string `json:"@odata.id"`
+	ID                string `json:"Id"`
+	Name              string `json:"Name"`
+	JobState          string `json:"JobState"`
+	JobType           string `json:"JobType"`
+	Message           string `json:"Message"`
+	PercentComplete   int64  `json:"PercentComplete"`
+	TargetSettingsURI string `json:"TargetSettingsURI"`
+}
+
+// Pending reports whether the job is queued and waits to be started, e.g. on the next server reset
+func (j DellJobQueueEntry) Pending() bool {
+	return dellJobPendingStates[j.JobState]
+}
+
+// GetDellJobQueue returns the jobs of the Dell job queue.
+//
+//	Parameters:
+//	- jobsURI: URI of the Dell job collection of the manager
+func GetDellJobQueue(service *gofish.Service, jobsURI string) ([]DellJobQueueEntry, error) {
+	var collection struct {
+		Members []json.RawMessage
+	}
+	if err := getJSON(service, jobsURI+"?$expand=*($levels=1)", &collection); err != nil {
+		return nil, err
+	}
+	jobs := make([]DellJobQueueEntry, 0, len(collection.Members))
+	for _, member := range collection.Members {
+		var job DellJobQueueEntry
+		if err := json.Unmarshal(member, &job); err != nil {
+			return nil, err
+		}
+		// Services that don't support $expand only return the links to the jobs
+		if job.ID == "" {
+			if err := getJSON(service, job.ODataID, &job); err != nil {
+				return nil, err
+			}
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// getJSON decodes the resource at uri into v
+func getJSON(service *gofish.Service, uri string, v interface{}) error {
+	resp, err := service.GetClient().Get(uri)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// DellJobQueueClearAll is the job ID that deletes all the jobs of the Dell job queue
+const DellJobQueueClearAll = "JID_CLEARALL"
+
+// DeleteDellJobQueue deletes a job of the Dell job queue through the DellJobService.DeleteJobQueue action,
+// or all of them when jobID is DellJobQueueClearAll.
+//
+//	Parameters:
+//	- jobServiceURI: URI of the Dell job service of the manager
+//	- jobID: Id of the job to delete
+func DeleteDellJobQueue(service *gofish.Service, jobServiceURI string, jobID string) error {
+	resp, err := service.GetClient().Post(jobServiceURI+"/Actions/DellJobService.DeleteJobQueue", map[string]interface{}{
+		"JobID": jobID,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2020-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	gofishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+func TestTaskError(t *testing.T) {
+	task := &redfish.Task{
+		TaskState: redfish.ExceptionTaskState,
+		Messages: []gofishcommon.Message{
+			{MessageID: "IDRAC.2.9.STOR023", Message: "The physical disk is in a foreign configuration."},
+		},
+		Oem: json.RawMessage(`{"Dell":{"JobState":"Failed","Message":"The physical disk is in a foreign configuration.","MessageId":"IDRAC.2.9.STOR023"}}`),
+	}
+	err := taskError(task)
+	expected := "the job has finished unsucessfully with a Exception state: " +
+		"The physical disk is in a foreign configuration. (IDRAC.2.9.STOR023)"
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err)
+	}
+
+	task.Messages = nil
+	task.Oem = json.RawMessage(`{"Dell":{"JobState":"Failed","Message":"Unable to create the virtual disk.","MessageId":"STOR018"}}`)
+	if err := taskError(task); !strings.HasSuffix(err.Error(), "Unable to create the virtual disk. (STOR018)") {
+		t.Errorf("expected the Dell job message, got %q", err)
+	}
+
+	task.Oem = nil
+	if err := taskError(task); err.Error() != "the job has finished unsucessfully with a Exception state" {
+		t.Errorf("expected the bare state error, got %q", err)
+	}
+}
+
+func TestNextPollInterval(t *testing.T) {
+	tests := []struct {
+		interval, maxInterval, want time.Duration
+	}{
+		{10 * time.Second, 10 * time.Second, 10 * time.Second},
+		{10 * time.Second, 60 * time.Second, 20 * time.Second},
+		{40 * time.Second, 60 * time.Second, 60 * time.Second},
+		{60 * time.Second, 60 * time.Second, 60 * time.Second},
+		{90 * time.Second, 60 * time.Second, 90 * time.Second},
+	}
+	for _, test := range tests {
+		if got := nextPollInterval(test.interval, test.maxInterval); got != test.want {
+			t.Errorf("nextPollInterval(%s, %s) = %s, want %s", test.interval, test.maxInterval, got, test.want)
+		}
+	}
+}
+
+func TestDellJobQueueEntryPending(t *testing.T) {
+	tests := map[string]bool{
+		"Scheduled":           true,
+		"New":                 true,
+		"Waiting":             true,
+		"Running":             false,
+		"Completed":           false,
+		"Failed":              false,
+		"CompletedWithErrors": false,
+	}
+	for state, expected := range tests {
+		if got := (DellJobQueueEntry{JobState: state}).Pending(); got != expected {
+			t.Errorf("Pending() for %s = %v, expected %v", state, got, expected)
+		}
+	}
+}
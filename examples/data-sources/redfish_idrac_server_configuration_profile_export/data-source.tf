@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2021-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "redfish_idrac_server_configuration_profile_export" "scp" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // Components to export, all of them by default
+  targets = ["BIOS", "RAID"]
+
+  // XML by default
+  export_format = "JSON"
+
+  // Default, Clone or Replace
+  export_use = "Default"
+
+  include_in_export = ["IncludeReadOnly"]
+}
+
+// Back up the profile of every server
+resource "local_sensitive_file" "scp_backup" {
+  for_each = data.redfish_idrac_server_configuration_profile_export.scp
+
+  filename = "${path.module}/${each.key}-scp.json"
+  content  = each.value.content
+}
@@ -30,7 +30,7 @@ data "redfish_storage" "storage" {
     ssl_insecure = each.value.ssl_insecure
   }
 
-  // by default, the data source uses the first system 
+  // by default, the data source uses the only system 
   # system_id = "System.Embedded.1"
 }
 
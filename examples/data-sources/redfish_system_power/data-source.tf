@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2021-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+data "redfish_system_power" "system_power" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // system_id is an optional argument. By default, the data source uses
+  // the first ComputerSystem resource present in the ComputerSystem collection
+  system_id = "System.Embedded.1"
+}
+
+output "system_power" {
+  value     = data.redfish_system_power.system_power
+  sensitive = true
+}
+
+// Servers which are powered on, for example to decide which ones can be rebooted
+output "powered_on_servers" {
+  value = [for key, power in data.redfish_system_power.system_power : key if power.power_state == "On"]
+}
@@ -29,4 +29,13 @@ provider "redfish" {
   # # Map of server BMCs with their alias keys and respective user credentials.
   # # This is required when resource/datasource's `redfish_alias` is not null
   # redfish_servers  = var.rack1
+
+  # # Optional HTTP settings for flaky BMC networks.
+  # # Only read requests are retried, job submissions are sent once.
+  # http_timeout = 60
+  # retry_count  = 3
+  # retry_delay  = 5
+
+  # # Log the redfish requests and responses, without their credentials, with TF_LOG=DEBUG
+  # debug_http = true
 }
\ No newline at end of file
@@ -44,6 +44,6 @@ resource "redfish_bios" "bios" {
   // The maximum amount of time to wait for the bios job to be completed
   bios_job_timeout = "1200"
 
-  // by default, the resource uses the first system
+  // by default, the resource uses the only system
   # system_id = "System.Embedded.1"
 }
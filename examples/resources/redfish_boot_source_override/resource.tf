@@ -52,6 +52,6 @@ resource "redfish_boot_source_override" "boot" {
   # // The maximum amount of time to wait for the bios job to be completed
   boot_source_job_timeout = "1200"
 
-  // by default, the resource uses the first system
+  // by default, the resource uses the only system
   # system_id = "System.Embedded.1"
 }
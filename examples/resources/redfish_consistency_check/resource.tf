@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+
+resource "redfish_consistency_check" "check" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  storage_controller_id = "RAID.Integrated.1-1"
+  volume_name           = "TerraformVol1"
+
+  # The consistency check starts right away, or on the next reset of the server with `OnReset`.
+  apply_time = "Immediate"
+  # reset_type = "ForceRestart"
+
+  # A new consistency check runs whenever one of these values changes, e.g. to scrub the volume monthly.
+  # check_on = {
+  #   month = "2024-06"
+  # }
+}
+
+output "consistency_check_status" {
+  value = { for key, check in redfish_consistency_check.check : key => check.status }
+}
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+
+resource "redfish_controller_key" "key" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  storage_controller_id = "RAID.Integrated.1-1"
+
+  # Local Key Management, the passphrase is stored on the controller.
+  # Changing the key or its ID rekeys the controller.
+  mode   = "LKM"
+  key_id = "key1"
+  key    = "Test123##"
+
+  # Secure Enterprise Key Manager, the key is provided by the key manager the iDRAC is enrolled in.
+  # mode = "SEKM"
+}
+
+# The controller must have a key to create encrypted volumes.
+resource "redfish_storage_volume" "encrypted" {
+  for_each = var.rack1
+
+  redfish_server {
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  storage_controller_id = redfish_controller_key.key[each.key].storage_controller_id
+  volume_name           = "EncryptedVolume"
+  raid_type             = "RAID0"
+  drives                = ["Solid State Disk 0:1:0"]
+  encrypted             = true
+}
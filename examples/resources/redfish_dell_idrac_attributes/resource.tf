@@ -30,6 +30,10 @@ resource "redfish_dell_idrac_attributes" "idrac" {
     ssl_insecure = each.value.ssl_insecure
   }
 
+  // Use "OnReset" to reset the iDRAC once the attributes are set,
+  // for attributes that only take effect after an iDRAC reset
+  settings_apply_time = "Immediate"
+
   // iDRAC attributes to be modified
   attributes = {
     "Users.3.Enable"                         = "Disabled"
@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+
+resource "redfish_hot_spare" "spares" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  storage_controller_id = "RAID.Integrated.1-1"
+
+  // IDs of the drives to assign as global hot spares of the controller.
+  // Drives removed from this list are unassigned, and all of them are unassigned on destroy.
+  drive_ids = ["Disk.Bay.2:Enclosure.Internal.0-1:RAID.Integrated.1-1"]
+
+  // Flag stating when to apply the spare jobs, either "Immediate" or "OnReset"
+  settings_apply_time = "Immediate"
+
+  // Reset parameters to be applied when settings_apply_time is "OnReset"
+  reset_type    = "ForceRestart"
+  reset_timeout = 120
+
+  // The maximum amount of time to wait for each spare job to be completed
+  job_timeout = 1200
+
+  // by default, the resource uses the only system
+  # system_id = "System.Embedded.1"
+}
@@ -0,0 +1,32 @@
+/*
+Copyright (c) 2022-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+terraform {
+  required_providers {
+    redfish = {
+      version = "1.5.0"
+      source  = "registry.terraform.io/dell/redfish"
+    }
+  }
+}
+
+provider "redfish" {
+  # `redfish_servers` is used to align with enhancements to password management.
+  # Map of server BMCs with their alias keys and respective user credentials.
+  # This is required when resource/datasource's `redfish_alias` is not null
+  redfish_servers = var.rack1
+}
\ No newline at end of file
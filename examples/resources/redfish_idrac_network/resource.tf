@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "redfish_idrac_network" "network" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // Static IPv4 addressing
+  dhcp_enabled     = false
+  ipv4_address     = "10.0.0.10"
+  ipv4_subnet_mask = "255.255.255.0"
+  ipv4_gateway     = "10.0.0.1"
+
+  dns_servers = ["10.0.0.2", "10.0.0.3"]
+
+  vlan_enabled = true
+  vlan_id      = 20
+
+  // The iDRAC is reachable at this endpoint once the network change is applied
+  new_endpoint = "https://10.0.0.10"
+}
@@ -160,4 +160,35 @@ resource "redfish_idrac_server_configuration_profile_import" "share_type_http" {
   lifecycle {
     replace_triggered_by = [terraform_data.trigger_by_timestamp]
   }
-}
\ No newline at end of file
+}
+
+# Validate a profile without applying it. The outcome of each attribute is reported in `results`.
+resource "redfish_idrac_server_configuration_profile_import" "preview" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  preview = true
+
+  share_parameters = {
+    filename   = "demo_nfs.xml"
+    target     = ["NIC"]
+    share_type = "NFS"
+    ip_address = "10.0.0.01"
+    share_name = "/dell/terraform-idrac-nfs"
+  }
+
+  lifecycle {
+    replace_triggered_by = [terraform_data.trigger_by_timestamp]
+  }
+}
@@ -32,4 +32,10 @@ resource "redfish_manager_reset" "manager_reset" {
 
   id         = "iDRAC.Embedded.1"
   reset_type = "GracefulRestart"
+
+  # The manager is reset again whenever one of these values changes,
+  # e.g. to restart the iDRAC after its certificate is replaced.
+  # reset_on = {
+  #   certificate = redfish_certificate.cert.id
+  # }
 }
\ No newline at end of file
@@ -41,7 +41,7 @@ resource "redfish_network_adapter" "nic" {
   apply_time = "OnReset"
 
   # Optional system_id for creating
-  # ID of the system resource. If `system_id` is not provided, the first system available from the iDRAC will be used.
+  # ID of the system resource. If `system_id` is not provided, the only system available from the iDRAC will be used.
   system_id = "System.Embedded.1"
 
 
@@ -48,6 +48,10 @@ resource "redfish_power" "system_power" {
 
   desired_power_action = "ForceRestart"
 
+  // Alternatively, desired_power_state keeps the server in the power state reached by the given reset action,
+  // a server found in another power state shows up as drift. Only one of them can be set.
+  # desired_power_state = "On"
+
   // The maximum amount of time to wait for the server to enter the correct power state before
   // giving up in seconds
   maximum_wait_time = 120
@@ -55,7 +59,7 @@ resource "redfish_power" "system_power" {
   // The frequency with which to check the server's power state in seconds
   check_interval = 10
 
-  // by default, the resource uses the first system
+  // by default, the resource uses the only system
   # system_id = "System.Embedded.1"
 }
 
@@ -48,7 +48,9 @@ resource "redfish_simple_update" "update" {
   reset_timeout = 120 // If not set, by default will be 120s
   // The maximum amount of time to wait for the simple update job to be completed
   simple_update_job_timeout = 1200 // If not set, by default will be 1200s
+  // Immediate resets the server now, OnReset only schedules the update for the next reset
+  apply_time = "Immediate" // If not set, by default will be Immediate
 
-  // by default, the resource uses the first system
+  // by default, the resource uses the only system
   # system_id = "System.Embedded.1"
 }
@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "redfish_snmp_trap" "trap" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // Receiver of the SNMP traps
+  destination = "192.168.0.20"
+
+  // Destination slot of the iDRAC, the first free slot when unset
+  # slot = 1
+
+  // Whether the traps are sent to the destination
+  enabled = true
+
+  // The settings below are shared by all the destinations of the iDRAC
+  protocol_version = "SNMPv2"
+  port             = 162
+  community        = "public"
+
+  // SNMPv3 traps are sent as an iDRAC user enabled for SNMPv3
+  # protocol_version = "SNMPv3"
+  # snmp_v3_user     = "snmpuser"
+}
@@ -46,7 +46,7 @@ resource "redfish_storage_controller" "storage_controller_example" {
   apply_time = "Immediate"
 
   # System ID. Optional for creating.
-  # ID of the system resource. If `system_id` is not provided, the first system available from the iDRAC will be used.
+  # ID of the system resource. If `system_id` is not provided, the only system available from the iDRAC will be used.
   # system_id = "System.Embedded.1"
 
   # Optional params for creating and updating
@@ -130,6 +130,9 @@ resource "redfish_storage_controller" "storage_controller_example" {
           # background_initialization_rate_percent = 30
           # Reconstruct Rate.
           # reconstruct_rate_percent = 30
+
+          # Spin down idle time of unconfigured and hot spare drives, this is a controller-wide setting.
+          # spindown_idle_time_seconds = 1800
         }
       }
     }
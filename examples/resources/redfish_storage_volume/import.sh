@@ -19,4 +19,8 @@ terraform import redfish_storage_volume.volume "{\"id\":\"<odata id of the volum
 
 # terraform import with redfish_alias. When using redfish_alias, provider's `redfish_servers` is required.
 # redfish_alias is used to align with enhancements to password management.
-terraform import redfish_storage_volume.volume "{\"id\":\"<odata id of the volume>\",\"redfish_alias\":\"<redfish_alias>\"}"
\ No newline at end of file
+# The endpoint and credentials are then taken from the matching entry of provider's `redfish_servers`.
+terraform import redfish_storage_volume.volume "{\"id\":\"<odata id of the volume>\",\"redfish_alias\":\"<redfish_alias>\"}"
+
+# terraform import using the storage controller and the volume name instead of the odata id of the volume.
+terraform import redfish_storage_volume.volume "{\"storage_controller_id\":\"<storage controller id>\",\"volume_name\":\"<volume name>\",\"username\":\"<username>\",\"password\":\"<password>\",\"endpoint\":\"<endpoint>\",\"ssl_insecure\":<true/false>}"
\ No newline at end of file
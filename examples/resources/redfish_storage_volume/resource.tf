@@ -40,13 +40,31 @@ resource "redfish_storage_volume" "volume" {
   // Sets the Raid level Options (RAID0, RAID1, RAID5, RAID6, RAID10, RAID50, RAID60)
   raid_type = "RAID0"
 
+  // Span layout of RAID10, RAID50 and RAID60 volumes, span_count * span_length must be the number of drives
+  # span_count  = 2
+  # span_length = 2
+
   // Name of the physical disk on which virtual disk should get created.
   drives = ["Physical Disk 0:1:0"]
 
-  // Flag stating when to create virtual disk either "Immediate" or "OnReset"
+  // Alternatively, the physical disks can be selected by their ID.
+  # drive_ids = ["Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1"]
+
+  // Names or IDs of the drives to assign as dedicated hot spares once the volume is created
+  # dedicated_hot_spares = ["Physical Disk 0:1:1"]
+
+  // Flag stating when to create virtual disk either "Immediate", "OnReset", "AtMaintenanceWindowStart" or "InMaintenanceWindowOnReset"
   // For BOSS Drives this should be set to "OnReset" as reboot is needed for the virtual disk to be created
   settings_apply_time = "Immediate"
 
+  // Required when settings_apply_time is "AtMaintenanceWindowStart" or "InMaintenanceWindowOnReset"
+  # maintenance_window = {
+  #   // Format is YYYY-MM-DDThh:mm:ss<offset>, <offset> being the offset from UTC of the iDRAC timezone
+  #   start_time = "2024-10-15T22:45:00-05:00"
+  #   // Duration in seconds of the maintenance window
+  #   duration = 600
+  # }
+
   // Reset parameters to be applied when upgrade is completed
   reset_type = "PowerCycle"
 
@@ -55,6 +73,12 @@ resource "redfish_storage_volume" "volume" {
 
   volume_job_timeout = 1200
 
+  // The interval in seconds between two checks of the volume job status
+  job_check_interval = 10
+
+  // The delay in seconds before rebooting the server when settings_apply_time is OnReset
+  pre_reboot_delay = 30
+
   // When creating on volumes on BOSS Controllers or with the encrypt field true this property is invalid. 
   //capacity_bytes        = 1073323222
 
@@ -71,12 +95,34 @@ resource "redfish_storage_volume" "volume" {
   // Possible values are "Disabled", "Enabled"
   disk_cache_policy = "Disabled"
 
+  // Initialization of the new volume: "None", "Fast" (background) or "Full" (foreground)
+  // When unset, the controller's default is used
+  # initialization = "Fast"
+
   // Whether or not to encrypt the virtual disk, default to false
   // Once a virtual disk is set to encrypted status it cannot be changed
   // This flag is only supported on firmware levels 6 and above
   encrypted = true
 
-  // by default, the resource uses the first system
+  // Secure erase the member drives once the volume is destroyed, default to false
+  // This is irreversible: all the data on the drives is lost
+  # secure_erase_on_destroy = true
+
+  // Cryptographically erase the member drives once the volume is destroyed, default to false
+  // All the member drives must be self-encrypting drives. Conflicts with secure_erase_on_destroy
+  # cryptographic_erase_on_destroy = true
+
+  // Create an SSD caching (CacheCade) volume, default to false. All the drives must be SSDs
+  # is_cache = true
+
+  // Adopt the volume of the same name if it already exists and matches this configuration, default to false
+  # adopt_existing = true
+
+  // Keep the volume and its data on the controller when the resource is destroyed, default to true
+  // Conflicts with secure_erase_on_destroy and cryptographic_erase_on_destroy
+  # delete_volume_on_destroy = false
+
+  // by default, the resource uses the only system
   # system_id = "System.Embedded.1"
 
   lifecycle {
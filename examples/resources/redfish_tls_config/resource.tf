@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "redfish_tls_config" "tls" {
+  for_each = var.rack1
+
+  redfish_server {
+    # Alias name for server BMCs. The key in provider's `redfish_servers` map
+    # `redfish_alias` is used to align with enhancements to password management.
+    # When using redfish_alias, provider's `redfish_servers` is required.
+    redfish_alias = each.key
+
+    user         = each.value.user
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  // Minimum TLS version accepted by the iDRAC web server
+  min_tls_version = "1.2"
+
+  // Cipher suite policy, i.e. the WebServer.1.SSLEncryptionBitLength attribute
+  cipher_suite_policy = "256-Bit or higher"
+
+  // OpenSSL cipher string, only supported by recent iDRAC firmwares
+  # custom_cipher_string = "ALL:!RC4:!3DES"
+
+  // Time to wait for the iDRAC to come back after the reset applying the settings
+  # reset_timeout = 300
+}
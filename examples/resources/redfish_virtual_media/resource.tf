@@ -45,6 +45,6 @@ resource "redfish_virtual_media" "vm" {
   transfer_protocol_type = "HTTP"
   write_protected        = true
 
-  // by default, the resource uses the first system
+  // by default, the resource uses the only system
   # system_id = "System.Embedded.1"
 }
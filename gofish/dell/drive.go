@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// DriveExtended contains gofish drive as well as Dell OEM data.
+type DriveExtended struct {
+	*redfish.Drive
+	Oem DriveOEM
+}
+
+// DriveOEM contains the OEM data.
+type DriveOEM struct {
+	Dell DriveOEMDell
+}
+
+// DriveOEMDell contains the Dell data.
+type DriveOEMDell struct {
+	DellPhysicalDisk DellPhysicalDisk
+}
+
+// DellPhysicalDisk contains the Dell physical disk data.
+// nolint: revive
+type DellPhysicalDisk struct {
+	Connector             int64
+	DriveFormFactor       string
+	EncryptionProtocol    string
+	FreeSizeInBytes       int64
+	HotSpareStatus        string
+	PowerStatus           string
+	RaidStatus            string
+	Slot                  int64
+	SystemEraseCapability string
+	T10PICapability       string
+	UsedSizeInBytes       int64
+}
+
+// Drive given redfish.Drive, returns dell.DriveExtended.
+// This is a wrapper that parses the Dell OEM data of the drive.
+func Drive(drive *redfish.Drive) (*DriveExtended, error) {
+	driveExtended := &DriveExtended{
+		Drive: drive,
+		Oem:   DriveOEM{},
+	}
+
+	if len(drive.Oem) == 0 {
+		return driveExtended, nil
+	}
+
+	var oemData DriveOEM
+	if err := json.Unmarshal(drive.Oem, &oemData); err != nil {
+		return driveExtended, err
+	}
+	driveExtended.Oem = oemData
+
+	return driveExtended, nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var driveBody = `{
+    "@odata.id": "/redfish/v1/Systems/System.Embedded.1/Storage/RAID.Integrated.1-1/Drives/Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1",
+    "@odata.type": "#Drive.v1_9_0.Drive",
+    "CapacityBytes": 299439751168,
+    "Id": "Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1",
+    "MediaType": "HDD",
+    "Name": "Physical Disk 0:1:0",
+    "SerialNumber": "WFK0LRB4",
+    "Oem": {
+        "Dell": {
+            "@odata.type": "#DellOem.v1_3_0.DellOemResources",
+            "DellPhysicalDisk": {
+                "Connector": 0,
+                "DriveFormFactor": "2.5Inch",
+                "EncryptionProtocol": "None",
+                "FreeSizeInBytes": 0,
+                "HotSpareStatus": "No",
+                "PowerStatus": "Spun-Up",
+                "RaidStatus": "Online",
+                "Slot": 0,
+                "SystemEraseCapability": "CryptographicErasePD",
+                "T10PICapability": "NotCapable",
+                "UsedSizeInBytes": 299439751168
+            }
+        }
+    }
+}`
+
+func TestDellDrive(t *testing.T) {
+	t.Run("Test redfish values", func(t *testing.T) {
+		dellDrive := getDellDrive(t)
+
+		assertField(t, dellDrive.ID, "Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1")
+		assertField(t, dellDrive.Name, "Physical Disk 0:1:0")
+		assertField(t, dellDrive.SerialNumber, "WFK0LRB4")
+	})
+	t.Run("Check Dell values", func(t *testing.T) {
+		dellDrive := getDellDrive(t)
+
+		assertField(t, dellDrive.Oem.Dell.DellPhysicalDisk.RaidStatus, "Online")
+		assertField(t, dellDrive.Oem.Dell.DellPhysicalDisk.HotSpareStatus, "No")
+		assertField(t, dellDrive.Oem.Dell.DellPhysicalDisk.T10PICapability, "NotCapable")
+		assertField(t, dellDrive.Oem.Dell.DellPhysicalDisk.SystemEraseCapability, "CryptographicErasePD")
+		assertInt(t, int(dellDrive.Oem.Dell.DellPhysicalDisk.UsedSizeInBytes), 299439751168)
+	})
+}
+
+func getDellDrive(t testing.TB) *DriveExtended {
+	t.Helper()
+
+	var result redfish.Drive
+
+	err := json.NewDecoder(strings.NewReader(driveBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding drive JSON - %s", err)
+	}
+
+	dellDrive, err := Drive(&result)
+	if err != nil {
+		t.Errorf("Error decoding Dell drive JSON - %s", err)
+	}
+
+	return dellDrive
+}
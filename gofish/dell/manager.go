@@ -249,3 +249,18 @@ func Manager(manager *redfish.Manager) (*ManagerExtended, error) {
 func (m *ManagerExtended) DellAttributes() ([]*Attributes, error) {
 	return ListReferenceDellAttributes(m.GetClient(), m.links.DellAttributes)
 }
+
+// DellLCServiceURI returns the odata id of the Dell Lifecycle Controller service
+func (m *ManagerExtended) DellLCServiceURI() string {
+	return string(m.links.DellLCService)
+}
+
+// JobsURI returns the odata id of the Dell job queue
+func (m *ManagerExtended) JobsURI() string {
+	return string(m.links.Jobs)
+}
+
+// DellJobServiceURI returns the odata id of the Dell job service
+func (m *ManagerExtended) DellJobServiceURI() string {
+	return string(m.links.DellJobService)
+}
@@ -376,7 +376,9 @@ func TestDellManager(t *testing.T) {
 			"/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellAttributes/LifecycleController.Embedded.1",
 		})
 		assertLink(t, dellManager.links.DellJobService, "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellJobService")
+		assertField(t, dellManager.DellJobServiceURI(), "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellJobService")
 		assertLink(t, dellManager.links.DellLCService, "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellLCService")
+		assertField(t, dellManager.DellLCServiceURI(), "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellLCService")
 		assertLink(t, dellManager.links.DellLicensableDeviceCollection, "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellLicensableDevices")
 		assertLink(t, dellManager.links.DellLicenseCollection, "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellLicenses")
 		assertLink(t, dellManager.links.DellLicenseManagementService, "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellLicenseManagementService")
@@ -391,6 +393,7 @@ func TestDellManager(t *testing.T) {
 		assertLink(t, dellManager.links.DelliDRACCardService, "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DelliDRACCardService")
 		assertLink(t, dellManager.links.DellvFlashCollection, "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/DellvFlash")
 		assertLink(t, dellManager.links.Jobs, "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/Jobs")
+		assertField(t, dellManager.JobsURI(), "/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/Jobs")
 	})
 
 	t.Run("Test Dell OEM field", func(t *testing.T) {
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// VolumeExtended contains gofish volume as well as Dell OEM data.
+type VolumeExtended struct {
+	*redfish.Volume
+	Oem VolumeOEM
+}
+
+// VolumeOEM contains the OEM data.
+type VolumeOEM struct {
+	Dell VolumeOEMDell
+}
+
+// VolumeOEMDell contains the Dell data.
+type VolumeOEMDell struct {
+	DellVolume DellVolume
+}
+
+// DellVolume contains the Dell volume data.
+// nolint: revive
+type DellVolume struct {
+	BusProtocol      string
+	Cachecade        string
+	DiskCachePolicy  string
+	LockStatus       string
+	MediaType        string
+	RaidStatus       string
+	ReadCachePolicy  string
+	SpanDepth        int64
+	SpanLength       int64
+	T10PIStatus      string
+	WriteCachePolicy string
+}
+
+// Volume given redfish.Volume, returns dell.VolumeExtended.
+// This is a wrapper that parses the Dell OEM data of the volume.
+func Volume(volume *redfish.Volume) (*VolumeExtended, error) {
+	volumeExtended := &VolumeExtended{
+		Volume: volume,
+		Oem:    VolumeOEM{},
+	}
+
+	if len(volume.OEM) == 0 {
+		return volumeExtended, nil
+	}
+
+	var oemData VolumeOEM
+	if err := json.Unmarshal(volume.OEM, &oemData); err != nil {
+		return volumeExtended, err
+	}
+	volumeExtended.Oem = oemData
+
+	return volumeExtended, nil
+}
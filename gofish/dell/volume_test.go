@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dell
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var volumeBody = `{
+    "@odata.id": "/redfish/v1/Systems/System.Embedded.1/Storage/RAID.Integrated.1-1/Volumes/Disk.Virtual.0:RAID.Integrated.1-1",
+    "@odata.type": "#Volume.v1_6_2.Volume",
+    "CapacityBytes": 299439751168,
+    "Id": "Disk.Virtual.0:RAID.Integrated.1-1",
+    "Name": "TerraformVol1",
+    "RAIDType": "RAID1",
+    "ReadCachePolicy": "Off",
+    "WriteCachePolicy": "WriteThrough",
+    "Oem": {
+        "Dell": {
+            "@odata.type": "#DellOem.v1_3_0.DellOemResources",
+            "DellVolume": {
+                "BusProtocol": "SAS",
+                "DiskCachePolicy": "Disabled",
+                "LockStatus": "Unlocked",
+                "MediaType": "HardDiskDrive",
+                "RaidStatus": "Online",
+                "ReadCachePolicy": "NoReadAhead",
+                "SpanDepth": 1,
+                "SpanLength": 2,
+                "T10PIStatus": "Enabled",
+                "WriteCachePolicy": "WriteThrough"
+            }
+        }
+    }
+}`
+
+func TestDellVolume(t *testing.T) {
+	t.Run("Test redfish values", func(t *testing.T) {
+		dellVolume := getDellVolume(t)
+
+		assertField(t, dellVolume.ID, "Disk.Virtual.0:RAID.Integrated.1-1")
+		assertField(t, string(dellVolume.RAIDType), "RAID1")
+		assertInt(t, dellVolume.CapacityBytes, 299439751168)
+	})
+	t.Run("Check Dell values", func(t *testing.T) {
+		dellVolume := getDellVolume(t)
+
+		assertField(t, dellVolume.Oem.Dell.DellVolume.DiskCachePolicy, "Disabled")
+		assertField(t, dellVolume.Oem.Dell.DellVolume.T10PIStatus, "Enabled")
+		assertField(t, dellVolume.Oem.Dell.DellVolume.LockStatus, "Unlocked")
+		assertInt(t, int(dellVolume.Oem.Dell.DellVolume.SpanLength), 2)
+	})
+}
+
+func getDellVolume(t testing.TB) *VolumeExtended {
+	t.Helper()
+
+	var result redfish.Volume
+
+	err := json.NewDecoder(strings.NewReader(volumeBody)).Decode(&result)
+	if err != nil {
+		t.Errorf("Error decoding volume JSON - %s", err)
+	}
+
+	dellVolume, err := Volume(&result)
+	if err != nil {
+		t.Errorf("Error decoding Dell volume JSON - %s", err)
+	}
+
+	return dellVolume
+}
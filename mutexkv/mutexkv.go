@@ -28,7 +28,7 @@ import (
 // might reace each other.
 type MutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*sync.RWMutex
 }
 
 // Lock the mutex for the given key. The caller is responsible for calling
@@ -47,13 +47,30 @@ func (m *MutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocked %s", key)
 }
 
+// RLock locks the mutex for the given key in shared mode, other RLock callers
+// don't wait while Lock callers do. The caller is responsible for calling
+// RUnlock for the same key
+func (m *MutexKV) RLock(key string) {
+	log.Printf("[DEBUG] Read locking %s", key)
+	m.get(key).RLock()
+	log.Printf("[DEBUG] Read locked %s", key)
+}
+
+// RUnlock unlocks the shared mutex for the given key. Caller must have called
+// RLock for the same key first.
+func (m *MutexKV) RUnlock(key string) {
+	log.Printf("[DEBUG] Read unlocking %s", key)
+	m.get(key).RUnlock()
+	log.Printf("[DEBUG] Read unlocked %s", key)
+}
+
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *MutexKV) get(key string) *sync.Mutex {
+func (m *MutexKV) get(key string) *sync.RWMutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = &sync.RWMutex{}
 		m.store[key] = mutex
 	}
 	return mutex
@@ -62,6 +79,6 @@ func (m *MutexKV) get(key string) *sync.Mutex {
 // NewMutexKV returns a properly initialized MutexKV
 func NewMutexKV() *MutexKV {
 	return &MutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*sync.RWMutex),
 	}
 }
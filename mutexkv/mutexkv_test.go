@@ -20,6 +20,7 @@ package mutexkv
 import (
 	"sync"
 	"testing"
+	"time"
 )
 
 func TestMutexKV(t *testing.T) {
@@ -66,6 +67,33 @@ func TestMutexKV(t *testing.T) {
 	})
 }
 
+func TestMutexKVShared(t *testing.T) {
+	mutex := NewMutexKV()
+	mutex.RLock("test")
+	// A second shared lock doesn't wait for the first one
+	mutex.RLock("test")
+
+	locked := make(chan struct{})
+	go func() {
+		mutex.Lock("test")
+		close(locked)
+		mutex.Unlock("test")
+	}()
+	select {
+	case <-locked:
+		t.Fatal("the exclusive lock was taken while the shared locks were held")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	mutex.RUnlock("test")
+	mutex.RUnlock("test")
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("the exclusive lock was not taken once the shared locks were released")
+	}
+}
+
 func assertSum(t testing.TB, got, want int) {
 	t.Helper()
 	if got != want {
@@ -98,6 +98,19 @@ func InsertMedia(id string, collection []*redfish.VirtualMedia, config redfish.V
 	return nil, err
 }
 
+// SupportsMediaType - Check whether the virtual media accepts the media type, any media type is accepted when none is given
+func SupportsMediaType(virtualMedia *redfish.VirtualMedia, mediaType string) bool {
+	if mediaType == "" {
+		return true
+	}
+	for _, t := range virtualMedia.MediaTypes {
+		if string(t) == mediaType {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdateVirtualMediaState - Copy virtual media details from response to state object
 func UpdateVirtualMediaState(response *redfish.VirtualMedia, plan models.VirtualMedia) models.VirtualMedia {
 	res := models.VirtualMedia{
@@ -112,6 +125,8 @@ func UpdateVirtualMediaState(response *redfish.VirtualMedia, plan models.Virtual
 		RedfishServer:        plan.RedfishServer,
 		UserName:             plan.UserName,
 		Password:             plan.Password,
+		MediaType:            plan.MediaType,
+		ConnectedVia:         types.StringValue(string(response.ConnectedVia)),
 	}
 
 	if response.UserName != "" {
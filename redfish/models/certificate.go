@@ -35,4 +35,7 @@ type RedfishSSLCertificate struct {
 	CertificateType    types.String    `tfsdk:"certificate_type"`
 	Passphrase         types.String    `tfsdk:"passphrase"`
 	SSLCertificateFile types.String    `tfsdk:"ssl_certificate_content"`
+	Subject            types.String    `tfsdk:"subject"`
+	Issuer             types.String    `tfsdk:"issuer"`
+	ValidNotAfter      types.String    `tfsdk:"valid_not_after"`
 }
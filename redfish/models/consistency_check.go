@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ConsistencyCheck is the tfsdk model of the consistency check of a volume
+type ConsistencyCheck struct {
+	ID                  types.String    `tfsdk:"id"`
+	RedfishServer       []RedfishServer `tfsdk:"redfish_server"`
+	SystemID            types.String    `tfsdk:"system_id"`
+	StorageControllerID types.String    `tfsdk:"storage_controller_id"`
+	VolumeName          types.String    `tfsdk:"volume_name"`
+	ApplyTime           types.String    `tfsdk:"apply_time"`
+	ResetType           types.String    `tfsdk:"reset_type"`
+	ResetTimeout        types.Int64     `tfsdk:"reset_timeout"`
+	JobTimeout          types.Int64     `tfsdk:"job_timeout"`
+	CheckOn             types.Map       `tfsdk:"check_on"`
+	JobID               types.String    `tfsdk:"job_id"`
+	Status              types.String    `tfsdk:"status"`
+	Message             types.String    `tfsdk:"message"`
+}
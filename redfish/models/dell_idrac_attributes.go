@@ -27,3 +27,13 @@ type DellIdracAttributes struct {
 	RedfishServer []RedfishServer `tfsdk:"redfish_server"`
 	Attributes    types.Map       `tfsdk:"attributes"`
 }
+
+// DellIdracAttributesResource to construct terraform schema for the idrac attributes resource.
+type DellIdracAttributesResource struct {
+	ID                types.String    `tfsdk:"id"`
+	RedfishServer     []RedfishServer `tfsdk:"redfish_server"`
+	Attributes        types.Map       `tfsdk:"attributes"`
+	SettingsApplyTime types.String    `tfsdk:"settings_apply_time"`
+	ResetType         types.String    `tfsdk:"reset_type"`
+	ResetTimeout      types.Int64     `tfsdk:"reset_timeout"`
+}
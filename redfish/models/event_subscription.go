@@ -0,0 +1,33 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EventSubscription to construct terraform schema for the event subscription resource.
+type EventSubscription struct {
+	ID               types.String    `tfsdk:"id"`
+	RedfishServer    []RedfishServer `tfsdk:"redfish_server"`
+	Destination      types.String    `tfsdk:"destination"`
+	Protocol         types.String    `tfsdk:"protocol"`
+	EventTypes       types.List      `tfsdk:"event_types"`
+	RegistryPrefixes types.List      `tfsdk:"registry_prefixes"`
+	Context          types.String    `tfsdk:"context"`
+}
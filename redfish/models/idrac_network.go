@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IdracNetwork to construct terraform schema for the iDRAC network resource.
+type IdracNetwork struct {
+	ID             types.String    `tfsdk:"id"`
+	RedfishServer  []RedfishServer `tfsdk:"redfish_server"`
+	ManagerID      types.String    `tfsdk:"manager_id"`
+	InterfaceID    types.String    `tfsdk:"interface_id"`
+	DHCPEnabled    types.Bool      `tfsdk:"dhcp_enabled"`
+	IPv4Address    types.String    `tfsdk:"ipv4_address"`
+	IPv4SubnetMask types.String    `tfsdk:"ipv4_subnet_mask"`
+	IPv4Gateway    types.String    `tfsdk:"ipv4_gateway"`
+	DNSServers     types.List      `tfsdk:"dns_servers"`
+	VLANEnabled    types.Bool      `tfsdk:"vlan_enabled"`
+	VLANID         types.Int64     `tfsdk:"vlan_id"`
+	NewEndpoint    types.String    `tfsdk:"new_endpoint"`
+	MACAddress     types.String    `tfsdk:"mac_address"`
+}
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// JobQueueDatasource to construct terraform schema for the job queue data source.
+type JobQueueDatasource struct {
+	ID            types.String    `tfsdk:"id"`
+	RedfishServer []RedfishServer `tfsdk:"redfish_server"`
+	Jobs          []JobQueueEntry `tfsdk:"jobs"`
+}
+
+// JobQueueEntry is a job of the Dell job queue.
+type JobQueueEntry struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	JobState        types.String `tfsdk:"job_state"`
+	JobType         types.String `tfsdk:"job_type"`
+	Message         types.String `tfsdk:"message"`
+	PercentComplete types.Int64  `tfsdk:"percent_complete"`
+}
+
+// ClearJobQueue to construct terraform schema for the clear job queue resource.
+type ClearJobQueue struct {
+	ID            types.String    `tfsdk:"id"`
+	RedfishServer []RedfishServer `tfsdk:"redfish_server"`
+	JobID         types.String    `tfsdk:"job_id"`
+	ClearOn       types.Map       `tfsdk:"clear_on"`
+	ClearedAt     types.String    `tfsdk:"cleared_at"`
+}
@@ -25,5 +25,6 @@ import (
 type RedfishManagerReset struct {
 	Id            types.String    `tfsdk:"id"`
 	ResetType     types.String    `tfsdk:"reset_type"`
+	ResetOn       types.Map       `tfsdk:"reset_on"`
 	RedfishServer []RedfishServer `tfsdk:"redfish_server"`
 }
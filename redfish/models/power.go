@@ -26,6 +26,7 @@ type Power struct {
 	PowerId            types.String    `tfsdk:"id"`
 	RedfishServer      []RedfishServer `tfsdk:"redfish_server"`
 	DesiredPowerAction types.String    `tfsdk:"desired_power_action"`
+	DesiredPowerState  types.String    `tfsdk:"desired_power_state"`
 	MaximumWaitTime    types.Int64     `tfsdk:"maximum_wait_time"`
 	CheckInterval      types.Int64     `tfsdk:"check_interval"`
 	PowerState         types.String    `tfsdk:"power_state"`
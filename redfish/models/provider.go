@@ -23,24 +23,32 @@ import (
 
 // ProviderConfig can be used to store data from the Terraform configuration.
 type ProviderConfig struct {
-	Username types.String `tfsdk:"user"`
-	Password types.String `tfsdk:"password"`
-	Servers  types.Map    `tfsdk:"redfish_servers"`
+	Username    types.String `tfsdk:"user"`
+	Password    types.String `tfsdk:"password"`
+	Servers     types.Map    `tfsdk:"redfish_servers"`
+	HTTPTimeout types.Int64  `tfsdk:"http_timeout"`
+	RetryCount  types.Int64  `tfsdk:"retry_count"`
+	RetryDelay  types.Int64  `tfsdk:"retry_delay"`
+	DebugHTTP   types.Bool   `tfsdk:"debug_http"`
 }
 
 // RedfishServer to configure server config for resource/datasource.
 type RedfishServer struct {
-	RedfishAlias types.String `tfsdk:"redfish_alias"`
-	User         types.String `tfsdk:"user"`
-	Password     types.String `tfsdk:"password"`
-	Endpoint     types.String `tfsdk:"endpoint"`
-	SslInsecure  types.Bool   `tfsdk:"ssl_insecure"`
+	RedfishAlias  types.String `tfsdk:"redfish_alias"`
+	User          types.String `tfsdk:"user"`
+	Password      types.String `tfsdk:"password"`
+	Endpoint      types.String `tfsdk:"endpoint"`
+	SslInsecure   types.Bool   `tfsdk:"ssl_insecure"`
+	CACert        types.String `tfsdk:"ca_cert"`
+	MinTLSVersion types.String `tfsdk:"min_tls_version"`
 }
 
 // RedfishServerPure defines server config without RedfishAlias.
 type RedfishServerPure struct {
-	User        types.String `tfsdk:"user"`
-	Password    types.String `tfsdk:"password"`
-	Endpoint    types.String `tfsdk:"endpoint"`
-	SslInsecure types.Bool   `tfsdk:"ssl_insecure"`
+	User          types.String `tfsdk:"user"`
+	Password      types.String `tfsdk:"password"`
+	Endpoint      types.String `tfsdk:"endpoint"`
+	SslInsecure   types.Bool   `tfsdk:"ssl_insecure"`
+	CACert        types.String `tfsdk:"ca_cert"`
+	MinTLSVersion types.String `tfsdk:"min_tls_version"`
 }
@@ -36,6 +36,12 @@ type SCPImport struct {
 	ShareParameters ShareParameters `json:"ShareParameters"`
 }
 
+// SCPImportPreview defines the payload of the SCP import preview action.
+type SCPImportPreview struct {
+	ImportBuffer    string          `json:"ImportBuffer,omitempty"`
+	ShareParameters ShareParameters `json:"ShareParameters"`
+}
+
 // ShareParameters to provide configuration for local/network share type
 type ShareParameters struct {
 	FileName                 string      `json:"FileName"`
@@ -64,8 +70,20 @@ type RedfishScpImport struct {
 	ImportBuffer   types.String    `tfsdk:"import_buffer"`
 	ShutdownType   types.String    `tfsdk:"shutdown_type"`
 	TimeToWait     types.Int64     `tfsdk:"time_to_wait"`
+	Preview        types.Bool      `tfsdk:"preview"`
 	// ShareParameters Object of type TFShareParameters
 	ShareParameters types.Object `tfsdk:"share_parameters"`
+	// Results List of ScpImportResult
+	Results types.List `tfsdk:"results"`
+}
+
+// ScpImportResult is the outcome of one attribute reported by an SCP import job.
+type ScpImportResult struct {
+	Name     types.String `tfsdk:"name"`
+	OldValue types.String `tfsdk:"old_value"`
+	NewValue types.String `tfsdk:"new_value"`
+	Status   types.String `tfsdk:"status"`
+	Message  types.String `tfsdk:"message"`
 }
 
 // TFRedfishScpExport is the tfsdk model of ScpExport
@@ -98,3 +116,14 @@ type TFShareParameters struct {
 	Target                   types.List   `tfsdk:"target"`
 	Username                 types.String `tfsdk:"username"`
 }
+
+// RedfishScpExportDatasource is the tfsdk model of the SCP export datasource
+type RedfishScpExportDatasource struct {
+	ID              types.String    `tfsdk:"id"`
+	RedfishServer   []RedfishServer `tfsdk:"redfish_server"`
+	Targets         types.List      `tfsdk:"targets"`
+	ExportFormat    types.String    `tfsdk:"export_format"`
+	ExportUse       types.String    `tfsdk:"export_use"`
+	IncludeInExport types.List      `tfsdk:"include_in_export"`
+	Content         types.String    `tfsdk:"content"`
+}
@@ -29,6 +29,7 @@ type SimpleUpdateRes struct {
 	Image         types.String    `tfsdk:"target_firmware_image"`
 	ResetType     types.String    `tfsdk:"reset_type"`
 	ResetTimeout  types.Int64     `tfsdk:"reset_timeout"`
+	ApplyTime     types.String    `tfsdk:"apply_time"`
 	JobTimeout    types.Int64     `tfsdk:"simple_update_job_timeout"`
 	SoftwareId    types.String    `tfsdk:"software_id"`
 	Version       types.String    `tfsdk:"version"`
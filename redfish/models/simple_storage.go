@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SimpleStorageDatasource to construct terraform schema for the simple storage data source.
+type SimpleStorageDatasource struct {
+	ID            types.String           `tfsdk:"id"`
+	RedfishServer []RedfishServer        `tfsdk:"redfish_server"`
+	SystemID      types.String           `tfsdk:"system_id"`
+	Devices       []DirectAttachedDevice `tfsdk:"devices"`
+}
+
+// DirectAttachedDevice is a disk that isn't behind a RAID controller.
+type DirectAttachedDevice struct {
+	ControllerID  types.String `tfsdk:"controller_id"`
+	Name          types.String `tfsdk:"name"`
+	Manufacturer  types.String `tfsdk:"manufacturer"`
+	Model         types.String `tfsdk:"model"`
+	CapacityBytes types.Int64  `tfsdk:"capacity_bytes"`
+	Health        types.String `tfsdk:"health"`
+	State         types.String `tfsdk:"state"`
+}
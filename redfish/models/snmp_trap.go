@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SNMPTrap to construct terraform schema for the SNMP trap resource.
+type SNMPTrap struct {
+	ID              types.String    `tfsdk:"id"`
+	RedfishServer   []RedfishServer `tfsdk:"redfish_server"`
+	Slot            types.Int64     `tfsdk:"slot"`
+	Destination     types.String    `tfsdk:"destination"`
+	Enabled         types.Bool      `tfsdk:"enabled"`
+	SNMPv3User      types.String    `tfsdk:"snmp_v3_user"`
+	ProtocolVersion types.String    `tfsdk:"protocol_version"`
+	Port            types.Int64     `tfsdk:"port"`
+	Community       types.String    `tfsdk:"community"`
+}
@@ -75,6 +75,7 @@ type DellStorageControllerAttributes struct {
 	PatrolReadMode                             types.String `tfsdk:"patrol_read_mode"`
 	BackgroundInitializationRatePercent        types.Int64  `tfsdk:"background_initialization_rate_percent"`
 	ReconstructRatePercent                     types.Int64  `tfsdk:"reconstruct_rate_percent"`
+	SpindownIdleTimeSeconds                    types.Int64  `tfsdk:"spindown_idle_time_seconds"`
 }
 
 // StorageControllerDatasource is struct for StorageController data-source.
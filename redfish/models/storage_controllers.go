@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2023-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StorageControllersDatasource is struct for storage controllers data-source
+type StorageControllersDatasource struct {
+	ID                 types.String               `tfsdk:"id"`
+	RedfishServer      []RedfishServer            `tfsdk:"redfish_server"`
+	SystemID           types.String               `tfsdk:"system_id"`
+	StorageControllers []StorageControllerSummary `tfsdk:"storage_controllers"`
+}
+
+// StorageControllerSummary is the tfsdk model of a storage controller summary
+type StorageControllerSummary struct {
+	ID                   types.String   `tfsdk:"storage_controller_id"`
+	Name                 types.String   `tfsdk:"name"`
+	Model                types.String   `tfsdk:"model"`
+	FirmwareVersion      types.String   `tfsdk:"firmware_version"`
+	SupportedRAIDTypes   []types.String `tfsdk:"supported_raid_types"`
+	EncryptionCapability types.String   `tfsdk:"encryption_capability"`
+}
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2023-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StorageDrivesDatasource is struct for storage drives data-source
+type StorageDrivesDatasource struct {
+	ID                  types.String    `tfsdk:"id"`
+	RedfishServer       []RedfishServer `tfsdk:"redfish_server"`
+	SystemID            types.String    `tfsdk:"system_id"`
+	StorageControllerID types.String    `tfsdk:"storage_controller_id"`
+	MediaType           types.String    `tfsdk:"media_type"`
+	Drives              []StorageDrive  `tfsdk:"drives"`
+}
+
+// StorageDrive is the tfsdk model of a drive attached to a storage controller
+type StorageDrive struct {
+	ID             types.String `tfsdk:"drive_id"`
+	Name           types.String `tfsdk:"name"`
+	MediaType      types.String `tfsdk:"media_type"`
+	Protocol       types.String `tfsdk:"protocol"`
+	CapacityBytes  types.Int64  `tfsdk:"capacity_bytes"`
+	BlockSizeBytes types.Int64  `tfsdk:"block_size_bytes"`
+	RaidStatus     types.String `tfsdk:"raid_status"`
+	InVolume       types.Bool   `tfsdk:"in_volume"`
+	Foreign        types.Bool   `tfsdk:"foreign"`
+}
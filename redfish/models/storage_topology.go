@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2023-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StorageTopologyDatasource is struct for storage topology data-source
+type StorageTopologyDatasource struct {
+	ID            types.String            `tfsdk:"id"`
+	RedfishServer []RedfishServer         `tfsdk:"redfish_server"`
+	SystemID      types.String            `tfsdk:"system_id"`
+	Volumes       []StorageTopologyVolume `tfsdk:"volumes"`
+}
+
+// StorageTopologyVolume is the tfsdk model of a volume along with its member drives
+type StorageTopologyVolume struct {
+	ID                  types.String                  `tfsdk:"volume_id"`
+	Name                types.String                  `tfsdk:"volume_name"`
+	StorageControllerID types.String                  `tfsdk:"storage_controller_id"`
+	RaidType            types.String                  `tfsdk:"raid_type"`
+	CapacityBytes       types.Int64                   `tfsdk:"capacity_bytes"`
+	PhysicalDisks       []StorageTopologyPhysicalDisk `tfsdk:"physical_disks"`
+}
+
+// StorageTopologyPhysicalDisk is the tfsdk model of a member drive of a volume
+type StorageTopologyPhysicalDisk struct {
+	ID            types.String `tfsdk:"drive_id"`
+	Name          types.String `tfsdk:"name"`
+	Slot          types.Int64  `tfsdk:"slot"`
+	SerialNumber  types.String `tfsdk:"serial_number"`
+	CapacityBytes types.Int64  `tfsdk:"capacity_bytes"`
+	MediaType     types.String `tfsdk:"media_type"`
+}
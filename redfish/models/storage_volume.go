@@ -21,22 +21,51 @@ import "github.com/hashicorp/terraform-plugin-framework/types"
 
 // RedfishStorageVolume is struct for storage volume resource
 type RedfishStorageVolume struct {
-	CapacityBytes       types.Int64     `tfsdk:"capacity_bytes"`
-	DiskCachePolicy     types.String    `tfsdk:"disk_cache_policy"`
-	RaidType            types.String    `tfsdk:"raid_type"`
-	Drives              types.List      `tfsdk:"drives"`
-	ID                  types.String    `tfsdk:"id"`
-	RedfishServer       []RedfishServer `tfsdk:"redfish_server"`
-	OptimumIoSizeBytes  types.Int64     `tfsdk:"optimum_io_size_bytes"`
-	ReadCachePolicy     types.String    `tfsdk:"read_cache_policy"`
-	ResetTimeout        types.Int64     `tfsdk:"reset_timeout"`
-	ResetType           types.String    `tfsdk:"reset_type"`
-	SettingsApplyTime   types.String    `tfsdk:"settings_apply_time"`
-	StorageControllerID types.String    `tfsdk:"storage_controller_id"`
-	VolumeJobTimeout    types.Int64     `tfsdk:"volume_job_timeout"`
-	VolumeName          types.String    `tfsdk:"volume_name"`
-	VolumeType          types.String    `tfsdk:"volume_type"`
-	WriteCachePolicy    types.String    `tfsdk:"write_cache_policy"`
-	Encrypted           types.Bool      `tfsdk:"encrypted"`
-	SystemID            types.String    `tfsdk:"system_id"`
+	CapacityBytes          types.Int64        `tfsdk:"capacity_bytes"`
+	DiskCachePolicy        types.String       `tfsdk:"disk_cache_policy"`
+	RaidType               types.String       `tfsdk:"raid_type"`
+	Drives                 types.List         `tfsdk:"drives"`
+	DriveIDs               types.List         `tfsdk:"drive_ids"`
+	DedicatedHotSpares     types.List         `tfsdk:"dedicated_hot_spares"`
+	ID                     types.String       `tfsdk:"id"`
+	JobID                  types.String       `tfsdk:"job_id"`
+	RedfishServer          []RedfishServer    `tfsdk:"redfish_server"`
+	OptimumIoSizeBytes     types.Int64        `tfsdk:"optimum_io_size_bytes"`
+	ReadCachePolicy        types.String       `tfsdk:"read_cache_policy"`
+	ResetTimeout           types.Int64        `tfsdk:"reset_timeout"`
+	ResetType              types.String       `tfsdk:"reset_type"`
+	SettingsApplyTime      types.String       `tfsdk:"settings_apply_time"`
+	MaintenanceWindow      *MaintenanceWindow `tfsdk:"maintenance_window"`
+	StorageControllerID    types.String       `tfsdk:"storage_controller_id"`
+	VolumeJobTimeout       types.Int64        `tfsdk:"volume_job_timeout"`
+	JobCheckInterval       types.Int64        `tfsdk:"job_check_interval"`
+	JobCheckMaxInterval    types.Int64        `tfsdk:"job_check_max_interval"`
+	PreRebootDelay         types.Int64        `tfsdk:"pre_reboot_delay"`
+	LCReadyTimeout         types.Int64        `tfsdk:"lc_ready_timeout"`
+	CancelPendingJobs      types.Bool         `tfsdk:"cancel_pending_jobs"`
+	AllowMixedDrives       types.Bool         `tfsdk:"allow_mixed_drives"`
+	AdoptExisting          types.Bool         `tfsdk:"adopt_existing"`
+	VolumeName             types.String       `tfsdk:"volume_name"`
+	VolumeType             types.String       `tfsdk:"volume_type"`
+	WriteCachePolicy       types.String       `tfsdk:"write_cache_policy"`
+	Encrypted              types.Bool         `tfsdk:"encrypted"`
+	IsCache                types.Bool         `tfsdk:"is_cache"`
+	EncryptionTypes        types.List         `tfsdk:"encryption_types"`
+	SystemID               types.String       `tfsdk:"system_id"`
+	ProtectionInformation  types.String       `tfsdk:"protection_information"`
+	Initialization         types.String       `tfsdk:"initialization"`
+	SpanCount              types.Int64        `tfsdk:"span_count"`
+	SpanLength             types.Int64        `tfsdk:"span_length"`
+	ControllerCacheSizeMB  types.Int64        `tfsdk:"controller_cache_size_mb"`
+	RedundantDriveCount    types.Int64        `tfsdk:"redundant_drive_count"`
+	Health                 types.String       `tfsdk:"health"`
+	State                  types.String       `tfsdk:"state"`
+	OperationProgress      types.String       `tfsdk:"operation_progress"`
+	InitializationProgress types.Int64        `tfsdk:"initialization_progress"`
+	WaitForInitialization  types.Bool         `tfsdk:"wait_for_initialization"`
+	SecureEraseOnDestroy   types.Bool         `tfsdk:"secure_erase_on_destroy"`
+	CryptoEraseOnDestroy   types.Bool         `tfsdk:"cryptographic_erase_on_destroy"`
+	DeleteVolumeOnDestroy  types.Bool         `tfsdk:"delete_volume_on_destroy"`
+	PendingEraseDriveIDs   types.List         `tfsdk:"pending_erase_drive_ids"`
+	ETag                   types.String       `tfsdk:"etag"`
 }
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2023-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StorageVolumesDatasource is struct for storage volumes data-source
+type StorageVolumesDatasource struct {
+	ID                  types.String    `tfsdk:"id"`
+	RedfishServer       []RedfishServer `tfsdk:"redfish_server"`
+	SystemID            types.String    `tfsdk:"system_id"`
+	StorageControllerID types.String    `tfsdk:"storage_controller_id"`
+	Volumes             []StorageVolume `tfsdk:"volumes"`
+}
+
+// StorageVolume is the tfsdk model of an existing volume of a storage controller
+type StorageVolume struct {
+	ID               types.String   `tfsdk:"volume_id"`
+	Name             types.String   `tfsdk:"volume_name"`
+	RaidType         types.String   `tfsdk:"raid_type"`
+	CapacityBytes    types.Int64    `tfsdk:"capacity_bytes"`
+	ReadCachePolicy  types.String   `tfsdk:"read_cache_policy"`
+	WriteCachePolicy types.String   `tfsdk:"write_cache_policy"`
+	DiskCachePolicy  types.String   `tfsdk:"disk_cache_policy"`
+	Encrypted        types.Bool     `tfsdk:"encrypted"`
+	Drives           []types.String `tfsdk:"drives"`
+	DriveIDs         []types.String `tfsdk:"drive_ids"`
+}
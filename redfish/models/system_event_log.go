@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SystemEventLogDatasource to construct terraform schema for the system event log data source.
+type SystemEventLogDatasource struct {
+	ID            types.String    `tfsdk:"id"`
+	RedfishServer []RedfishServer `tfsdk:"redfish_server"`
+	Severity      types.String    `tfsdk:"severity"`
+	Entries       []SelEntry      `tfsdk:"entries"`
+}
+
+// SelEntry is an entry of the system event log.
+type SelEntry struct {
+	ID        types.String `tfsdk:"id"`
+	Severity  types.String `tfsdk:"severity"`
+	Created   types.String `tfsdk:"created"`
+	Message   types.String `tfsdk:"message"`
+	MessageID types.String `tfsdk:"message_id"`
+}
+
+// ClearSel to construct terraform schema for the clear system event log resource.
+type ClearSel struct {
+	ID            types.String    `tfsdk:"id"`
+	RedfishServer []RedfishServer `tfsdk:"redfish_server"`
+	ClearOn       types.Map       `tfsdk:"clear_on"`
+	ClearedAt     types.String    `tfsdk:"cleared_at"`
+}
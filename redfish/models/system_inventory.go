@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SystemInventoryDatasource to construct terraform schema for the system inventory data source.
+type SystemInventoryDatasource struct {
+	ID                      types.String           `tfsdk:"id"`
+	RedfishServer           []RedfishServer        `tfsdk:"redfish_server"`
+	SystemID                types.String           `tfsdk:"system_id"`
+	ProcessorCount          types.Int64            `tfsdk:"processor_count"`
+	LogicalProcessorCount   types.Int64            `tfsdk:"logical_processor_count"`
+	ProcessorModel          types.String           `tfsdk:"processor_model"`
+	TotalMemoryGiB          types.Float64          `tfsdk:"total_memory_gib"`
+	MemoryDimms             []InventoryMemoryDimm  `tfsdk:"memory_dimms"`
+	NetworkInterfaces       []InventoryNetworkPort `tfsdk:"network_interfaces"`
+	PCIeDevices             []InventoryPCIeDevice  `tfsdk:"pcie_devices"`
+	DriveCount              types.Int64            `tfsdk:"drive_count"`
+	TotalDriveCapacityBytes types.Int64            `tfsdk:"total_drive_capacity_bytes"`
+	Drives                  []StorageDrive         `tfsdk:"drives"`
+}
+
+// InventoryMemoryDimm is a memory DIMM of the system inventory.
+type InventoryMemoryDimm struct {
+	ID               types.String `tfsdk:"id"`
+	DeviceLocator    types.String `tfsdk:"device_locator"`
+	CapacityMiB      types.Int64  `tfsdk:"capacity_mib"`
+	MemoryDeviceType types.String `tfsdk:"memory_device_type"`
+	OperatingSpeed   types.Int64  `tfsdk:"operating_speed_mhz"`
+	Manufacturer     types.String `tfsdk:"manufacturer"`
+	PartNumber       types.String `tfsdk:"part_number"`
+	SerialNumber     types.String `tfsdk:"serial_number"`
+}
+
+// InventoryNetworkPort is an ethernet interface of the system inventory.
+type InventoryNetworkPort struct {
+	ID                  types.String `tfsdk:"id"`
+	MACAddress          types.String `tfsdk:"mac_address"`
+	PermanentMACAddress types.String `tfsdk:"permanent_mac_address"`
+	LinkStatus          types.String `tfsdk:"link_status"`
+	SpeedMbps           types.Int64  `tfsdk:"speed_mbps"`
+}
+
+// InventoryPCIeDevice is a PCIe device of the system inventory.
+type InventoryPCIeDevice struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Manufacturer    types.String `tfsdk:"manufacturer"`
+	Model           types.String `tfsdk:"model"`
+	DeviceType      types.String `tfsdk:"device_type"`
+	FirmwareVersion types.String `tfsdk:"firmware_version"`
+}
@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ThermalPowerDatasource to construct terraform schema for the thermal and power metrics data source.
+type ThermalPowerDatasource struct {
+	ID                   types.String    `tfsdk:"id"`
+	RedfishServer        []RedfishServer `tfsdk:"redfish_server"`
+	ChassisID            types.String    `tfsdk:"chassis_id"`
+	Fans                 []Fan           `tfsdk:"fans"`
+	Temperatures         []Temperature   `tfsdk:"temperatures"`
+	PowerSupplies        []PowerSupply   `tfsdk:"power_supplies"`
+	PowerConsumedWatts   types.Float64   `tfsdk:"power_consumed_watts"`
+	AverageConsumedWatts types.Float64   `tfsdk:"average_consumed_watts"`
+}
+
+// Fan is a fan speed sensor of the chassis.
+type Fan struct {
+	Name         types.String `tfsdk:"name"`
+	Reading      types.Int64  `tfsdk:"reading"`
+	ReadingUnits types.String `tfsdk:"reading_units"`
+	Health       types.String `tfsdk:"health"`
+	State        types.String `tfsdk:"state"`
+}
+
+// Temperature is a temperature sensor of the chassis.
+type Temperature struct {
+	Name            types.String  `tfsdk:"name"`
+	ReadingCelsius  types.Float64 `tfsdk:"reading_celsius"`
+	PhysicalContext types.String  `tfsdk:"physical_context"`
+	Health          types.String  `tfsdk:"health"`
+	State           types.String  `tfsdk:"state"`
+}
+
+// PowerSupply is a power supply of the chassis.
+type PowerSupply struct {
+	Name                 types.String  `tfsdk:"name"`
+	PowerSupplyType      types.String  `tfsdk:"power_supply_type"`
+	PowerCapacityWatts   types.Float64 `tfsdk:"power_capacity_watts"`
+	LastPowerOutputWatts types.Float64 `tfsdk:"last_power_output_watts"`
+	Health               types.String  `tfsdk:"health"`
+	State                types.String  `tfsdk:"state"`
+}
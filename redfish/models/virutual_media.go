@@ -34,6 +34,8 @@ type VirtualMedia struct {
 	VirtualMediaID       types.String    `tfsdk:"virtual_media_id"`
 	UserName             types.String    `tfsdk:"username"`
 	Password             types.String    `tfsdk:"password"`
+	MediaType            types.String    `tfsdk:"media_type"`
+	ConnectedVia         types.String    `tfsdk:"connected_via"`
 }
 
 // VirtualMediaDataSource struct for datasource
@@ -19,11 +19,17 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
+	"sync"
 	"terraform-provider-redfish/gofish/dell"
 	"terraform-provider-redfish/redfish/models"
 	"time"
@@ -31,9 +37,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stmcginnis/gofish"
 	"github.com/stmcginnis/gofish/redfish"
@@ -52,8 +60,26 @@ const (
 	redfishAliasMD        = "Alias name for server BMCs. The key in provider's `redfish_servers` map"
 	endpointFieldName     = "endpoint"
 	redfishAliasFieldName = "redfish_alias"
+	// minTLSVersionFieldName is the field name of the minimum TLS version of the server
+	minTLSVersionFieldName = "min_tls_version"
 )
 
+const caCertMD = "PEM encoded CA bundle used to verify the server BMC certificate, for BMCs using a private CA." +
+	" Ignored when `ssl_insecure` is set."
+
+const minTLSVersionMD = "Minimum TLS version accepted from the server BMC. Accepted values: `1.2`, `1.3`. Default is `1.2`," +
+	" TLS 1.0 and 1.1 are never negotiated. Connections negotiating a weaker version are rejected, even when the BMC offers it." +
+	" When it or `ca_cert` is set, the version negotiated with the server is reported as a warning."
+
+// tlsVersions maps the accepted min_tls_version values to their TLS versions
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// minTLSVersions are the accepted values of min_tls_version
+var minTLSVersions = []string{"1.2", "1.3"}
+
 // ServerStatusChecker has required fields for Check() method
 type ServerStatusChecker struct {
 	Service  *gofish.Service
@@ -88,6 +114,22 @@ func RedfishServerSchema() map[string]resourceSchema.Attribute {
 			Optional:    true,
 			Description: "This field indicates whether the SSL/TLS certificate must be verified or not",
 		},
+		minTLSVersionFieldName: resourceSchema.StringAttribute{
+			MarkdownDescription: minTLSVersionMD,
+			Description:         minTLSVersionMD,
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(minTLSVersions...),
+			},
+		},
+		"ca_cert": resourceSchema.StringAttribute{
+			MarkdownDescription: caCertMD,
+			Description:         caCertMD,
+			Optional:            true,
+			Validators: []validator.String{
+				caCertValidator{},
+			},
+		},
 		redfishAliasFieldName: resourceSchema.StringAttribute{
 			MarkdownDescription: redfishAliasMD,
 			Description:         redfishAliasMD,
@@ -128,6 +170,22 @@ func RedfishServerDatasourceSchema() map[string]datasourceSchema.Attribute {
 			Optional:    true,
 			Description: "This field indicates whether the SSL/TLS certificate must be verified or not",
 		},
+		minTLSVersionFieldName: datasourceSchema.StringAttribute{
+			MarkdownDescription: minTLSVersionMD,
+			Description:         minTLSVersionMD,
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(minTLSVersions...),
+			},
+		},
+		"ca_cert": datasourceSchema.StringAttribute{
+			MarkdownDescription: caCertMD,
+			Description:         caCertMD,
+			Optional:            true,
+			Validators: []validator.String{
+				caCertValidator{},
+			},
+		},
 		redfishAliasFieldName: datasourceSchema.StringAttribute{
 			MarkdownDescription: redfishAliasMD,
 			Description:         redfishAliasMD,
@@ -185,24 +243,40 @@ func RedfishServerDatasourceBlockMap() map[string]datasourceSchema.Block {
 //
 // The function returns a pointer to a ComputerSystem instance and an error, if any.
 // Based on an instance of Service from the gofish library, retrieve a concrete ComputerSystem on which we can take action.
-// If sysid is not empty, filter the systems using sysid.
+// If sysid is not empty, filter the systems using sysid. If it is empty, the only system of the service is
+// returned, and an error listing the system IDs when there are several.
 func getSystemResource(service *gofish.Service, sysid string) (*redfish.ComputerSystem, error) {
-	if service == nil {
-		return nil, fmt.Errorf("gofish.Service is nil")
-	}
-
-	systems, err := service.Systems()
+	systems, err := getSystems(service)
 	if err != nil {
 		return nil, err
 	}
 
+	return selectSystem(systems, sysid)
+}
+
+// getSystems returns the computer systems listed by the service root.
+func getSystems(service *gofish.Service) ([]*redfish.ComputerSystem, error) {
+	if service == nil {
+		return nil, fmt.Errorf("gofish.Service is nil")
+	}
+	return service.Systems()
+}
+
+// selectSystem returns the system with the given ID, or the only system when sysid is empty.
+func selectSystem(systems []*redfish.ComputerSystem, sysid string) (*redfish.ComputerSystem, error) {
 	if len(systems) == 0 {
 		return nil, errors.New("no computer systems found")
 	}
 
 	if len(sysid) == 0 {
-		// Use the first system if sysid is not provided
-		return systems[0], nil
+		if len(systems) == 1 {
+			return systems[0], nil
+		}
+		ids := make([]string, 0, len(systems))
+		for _, system := range systems {
+			ids = append(ids, system.ID)
+		}
+		return nil, fmt.Errorf("the service has %d computer systems, set system_id to one of: %s", len(systems), strings.Join(ids, ", "))
 	}
 
 	for _, system := range systems {
@@ -214,11 +288,40 @@ func getSystemResource(service *gofish.Service, sysid string) (*redfish.Computer
 	return nil, errors.New("no computer system found with given system id")
 }
 
+// getChassisResource retrieves the chassis with the given ID, or the first chassis when chassisID is empty.
+func getChassisResource(service *gofish.Service, chassisID string) (*redfish.Chassis, error) {
+	if service == nil {
+		return nil, fmt.Errorf("gofish.Service is nil")
+	}
+
+	chassisCollection, err := service.Chassis()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chassisCollection) == 0 {
+		return nil, errors.New("no chassis found")
+	}
+
+	if len(chassisID) == 0 {
+		// Use the first chassis if chassisID is not provided
+		return chassisCollection[0], nil
+	}
+
+	for _, chassis := range chassisCollection {
+		if chassis.ID == chassisID {
+			return chassis, nil
+		}
+	}
+
+	return nil, errors.New("no chassis found with given chassis id")
+}
+
 // NewConfig function creates the needed gofish structs to query the redfish API
 // See https://github.com/stmcginnis/gofish for details. This function returns a Service struct which can then be
 // used to make any required API calls.
 // To-Do: Verify from plan modifier, if required implement wrapper for validation of unknown in redfish_server.
-func NewConfig(pconfig *redfishProvider, rserver *[]models.RedfishServer) (*gofish.APIClient, error) {
+func NewConfig(ctx context.Context, pconfig *redfishProvider, rserver *[]models.RedfishServer) (*gofish.APIClient, error) {
 	if len(*rserver) == 0 {
 		return nil, fmt.Errorf("no provider block was found")
 	}
@@ -250,12 +353,40 @@ func NewConfig(pconfig *redfishProvider, rserver *[]models.RedfishServer) (*gofi
 		return nil, fmt.Errorf("error. Either Redfish client username or password has not been set. Please check your configuration")
 	}
 
+	tlsConfig, err := newTLSConfig(rserver1)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := rserver1.Endpoint.ValueString()
+	var audit *tlsAudit
+	if pconfig != nil {
+		audit = pconfig.tlsAudit
+	}
+	if rserver1.SslInsecure.ValueBool() {
+		audit.warn("ssl_insecure "+endpoint, "Certificate verification disabled for "+endpoint,
+			"ssl_insecure is set, so the certificate of the server BMC is not verified and the connection can be intercepted."+
+				" Set ca_cert instead to trust a BMC using a private CA.")
+	}
+	// the negotiated version is only audited when the TLS settings of the server are pinned, gofish keeps its
+	// own HTTP client otherwise
+	if tlsConfig.MinVersion != 0 || tlsConfig.RootCAs != nil {
+		tlsConfig.VerifyConnection = tlsVersionAuditor(ctx, audit, endpoint)
+	}
+
 	clientConfig := gofish.ClientConfig{
-		Endpoint: rserver1.Endpoint.ValueString(),
+		Endpoint: endpoint,
 		Username: redfishClientUser,
 		Password: redfishClientPass,
 		Insecure: rserver1.SslInsecure.ValueBool(),
 	}
+	var providerConfig models.ProviderConfig
+	if pconfig != nil {
+		providerConfig = pconfig.ProviderConfig
+	}
+	clientConfig.HTTPClient = newHTTPClient(providerConfig, tlsConfig)
+	if providerConfig.DebugHTTP.ValueBool() {
+		clientConfig.DumpWriter = &httpDumpWriter{ctx: ctx, endpoint: clientConfig.Endpoint}
+	}
 
 	api, err := gofish.Connect(clientConfig)
 	if err != nil {
@@ -264,6 +395,241 @@ func NewConfig(pconfig *redfishProvider, rserver *[]models.RedfishServer) (*gofi
 	return api, nil
 }
 
+// newTLSConfig builds the TLS configuration of a server, trusting its ca_cert unless ssl_insecure is set.
+// Versions below min_tls_version are rejected during the handshake.
+func newTLSConfig(rserver models.RedfishServer) (*tls.Config, error) {
+	insecure := rserver.SslInsecure.ValueBool()
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: insecure, // #nosec G402
+		MinVersion:         tlsVersions[rserver.MinTLSVersion.ValueString()],
+	}
+	if insecure || rserver.CACert.ValueString() == "" {
+		return tlsConfig, nil
+	}
+
+	tlsConfig.RootCAs = x509.NewCertPool()
+	if !tlsConfig.RootCAs.AppendCertsFromPEM([]byte(rserver.CACert.ValueString())) {
+		return nil, fmt.Errorf("ca_cert doesn't contain any valid PEM encoded certificate")
+	}
+	return tlsConfig, nil
+}
+
+// newHTTPClient builds the HTTP client used by gofish out of the server TLS configuration and the provider level
+// http_timeout, retry_count and retry_delay. It returns nil, keeping the gofish defaults, when none of them is set.
+func newHTTPClient(config models.ProviderConfig, tlsConfig *tls.Config) *http.Client {
+	if config.HTTPTimeout.IsNull() && config.RetryCount.IsNull() && config.RetryDelay.IsNull() &&
+		tlsConfig.RootCAs == nil && tlsConfig.MinVersion == 0 {
+		return nil
+	}
+
+	// same transport gofish would build, it is ignored once a custom HTTP client is given
+	defaultTransport := http.DefaultTransport.(*http.Transport)
+	transport := &http.Transport{
+		Proxy:                 defaultTransport.Proxy,
+		DialContext:           defaultTransport.DialContext,
+		MaxIdleConns:          defaultTransport.MaxIdleConns,
+		IdleConnTimeout:       defaultTransport.IdleConnTimeout,
+		ExpectContinueTimeout: defaultTransport.ExpectContinueTimeout,
+		TLSHandshakeTimeout:   defaultTLSHandshakeTimeout * time.Second,
+		TLSClientConfig:       tlsConfig,
+	}
+
+	retryDelay := int64(defaultRetryDelay)
+	if !config.RetryDelay.IsNull() {
+		retryDelay = config.RetryDelay.ValueInt64()
+	}
+
+	return &http.Client{
+		Timeout: time.Duration(config.HTTPTimeout.ValueInt64()) * time.Second,
+		Transport: &retryTransport{
+			next:       transport,
+			retryCount: config.RetryCount.ValueInt64(),
+			retryDelay: time.Duration(retryDelay) * time.Second,
+		},
+	}
+}
+
+// retryTransport retries read requests failing with a connection error or a 5xx status.
+// Other methods are sent once, so that a job submission is never posted twice.
+type retryTransport struct {
+	next       http.RoundTripper
+	retryCount int64
+	retryDelay time.Duration
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return t.next.RoundTrip(req)
+	}
+
+	for attempt := int64(0); ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if attempt >= t.retryCount || (err == nil && resp.StatusCode < http.StatusInternalServerError) {
+			return resp, err
+		}
+		if err == nil {
+			resp.Body.Close() // #nosec G104
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(t.retryDelay):
+		}
+	}
+}
+
+// httpDumpRedactions hides the credentials of the HTTP traces: the authentication headers and the
+// passwords, keys and tokens of the JSON bodies.
+var httpDumpRedactions = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`(?im)^(authorization|x-auth-token|cookie|set-cookie):.*$`), "$1: [REDACTED]"},
+	{regexp.MustCompile(`(?i)("[a-z]*(password|passphrase|key|token)"\s*:\s*)"(?:[^"\\]|\\.)*"`), `$1"[REDACTED]"`},
+}
+
+// redactHTTPDump removes the credentials from an HTTP request or response dump.
+func redactHTTPDump(dump string) string {
+	for _, redaction := range httpDumpRedactions {
+		dump = redaction.pattern.ReplaceAllString(dump, redaction.replacement)
+	}
+	return dump
+}
+
+// httpDumpWriter is the gofish DumpWriter of debug_http, it logs the redacted HTTP traces through the context of
+// the request the client was created for.
+type httpDumpWriter struct {
+	ctx      context.Context
+	endpoint string
+}
+
+// Write implements io.Writer
+func (w *httpDumpWriter) Write(dump []byte) (int, error) {
+	tflog.Debug(w.ctx, "redfish HTTP trace", map[string]interface{}{
+		"endpoint": w.endpoint,
+		"dump":     redactHTTPDump(string(dump)),
+	})
+	return len(dump), nil
+}
+
+// tlsVersionAuditor returns the VerifyConnection callback reporting the TLS version negotiated with the endpoint,
+// so that the TLS versions of a fleet of servers can be audited from the plan output.
+func tlsVersionAuditor(ctx context.Context, audit *tlsAudit, endpoint string) func(tls.ConnectionState) error {
+	return func(state tls.ConnectionState) error {
+		version := tls.VersionName(state.Version)
+		tflog.Info(ctx, "negotiated TLS version", map[string]interface{}{
+			"endpoint":    endpoint,
+			"tls_version": version,
+		})
+		audit.warn("tls_version "+endpoint+" "+version, "TLS version negotiated with "+endpoint,
+			fmt.Sprintf("The connection to the server BMC negotiated %s.", version))
+		return nil
+	}
+}
+
+// tlsAudit collects the TLS warnings of the server connections, each is reported once per run.
+type tlsAudit struct {
+	mutex    sync.Mutex
+	reported map[string]bool
+	pending  diag.Diagnostics
+}
+
+// warn queues a warning, unless the warning with the same key has already been queued
+func (a *tlsAudit) warn(key, summary, detail string) {
+	if a == nil {
+		return
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.reported == nil {
+		a.reported = make(map[string]bool)
+	}
+	if a.reported[key] {
+		return
+	}
+	a.reported[key] = true
+	a.pending.AddWarning(summary, detail)
+}
+
+// diagnostics returns the queued warnings and empties the queue
+func (a *tlsAudit) diagnostics() diag.Diagnostics {
+	if a == nil {
+		return nil
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	pending := a.pending
+	a.pending = nil
+	return pending
+}
+
+// hostnameRegex matches the RFC 1123 host names, e.g. ntp.example.com
+var hostnameRegex = regexp.MustCompile(`^(?i)[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*\.?$`)
+
+// numericHostRegex matches the values made of digits and dots, which are malformed IPv4 addresses rather than host names
+var numericHostRegex = regexp.MustCompile(`^[0-9.]+$`)
+
+// hostnameOrIPValidator checks a string is an IP address or a host name, e.g. of an NTP or syslog server
+type hostnameOrIPValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v hostnameOrIPValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (hostnameOrIPValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be an IP address or a host name"
+}
+
+// ValidateString performs the validation.
+func (v hostnameOrIPValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	if net.ParseIP(value) != nil ||
+		(len(value) <= 253 && hostnameRegex.MatchString(value) && !numericHostRegex.MatchString(value)) {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid host",
+		fmt.Sprintf("%s, got: %q", v.Description(ctx), value),
+	)
+}
+
+// caCertValidator warns that ca_cert is ignored when ssl_insecure is set in the same server block
+type caCertValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v caCertValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (caCertValidator) MarkdownDescription(_ context.Context) string {
+	return "warns when `ssl_insecure` is also set"
+}
+
+// ValidateString performs the validation.
+func (caCertValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	var insecure types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("ssl_insecure"), &insecure)...)
+	if insecure.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"ca_cert is ignored",
+			"ssl_insecure is set, so the server certificate is not verified and ca_cert is not used.",
+		)
+	}
+}
+
 // getActiveAliasRedfishServer is a helper function to get the active alias server from provider block.
 func getActiveAliasRedfishServer(pconfig *redfishProvider, rserver *models.RedfishServer) error {
 	serverAlias := rserver.RedfishAlias.ValueString()
@@ -286,9 +652,50 @@ func getActiveAliasRedfishServer(pconfig *redfishProvider, rserver *models.Redfi
 	rserver.User = aliasServer.User
 	rserver.Password = aliasServer.Password
 	rserver.SslInsecure = aliasServer.SslInsecure
+	rserver.CACert = aliasServer.CACert
+	rserver.MinTLSVersion = aliasServer.MinTLSVersion
 	return nil
 }
 
+// checkResetTypeSupported returns an error when the system doesn't advertise the reset type in the allowable values
+// of its Reset action. Systems advertising no allowable values are assumed to support every reset type.
+func checkResetTypeSupported(system *redfish.ComputerSystem, resetType string) error {
+	if len(system.SupportedResetTypes) == 0 {
+		return nil
+	}
+	supported := make([]string, 0, len(system.SupportedResetTypes))
+	for _, allowed := range system.SupportedResetTypes {
+		if string(allowed) == resetType {
+			return nil
+		}
+		supported = append(supported, string(allowed))
+	}
+	return fmt.Errorf("reset type %s is not supported by system %s, the supported reset types are: %s",
+		resetType, system.ID, strings.Join(supported, ", "))
+}
+
+// restartTransitionCheckInterval is how often the power state is checked until a restarting system is seen going
+// down, since it may only stay down for a few seconds.
+const restartTransitionCheckInterval = time.Second
+
+// resetWaiter tells when a system reached the power state expected after a reset. Restarts start and end in
+// the On power state, so On is only accepted once the system was seen leaving it, otherwise the system may
+// not have gone down yet.
+type resetWaiter struct {
+	target          redfish.PowerState
+	needsTransition bool
+	transitioned    bool
+}
+
+// done records the observed power state and reports whether the reset completed.
+func (w *resetWaiter) done(state redfish.PowerState) bool {
+	if state != w.target {
+		w.transitioned = true
+		return false
+	}
+	return !w.needsTransition || w.transitioned
+}
+
 type powerOperator struct {
 	ctx     context.Context
 	service *gofish.Service
@@ -301,7 +708,8 @@ type powerOperator struct {
 // the expected power state before considering it a failure. The third is checkInterval which is how often to check the
 // server's power state for updates. The last is a pointer to a gofish.Service object with which the function can
 // interact with the server. It will return a tuple consisting of the server's power state at time of return and
-// diagnostics
+// an error, which is also returned when the server doesn't reach the expected power state within maximumWaitTime.
+// A restart only completes once the server was seen going down and back On.
 func (p powerOperator) PowerOperation(resetType string, maximumWaitTime int64, checkInterval int64) (redfish.PowerState, error) {
 	const powerON redfish.PowerState = "On"
 	const powerOFF redfish.PowerState = "Off"
@@ -312,7 +720,12 @@ func (p powerOperator) PowerOperation(resetType string, maximumWaitTime int64, c
 	}
 	system.Entity.SetETag("")
 
+	if err := checkResetTypeSupported(system, resetType); err != nil {
+		return system.PowerState, err
+	}
+
 	var targetPowerState redfish.PowerState
+	restarting := false
 
 	if resetType == "ForceOff" || resetType == "GracefulShutdown" {
 		if system.PowerState == powerOFF {
@@ -330,11 +743,24 @@ func (p powerOperator) PowerOperation(resetType string, maximumWaitTime int64, c
 		targetPowerState = powerON
 	}
 
-	if resetType == "ForceRestart" || resetType == "GracefulRestart" || resetType == "PowerCycle" || resetType == "Nmi" {
+	// A non-maskable interrupt is handled by the running OS and doesn't change the power state
+	if resetType == "Nmi" {
+		if system.PowerState != powerON {
+			return system.PowerState, fmt.Errorf("a Nmi can't be sent to system %s, its power state is %s", system.ID, system.PowerState)
+		}
+		tflog.Trace(p.ctx, "Performing system.Reset(Nmi)")
+		if err = system.Reset(redfish.NmiResetType); err != nil {
+			tflog.Warn(p.ctx, fmt.Sprintf("system.Reset returned an error: %s", err))
+		}
+		return system.PowerState, err
+	}
+
+	if resetType == "ForceRestart" || resetType == "GracefulRestart" || resetType == "PowerCycle" {
 		// If someone asks for a reset while the server is off, change the reset type to on instead
 		if system.PowerState == powerOFF {
 			resetType = "On"
 		}
+		restarting = system.PowerState == powerON
 		targetPowerState = powerON
 	}
 
@@ -355,28 +781,36 @@ func (p powerOperator) PowerOperation(resetType string, maximumWaitTime int64, c
 	}
 
 	// Wait for the server to be in the correct power state
-	var totalTime int64
-	for totalTime < maximumWaitTime {
-		time.Sleep(time.Duration(checkInterval) * time.Second)
-		totalTime += checkInterval
-		tflog.Trace(p.ctx, fmt.Sprintf("Total time is %d seconds. Checking power state now.", totalTime))
+	waiter := resetWaiter{target: targetPowerState, needsTransition: restarting}
+	lastPowerState := system.PowerState
+	start := time.Now()
+	for time.Since(start) < time.Duration(maximumWaitTime)*time.Second {
+		interval := time.Duration(checkInterval) * time.Second
+		if waiter.needsTransition && !waiter.transitioned {
+			interval = restartTransitionCheckInterval
+		}
+		time.Sleep(interval)
+		tflog.Trace(p.ctx, fmt.Sprintf("Total time is %d seconds. Checking power state now.", int64(time.Since(start).Seconds())))
 
 		system, err := getSystemResource(p.service, p.sysid)
 		if err != nil {
 			tflog.Error(p.ctx, fmt.Sprintf("Failed to identify system: %s", err))
 			continue
 		}
-		if system.PowerState == targetPowerState {
+		lastPowerState = system.PowerState
+		if waiter.done(system.PowerState) {
 			tflog.Debug(p.ctx, "system.Reset successful")
 			return system.PowerState, nil
 		}
 	}
 
 	// If we've reached here it means the system never reached the appropriate target state
-	// We will instead set the power state to whatever the current state is and return
-	// TODO : Change to warning when updated to plugin framework
-	tflog.Warn(p.ctx, "The system failed to update the server's power status within the maximum wait time specified!")
-	return system.PowerState, nil
+	if waiter.needsTransition && !waiter.transitioned {
+		return lastPowerState, fmt.Errorf("the system was not seen going down within %d seconds after the %s reset,"+
+			" the last observed power state is %s", maximumWaitTime, resetType, lastPowerState)
+	}
+	return lastPowerState, fmt.Errorf("the system did not reach the %s power state within %d seconds after the %s reset,"+
+		" the last observed power state is %s", targetPowerState, maximumWaitTime, resetType, lastPowerState)
 }
 
 // Check checks iDRAC server status after provided interval until the provided timeout time
@@ -408,6 +842,31 @@ func (s *ServerStatusChecker) Check(ctx context.Context) error {
 	return err
 }
 
+// getDellManager returns the first manager of the service with its Dell OEM actions and links.
+func getDellManager(service *gofish.Service) (*dell.ManagerExtended, error) {
+	managers, err := service.Managers()
+	if err != nil {
+		return nil, err
+	}
+	if len(managers) == 0 {
+		return nil, errors.New("no manager was found")
+	}
+	return dell.Manager(managers[0])
+}
+
+// getDellIdracAttributes returns the iDRAC attributes of the first manager of the service.
+func getDellIdracAttributes(service *gofish.Service) (*dell.Attributes, error) {
+	dellManager, err := getDellManager(service)
+	if err != nil {
+		return nil, err
+	}
+	dellAttributes, err := dellManager.DellAttributes()
+	if err != nil {
+		return nil, err
+	}
+	return getIdracAttributes(dellAttributes)
+}
+
 // Checks whether the server generation is 17G and above
 func isServerGenerationSeventeenAndAbove(service *gofish.Service) (bool, error) {
 	managers, err := service.Managers()
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2023-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stmcginnis/gofish"
+)
+
+// newSystemsService returns a service whose root lists the computer systems with the given IDs.
+func newSystemsService(t *testing.T, systemIDs ...string) *gofish.Service {
+	t.Helper()
+	documents := map[string]interface{}{
+		"/redfish/v1/": map[string]interface{}{
+			"@odata.id": "/redfish/v1/",
+			"Id":        "RootService",
+			"Systems":   map[string]string{"@odata.id": "/redfish/v1/Systems"},
+		},
+	}
+	members := []map[string]string{}
+	for _, id := range systemIDs {
+		uri := "/redfish/v1/Systems/" + id
+		members = append(members, map[string]string{"@odata.id": uri})
+		documents[uri] = map[string]interface{}{"@odata.id": uri, "Id": id, "Name": "System"}
+	}
+	documents["/redfish/v1/Systems"] = map[string]interface{}{
+		"@odata.id":           "/redfish/v1/Systems",
+		"Members":             members,
+		"Members@odata.count": len(members),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		document, ok := documents[r.URL.Path]
+		if !ok {
+			document, ok = documents[strings.TrimSuffix(r.URL.Path, "/")]
+		}
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(document)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := gofish.Connect(gofish.ClientConfig{Endpoint: server.URL, Insecure: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	t.Cleanup(client.Logout)
+	return client.Service
+}
+
+func TestGetSystemResource(t *testing.T) {
+	single := newSystemsService(t, "System.Embedded.1")
+	system, err := getSystemResource(single, "")
+	if err != nil || system.ID != "System.Embedded.1" {
+		t.Errorf("expected the only system to be selected, got %v, %v", system, err)
+	}
+	if _, err := getSystemResource(single, "System.Embedded.2"); err == nil {
+		t.Error("expected an error for a missing system")
+	}
+
+	multiple := newSystemsService(t, "Node.1", "Node.2")
+	if _, err := getSystemResource(multiple, ""); err == nil || !strings.Contains(err.Error(), "Node.1, Node.2") {
+		t.Errorf("expected an error listing the systems, got %v", err)
+	}
+	system, err = getSystemResource(multiple, "Node.2")
+	if err != nil || system.ID != "Node.2" {
+		t.Errorf("expected Node.2 to be selected, got %v, %v", system, err)
+	}
+
+	if _, err := getSystemResource(newSystemsService(t), ""); err == nil {
+		t.Error("expected an error when the service has no systems")
+	}
+	if _, err := getSystemResource(nil, ""); err == nil {
+		t.Error("expected an error for a nil service")
+	}
+}
@@ -39,4 +39,10 @@ const (
 
 	// Seventeen specifies the server generation for comparison
 	Seventeen = 17
+
+	// defaultRetryDelay specifies the delay in seconds between two retries when retry_delay is not set
+	defaultRetryDelay = 5
+
+	// defaultTLSHandshakeTimeout specifies the TLS handshake timeout in seconds, as used by gofish
+	defaultTLSHandshakeTimeout = 10
 )
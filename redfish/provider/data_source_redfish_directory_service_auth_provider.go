@@ -119,11 +119,12 @@ func (g *DirectoryServiceAuthProviderDatasource) Read(ctx context.Context, req d
 	var plan models.DirectoryServiceAuthProviderDatasource
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
-	api, err := NewConfig(g.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, g.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
 	defer api.Logout()
 	g.ctx = ctx
 	g.service = api.Service
@@ -115,11 +115,12 @@ func (g *FirmwareInventoryDatasource) Read(ctx context.Context, req datasource.R
 	var plan models.FirmwareInventory
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
-	api, err := NewConfig(g.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, g.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 	state, err := readRedfishFirmwareInventory(service)
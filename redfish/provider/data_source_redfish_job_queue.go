@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-redfish/common"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &JobQueueDatasource{}
+	_ datasource.DataSourceWithConfigure = &JobQueueDatasource{}
+)
+
+// NewJobQueueDatasource is new datasource for the job queue
+func NewJobQueueDatasource() datasource.DataSource {
+	return &JobQueueDatasource{}
+}
+
+// JobQueueDatasource to construct datasource
+type JobQueueDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *JobQueueDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*JobQueueDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "job_queue"
+}
+
+// Schema implements datasource.DataSource
+func (*JobQueueDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query the jobs of the iDRAC job queue." +
+			" The information fetched from this block can be further used for resource block.",
+		Description: "This Terraform datasource is used to query the jobs of the iDRAC job queue." +
+			" The information fetched from this block can be further used for resource block.",
+		Attributes: JobQueueDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// JobQueueDatasourceSchema to define the job queue data-source schema
+func JobQueueDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the job queue data-source. It is the URI of the iDRAC job queue.",
+			Description:         "ID of the job queue data-source. It is the URI of the iDRAC job queue.",
+			Computed:            true,
+		},
+		"jobs": schema.ListNestedAttribute{
+			MarkdownDescription: "Jobs of the job queue.",
+			Description:         "Jobs of the job queue.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "ID of the job, e.g. `JID_123456789012`",
+						Description:         "ID of the job, e.g. JID_123456789012",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the job",
+						Description:         "Name of the job",
+						Computed:            true,
+					},
+					"job_state": schema.StringAttribute{
+						MarkdownDescription: "State of the job, e.g. `Scheduled`, `Running` or `Completed`",
+						Description:         "State of the job, e.g. Scheduled, Running or Completed",
+						Computed:            true,
+					},
+					"job_type": schema.StringAttribute{
+						MarkdownDescription: "Type of the job, e.g. `RAIDConfiguration`",
+						Description:         "Type of the job, e.g. RAIDConfiguration",
+						Computed:            true,
+					},
+					"message": schema.StringAttribute{
+						MarkdownDescription: "Last message reported by the job",
+						Description:         "Last message reported by the job",
+						Computed:            true,
+					},
+					"percent_complete": schema.Int64Attribute{
+						MarkdownDescription: "Completion percentage of the job",
+						Description:         "Completion percentage of the job",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *JobQueueDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.JobQueueDatasource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, err := NewConfig(ctx, g.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	dellManager, err := getDellManager(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to fetch the iDRAC manager", err.Error())
+		return
+	}
+	if dellManager.JobsURI() == "" {
+		resp.Diagnostics.AddError("failed to fetch the job queue", "the manager has no Dell job queue")
+		return
+	}
+	jobs, err := common.GetDellJobQueue(api.Service, dellManager.JobsURI())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to fetch the job queue", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(dellManager.JobsURI())
+	state.Jobs = getJobQueueEntries(jobs)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// getJobQueueEntries converts the jobs of the Dell job queue.
+func getJobQueueEntries(jobs []common.DellJobQueueEntry) []models.JobQueueEntry {
+	entries := make([]models.JobQueueEntry, 0, len(jobs))
+	for _, job := range jobs {
+		entries = append(entries, models.JobQueueEntry{
+			ID:              types.StringValue(job.ID),
+			Name:            types.StringValue(job.Name),
+			JobState:        types.StringValue(job.JobState),
+			JobType:         types.StringValue(job.JobType),
+			Message:         types.StringValue(job.Message),
+			PercentComplete: types.Int64Value(job.PercentComplete),
+		})
+	}
+	return entries
+}
@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-redfish/common"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test case for Job Queue DataSource
+func TestAccRedfishJobQueueDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceJobQueueConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.redfish_job_queue.jobs", "id"),
+					resource.TestCheckResourceAttrSet("data.redfish_job_queue.jobs", "jobs.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetJobQueueEntries(t *testing.T) {
+	jobs := []common.DellJobQueueEntry{
+		{ID: "JID_1", Name: "Configure: RAID.Integrated.1-1", JobState: "Scheduled", JobType: "RAIDConfiguration"},
+		{ID: "JID_2", Name: "Export Configuration", JobState: "Completed", PercentComplete: 100},
+	}
+
+	got := getJobQueueEntries(jobs)
+	if len(got) != len(jobs) {
+		t.Fatalf("expected %d entries, got %d", len(jobs), len(got))
+	}
+	if got[0].ID.ValueString() != "JID_1" || got[0].JobType.ValueString() != "RAIDConfiguration" {
+		t.Errorf("unexpected first entry %v", got[0])
+	}
+	if got[1].PercentComplete.ValueInt64() != 100 {
+		t.Errorf("expected the second job to be complete, got %v", got[1])
+	}
+	if got := getJobQueueEntries(nil); got == nil || len(got) != 0 {
+		t.Errorf("expected an empty list for no jobs, got %v", got)
+	}
+}
+
+func testAccRedfishDataSourceJobQueueConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+		
+		data "redfish_job_queue" "jobs" {
+		
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
@@ -0,0 +1,248 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"terraform-provider-redfish/common"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+)
+
+var (
+	_ datasource.DataSource              = &ScpExportDatasource{}
+	_ datasource.DataSourceWithConfigure = &ScpExportDatasource{}
+)
+
+// NewScpExportDatasource is new datasource for the Server Configuration Profile export
+func NewScpExportDatasource() datasource.DataSource {
+	return &ScpExportDatasource{}
+}
+
+// ScpExportDatasource to construct datasource
+type ScpExportDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *ScpExportDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*ScpExportDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "idrac_server_configuration_profile_export"
+}
+
+// Schema implements datasource.DataSource
+func (*ScpExportDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to export the iDRAC Server Configuration Profile (SCP) of the selected components." +
+			" The profile is returned as is, so that it can be backed up or compared with the desired configuration.",
+		Description: "Data source to export the iDRAC Server Configuration Profile (SCP) of the selected components." +
+			" The profile is returned as is, so that it can be backed up or compared with the desired configuration.",
+		Attributes: ScpExportDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// ScpExportDatasourceSchema to define the SCP export datasource schema
+func ScpExportDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the SCP export data-source",
+			Description:         "ID of the SCP export data-source",
+			Computed:            true,
+		},
+		"targets": schema.ListAttribute{
+			MarkdownDescription: "Components to export the configuration of. Defaults to `ALL`.",
+			Description:         "Components to export the configuration of. Defaults to ALL.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(scpTargets...)),
+			},
+		},
+		"export_format": schema.StringAttribute{
+			MarkdownDescription: "Format of the exported profile. Accepted values: `XML`, `JSON`. Defaults to `XML`.",
+			Description:         "Format of the exported profile. Accepted values: XML, JSON. Defaults to XML.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("XML", "JSON"),
+			},
+		},
+		"export_use": schema.StringAttribute{
+			MarkdownDescription: "Type of Server Configuration Profile to export. Accepted values: `Default`, `Clone`, `Replace`." +
+				" Defaults to `Default`.",
+			Description: "Type of Server Configuration Profile to export. Accepted values: Default, Clone, Replace." +
+				" Defaults to Default.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("Default", "Clone", "Replace"),
+			},
+		},
+		"include_in_export": schema.ListAttribute{
+			MarkdownDescription: "Additional data to include in the export. Accepted values: `Default`, `IncludeReadOnly`," +
+				" `IncludePasswordHashValues`, `IncludeCustomTelemetry`. Defaults to `Default`.",
+			Description: "Additional data to include in the export. Accepted values: Default, IncludeReadOnly," +
+				" IncludePasswordHashValues, IncludeCustomTelemetry. Defaults to Default.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(
+					"Default",
+					"IncludeReadOnly",
+					"IncludePasswordHashValues",
+					"IncludeCustomTelemetry",
+				)),
+			},
+		},
+		"content": schema.StringAttribute{
+			MarkdownDescription: "Exported Server Configuration Profile, in the requested format.",
+			Description:         "Exported Server Configuration Profile, in the requested format.",
+			Computed:            true,
+			Sensitive:           true,
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *ScpExportDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.RedfishScpExportDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The export runs as a job on the iDRAC, so it is serialized with the other jobs of the server
+	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
+	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+
+	api, err := NewConfig(ctx, g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
+	service := api.Service
+	defer api.Logout()
+	state, diags := readRedfishScpExport(ctx, service, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func readRedfishScpExport(ctx context.Context, service *gofish.Service, d models.RedfishScpExportDatasource,
+) (models.RedfishScpExportDatasource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	managers, err := service.Managers()
+	if err != nil {
+		diags.AddError("Error fetching managers", err.Error())
+		return d, diags
+	}
+	if len(managers) == 0 {
+		diags.AddError("Error fetching managers", "no manager was found")
+		return d, diags
+	}
+	dellManager, err := dell.Manager(managers[0])
+	if err != nil {
+		diags.AddError("Error fetching the Dell manager", err.Error())
+		return d, diags
+	}
+
+	var targets, includeInExport []string
+	diags.Append(d.Targets.ElementsAs(ctx, &targets, true)...)
+	diags.Append(d.IncludeInExport.ElementsAs(ctx, &includeInExport, true)...)
+	if diags.HasError() {
+		return d, diags
+	}
+	payload := scpExportDatasourcePayload(d.ExportFormat.ValueString(), d.ExportUse.ValueString(), targets, includeInExport,
+		dellManager.FirmwareVersion)
+
+	resp, err := service.GetClient().Post(dellManager.Actions.ExportSystemConfigurationTarget, payload)
+	if err != nil {
+		diags.AddError("Error exporting the Server Configuration Profile", err.Error())
+		return d, diags
+	}
+	resp.Body.Close() // #nosec G104
+	location, err := resp.Location()
+	if err != nil {
+		diags.AddError("Error exporting the Server Configuration Profile", "the export job location could not be read: "+err.Error())
+		return d, diags
+	}
+
+	content, err := common.WaitForJobAttachmentWithContext(ctx, service, location.EscapedPath(), intervalJobCheckTime, defaultJobTimeout)
+	if err != nil {
+		diags.AddError("Error exporting the Server Configuration Profile", err.Error())
+		return d, diags
+	}
+
+	d.ID = types.StringValue(dellManager.ODataID + "/ExportSystemConfiguration")
+	d.Content = types.StringValue(string(content))
+	return d, diags
+}
+
+// scpExportDatasourcePayload builds the payload exporting the profile locally, so that it is attached to the job.
+// Defaults are applied to the unset settings.
+func scpExportDatasourcePayload(exportFormat, exportUse string, targets, includeInExport []string, firmwareVersion string,
+) map[string]interface{} {
+	if exportFormat == "" {
+		exportFormat = "XML"
+	}
+	if exportUse == "" {
+		exportUse = "Default"
+	}
+	if len(targets) == 0 {
+		targets = []string{"ALL"}
+	}
+	if len(includeInExport) == 0 {
+		includeInExport = []string{"Default"}
+	}
+	// iDRAC 5.x firmware expects the targets as a comma separated string
+	var target interface{} = targets
+	if strings.HasPrefix(firmwareVersion, "5.") {
+		target = strings.Join(targets, ",")
+	}
+	return map[string]interface{}{
+		"ExportFormat":    exportFormat,
+		"ExportUse":       exportUse,
+		"IncludeInExport": includeInExport,
+		"ShareParameters": map[string]interface{}{
+			"Target": target,
+		},
+	}
+}
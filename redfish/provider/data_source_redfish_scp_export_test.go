@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to export the Server Configuration Profile of the BIOS in JSON
+func TestAccRedfishScpExportDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceScpExportConfig(creds, `["BIOS"]`, "JSON"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.redfish_idrac_server_configuration_profile_export.scp", "content",
+						regexp.MustCompile("SystemConfiguration")),
+				),
+			},
+		},
+	})
+}
+
+// Test to export the Server Configuration Profile with an invalid target - Negative
+func TestAccRedfishScpExportDataSource_InvalidTarget(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishDataSourceScpExportConfig(creds, `["GPU"]`, "XML"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func TestScpExportDatasourcePayload(t *testing.T) {
+	payload := scpExportDatasourcePayload("", "", nil, nil, "7.00.00.00")
+	expected := map[string]interface{}{
+		"ExportFormat":    "XML",
+		"ExportUse":       "Default",
+		"IncludeInExport": []string{"Default"},
+		"ShareParameters": map[string]interface{}{"Target": []string{"ALL"}},
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("expected the default payload %v, got %v", expected, payload)
+	}
+
+	payload = scpExportDatasourcePayload("JSON", "Clone", []string{"BIOS", "RAID"}, nil, "5.10.50.00")
+	target := payload["ShareParameters"].(map[string]interface{})["Target"]
+	if target != "BIOS,RAID" {
+		t.Errorf("expected the targets to be joined for 5.x firmware, got %v", target)
+	}
+}
+
+func testAccRedfishDataSourceScpExportConfig(testingInfo TestingServerCredentials, targets, format string) string {
+	return fmt.Sprintf(`
+		data "redfish_idrac_server_configuration_profile_export" "scp" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  targets       = %s
+		  export_format = "%s"
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		targets,
+		format,
+	)
+}
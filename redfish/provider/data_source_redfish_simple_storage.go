@@ -0,0 +1,236 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var (
+	_ datasource.DataSource              = &SimpleStorageDatasource{}
+	_ datasource.DataSourceWithConfigure = &SimpleStorageDatasource{}
+)
+
+// NewSimpleStorageDatasource is new datasource for the direct-attached drives
+func NewSimpleStorageDatasource() datasource.DataSource {
+	return &SimpleStorageDatasource{}
+}
+
+// SimpleStorageDatasource to construct datasource
+type SimpleStorageDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *SimpleStorageDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*SimpleStorageDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "simple_storage"
+}
+
+// Schema implements datasource.DataSource
+func (*SimpleStorageDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to list the direct-attached disks of a system, i.e. the NVMe and" +
+			" AHCI disks that aren't behind a RAID controller. The disks of RAID controllers are listed by `redfish_storage_drives`.",
+		Description: "This Terraform datasource is used to list the direct-attached disks of a system, i.e. the NVMe and" +
+			" AHCI disks that aren't behind a RAID controller. The disks of RAID controllers are listed by redfish_storage_drives.",
+		Attributes: SimpleStorageDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// SimpleStorageDatasourceSchema to define the simple storage data-source schema
+func SimpleStorageDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the simple storage data-source. It is the URI of the system.",
+			Description:         "ID of the simple storage data-source. It is the URI of the system.",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the system resource. If the value for system ID is not provided, " +
+				"the data source picks the only system available from the iDRAC.",
+			Description: "ID of the system resource. If the value for system ID is not provided, " +
+				"the data source picks the only system available from the iDRAC.",
+			Optional:   true,
+			Computed:   true,
+			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"devices": schema.ListNestedAttribute{
+			MarkdownDescription: "Direct-attached disks of the system.",
+			Description:         "Direct-attached disks of the system.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"controller_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the simple storage or storage subsystem the disk is attached to, e.g. `AHCI.Embedded.1-1`",
+						Description:         "ID of the simple storage or storage subsystem the disk is attached to, e.g. AHCI.Embedded.1-1",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the disk",
+						Description:         "Name of the disk",
+						Computed:            true,
+					},
+					"manufacturer": schema.StringAttribute{
+						MarkdownDescription: "Manufacturer of the disk",
+						Description:         "Manufacturer of the disk",
+						Computed:            true,
+					},
+					"model": schema.StringAttribute{
+						MarkdownDescription: "Model of the disk",
+						Description:         "Model of the disk",
+						Computed:            true,
+					},
+					"capacity_bytes": schema.Int64Attribute{
+						MarkdownDescription: "Capacity of the disk in bytes",
+						Description:         "Capacity of the disk in bytes",
+						Computed:            true,
+					},
+					"health": schema.StringAttribute{
+						MarkdownDescription: "Health of the disk, e.g. `OK`, `Warning` or `Critical`",
+						Description:         "Health of the disk, e.g. OK, Warning or Critical",
+						Computed:            true,
+					},
+					"state": schema.StringAttribute{
+						MarkdownDescription: "State of the disk, e.g. `Enabled` or `Absent`",
+						Description:         "State of the disk, e.g. Enabled or Absent",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *SimpleStorageDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.SimpleStorageDatasource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, err := NewConfig(ctx, g.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	system, err := getSystemResource(api.Service, state.SystemID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to fetch the computer system", err.Error())
+		return
+	}
+	devices, err := getDirectAttachedDevices(system)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to fetch the direct-attached disks", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(system.ODataID)
+	state.SystemID = types.StringValue(system.ID)
+	state.Devices = devices
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// getDirectAttachedDevices lists the devices of the simple storages and the drives of the storage subsystems
+// that aren't RAID controllers. Services listing RAID controllers as simple storages too have them skipped.
+func getDirectAttachedDevices(system *redfish.ComputerSystem) ([]models.DirectAttachedDevice, error) {
+	storages, err := system.Storage()
+	if err != nil {
+		return nil, err
+	}
+	devices := []models.DirectAttachedDevice{}
+	raidControllers := map[string]bool{}
+	for _, storage := range storages {
+		if isRaidStorage(storage) {
+			raidControllers[storage.ID] = true
+			continue
+		}
+		drives, err := storage.Drives()
+		if err != nil {
+			return nil, err
+		}
+		for _, drive := range drives {
+			devices = append(devices, models.DirectAttachedDevice{
+				ControllerID:  types.StringValue(storage.ID),
+				Name:          types.StringValue(drive.Name),
+				Manufacturer:  types.StringValue(drive.Manufacturer),
+				Model:         types.StringValue(drive.Model),
+				CapacityBytes: types.Int64Value(drive.CapacityBytes),
+				Health:        types.StringValue(string(drive.Status.Health)),
+				State:         types.StringValue(string(drive.Status.State)),
+			})
+		}
+	}
+
+	simpleStorages, err := system.SimpleStorages()
+	if err != nil {
+		return nil, err
+	}
+	return append(devices, getSimpleStorageDevices(simpleStorages, raidControllers)...), nil
+}
+
+// getSimpleStorageDevices converts the devices of the simple storages, skipping the RAID controllers.
+func getSimpleStorageDevices(simpleStorages []*redfish.SimpleStorage, raidControllers map[string]bool) []models.DirectAttachedDevice {
+	devices := []models.DirectAttachedDevice{}
+	for _, simpleStorage := range simpleStorages {
+		if raidControllers[simpleStorage.ID] {
+			continue
+		}
+		for _, device := range simpleStorage.Devices {
+			devices = append(devices, models.DirectAttachedDevice{
+				ControllerID:  types.StringValue(simpleStorage.ID),
+				Name:          types.StringValue(device.Name),
+				Manufacturer:  types.StringValue(device.Manufacturer),
+				Model:         types.StringValue(device.Model),
+				CapacityBytes: types.Int64Value(device.CapacityBytes),
+				Health:        types.StringValue(string(device.Status.Health)),
+				State:         types.StringValue(string(device.Status.State)),
+			})
+		}
+	}
+	return devices
+}
+
+// isRaidStorage reports whether one of the controllers of the storage subsystem supports RAID levels.
+func isRaidStorage(storage *redfish.Storage) bool {
+	for _, controller := range storage.StorageControllers {
+		if len(controller.SupportedRAIDTypes) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test case for Simple Storage DataSource
+func TestAccRedfishSimpleStorageDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceSimpleStorageConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.redfish_simple_storage.disks", "id", "/redfish/v1/Systems/System.Embedded.1"),
+					resource.TestCheckResourceAttr("data.redfish_simple_storage.disks", "system_id", "System.Embedded.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetSimpleStorageDevices(t *testing.T) {
+	ahci := &redfish.SimpleStorage{
+		Devices: []redfish.Device{{
+			Name:          "Disk 0 on AHCI Controller",
+			Model:         "MTFDDAV240TDU",
+			CapacityBytes: 240057409536,
+			Status:        redfishcommon.Status{Health: redfishcommon.OKHealth, State: redfishcommon.EnabledState},
+		}},
+	}
+	ahci.ID = "AHCI.Embedded.1-1"
+	raid := &redfish.SimpleStorage{Devices: []redfish.Device{{Name: "Physical Disk 0:1:0"}}}
+	raid.ID = "RAID.Integrated.1-1"
+
+	got := getSimpleStorageDevices([]*redfish.SimpleStorage{ahci, raid}, map[string]bool{"RAID.Integrated.1-1": true})
+	if len(got) != 1 {
+		t.Fatalf("expected the RAID controller to be skipped, got %v", got)
+	}
+	if got[0].ControllerID.ValueString() != "AHCI.Embedded.1-1" || got[0].CapacityBytes.ValueInt64() != 240057409536 ||
+		got[0].Health.ValueString() != "OK" || got[0].State.ValueString() != "Enabled" {
+		t.Errorf("unexpected device %v", got[0])
+	}
+}
+
+func TestIsRaidStorage(t *testing.T) {
+	raid := &redfish.Storage{StorageControllers: []redfish.StorageController{
+		{SupportedRAIDTypes: []redfish.RAIDType{redfish.RAID0RAIDType, redfish.RAID1RAIDType}},
+	}}
+	if !isRaidStorage(raid) {
+		t.Errorf("expected a controller supporting RAID levels to be a RAID storage")
+	}
+	if isRaidStorage(&redfish.Storage{StorageControllers: []redfish.StorageController{{}}}) {
+		t.Errorf("expected a controller without RAID levels not to be a RAID storage")
+	}
+}
+
+func testAccRedfishDataSourceSimpleStorageConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+		
+		data "redfish_simple_storage" "disks" {
+		
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
@@ -118,11 +118,12 @@ func (g *StorageDatasource) Read(ctx context.Context, req datasource.ReadRequest
 	var plan models.StorageDatasource
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
-	api, err := NewConfig(g.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, g.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 	g.ctx = ctx
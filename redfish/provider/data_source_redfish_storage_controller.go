@@ -100,11 +100,12 @@ func (g *StorageControllerDatasource) Read(ctx context.Context, req datasource.R
 	var plan models.StorageControllerDatasource
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
-	api, err := NewConfig(g.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, g.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
 	defer api.Logout()
 	g.ctx = ctx
 	g.service = api.Service
@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+)
+
+var (
+	_ datasource.DataSource              = &StorageControllerApplyTimesDatasource{}
+	_ datasource.DataSourceWithConfigure = &StorageControllerApplyTimesDatasource{}
+)
+
+// NewStorageControllerApplyTimesDatasource is new datasource for the apply times of a storage controller
+func NewStorageControllerApplyTimesDatasource() datasource.DataSource {
+	return &StorageControllerApplyTimesDatasource{}
+}
+
+// StorageControllerApplyTimesDatasource to construct datasource
+type StorageControllerApplyTimesDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *StorageControllerApplyTimesDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*StorageControllerApplyTimesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "storage_controller_apply_times"
+}
+
+// Schema implements datasource.DataSource
+func (*StorageControllerApplyTimesDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query the apply times a storage controller supports for its" +
+			" volume operations, i.e. the values accepted by the `settings_apply_time` of `redfish_storage_volume`.",
+		Description: "This Terraform datasource is used to query the apply times a storage controller supports for its" +
+			" volume operations, i.e. the values accepted by the settings_apply_time of redfish_storage_volume.",
+		Attributes: StorageControllerApplyTimesDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// StorageControllerApplyTimesDatasourceSchema to define the storage controller apply times data-source schema
+func StorageControllerApplyTimesDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage controller apply times data-source. It is the URI of the storage controller.",
+			Description:         "ID of the storage controller apply times data-source. It is the URI of the storage controller.",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the system resource. If the value for system ID is not provided, " +
+				"the data source picks the only system available from the iDRAC.",
+			Description: "ID of the system resource. If the value for system ID is not provided, " +
+				"the data source picks the only system available from the iDRAC.",
+			Optional:   true,
+			Computed:   true,
+			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"storage_controller_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage controller, e.g. `RAID.Integrated.1-1`",
+			Description:         "ID of the storage controller, e.g. RAID.Integrated.1-1",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"apply_times": schema.ListAttribute{
+			MarkdownDescription: "Apply times the storage controller supports for its volume operations, as advertised in" +
+				" `@Redfish.OperationApplyTimeSupport`, e.g. `Immediate`, `OnReset`, `AtMaintenanceWindowStart` or `InMaintenanceWindowOnReset`.",
+			Description: "Apply times the storage controller supports for its volume operations, as advertised in" +
+				" @Redfish.OperationApplyTimeSupport, e.g. Immediate, OnReset, AtMaintenanceWindowStart or InMaintenanceWindowOnReset.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *StorageControllerApplyTimesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.StorageControllerApplyTimesDatasource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, err := NewConfig(ctx, g.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	storage, system, err := getStorage(api.Service, state.SystemID.ValueString(), state.StorageControllerID.ValueString())
+	if errors.Is(err, errNoStorageSubsystem) {
+		resp.Diagnostics.AddError(noStorageSubsystemErrorMsg, err.Error())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to fetch the storage controller", err.Error())
+		return
+	}
+	operationApplyTimes, err := storage.GetOperationApplyTimeValues()
+	if err != nil {
+		resp.Diagnostics.AddError("failed to fetch the apply times of the storage controller", err.Error())
+		return
+	}
+
+	applyTimes, diags := types.ListValueFrom(ctx, types.StringType, getApplyTimeNames(operationApplyTimes))
+	resp.Diagnostics.Append(diags...)
+	state.ID = types.StringValue(storage.ODataID)
+	state.SystemID = types.StringValue(system.ID)
+	state.ApplyTimes = applyTimes
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// getApplyTimeNames converts the apply times advertised by the storage controller.
+func getApplyTimeNames(operationApplyTimes []redfishcommon.OperationApplyTime) []string {
+	names := make([]string, 0, len(operationApplyTimes))
+	for _, applyTime := range operationApplyTimes {
+		names = append(names, string(applyTime))
+	}
+	return names
+}
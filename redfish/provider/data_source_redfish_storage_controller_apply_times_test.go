@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+)
+
+// Test case for Storage Controller Apply Times DataSource
+func TestAccRedfishStorageControllerApplyTimesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceStorageControllerApplyTimesConfig(creds, "RAID.Integrated.1-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.redfish_storage_controller_apply_times.apply_times", "storage_controller_id",
+						"RAID.Integrated.1-1"),
+					resource.TestCheckResourceAttrSet("data.redfish_storage_controller_apply_times.apply_times", "apply_times.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishStorageControllerApplyTimesDataSource_invalidController(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishDataSourceStorageControllerApplyTimesConfig(creds, "Invalid-ID"),
+				ExpectError: regexp.MustCompile("failed to fetch the storage controller"),
+			},
+		},
+	})
+}
+
+func TestGetApplyTimeNames(t *testing.T) {
+	got := getApplyTimeNames([]redfishcommon.OperationApplyTime{
+		redfishcommon.ImmediateApplyTime,
+		redfishcommon.OnResetApplyTime,
+	})
+	if want := []string{"Immediate", "OnReset"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got := getApplyTimeNames(nil); got == nil || len(got) != 0 {
+		t.Errorf("expected an empty list for no apply times, got %v", got)
+	}
+}
+
+func testAccRedfishDataSourceStorageControllerApplyTimesConfig(testingInfo TestingServerCredentials, controllerID string) string {
+	return fmt.Sprintf(`
+		
+		data "redfish_storage_controller_apply_times" "apply_times" {
+		
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  storage_controller_id = "%s"
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		controllerID,
+	)
+}
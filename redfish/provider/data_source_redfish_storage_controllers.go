@@ -0,0 +1,212 @@
+/*
+Copyright (c) 2023-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/stmcginnis/gofish"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &StorageControllersDatasource{}
+	_ datasource.DataSourceWithConfigure = &StorageControllersDatasource{}
+)
+
+// NewStorageControllersDatasource is new datasource for storage controllers
+func NewStorageControllersDatasource() datasource.DataSource {
+	return &StorageControllersDatasource{}
+}
+
+// StorageControllersDatasource to construct datasource
+type StorageControllersDatasource struct {
+	p       *redfishProvider
+	ctx     context.Context
+	service *gofish.Service
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *StorageControllersDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*StorageControllersDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "storage_controllers"
+}
+
+// Schema implements datasource.DataSource
+func (*StorageControllersDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to list the storage controllers of a system." +
+			" The controller IDs fetched from this block can be used as `storage_controller_id` of the storage volume resource.",
+		Description: "This Terraform datasource is used to list the storage controllers of a system." +
+			" The controller IDs fetched from this block can be used as storage_controller_id of the storage volume resource.",
+		Attributes: StorageControllersDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// StorageControllersDatasourceSchema to define the storage controllers data-source schema
+func StorageControllersDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage controllers data-source",
+			Description:         "ID of the storage controllers data-source",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system",
+			Description:         "System ID of the system",
+			Computed:            true,
+			Optional:            true,
+		},
+		"storage_controllers": schema.ListNestedAttribute{
+			MarkdownDescription: "List of storage controllers of the system.",
+			Description:         "List of storage controllers of the system.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: StorageControllerSummarySchema(),
+			},
+			Computed: true,
+		},
+	}
+}
+
+// StorageControllerSummarySchema is a function that returns the schema for a storage controller summary
+func StorageControllerSummarySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"storage_controller_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage controller",
+			Description:         "ID of the storage controller",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the storage controller",
+			Description:         "Name of the storage controller",
+			Computed:            true,
+		},
+		"model": schema.StringAttribute{
+			MarkdownDescription: "Model of the storage controller",
+			Description:         "Model of the storage controller",
+			Computed:            true,
+		},
+		"firmware_version": schema.StringAttribute{
+			MarkdownDescription: "Firmware version of the storage controller",
+			Description:         "Firmware version of the storage controller",
+			Computed:            true,
+		},
+		"supported_raid_types": schema.ListAttribute{
+			MarkdownDescription: "RAID types supported by the storage controller",
+			Description:         "RAID types supported by the storage controller",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"encryption_capability": schema.StringAttribute{
+			MarkdownDescription: "Encryption capability of the storage controller",
+			Description:         "Encryption capability of the storage controller",
+			Computed:            true,
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *StorageControllersDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.StorageControllersDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, err := NewConfig(ctx, g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
+	service := api.Service
+	defer api.Logout()
+	g.ctx = ctx
+	g.service = service
+
+	state, diags := g.readDatasourceRedfishStorageControllers(plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (g *StorageControllersDatasource) readDatasourceRedfishStorageControllers(d models.StorageControllersDatasource) (models.StorageControllersDatasource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	// write the current time as ID
+	d.ID = types.StringValue(fmt.Sprintf("%d", time.Now().Unix()))
+
+	system, err := getSystemResource(g.service, d.SystemID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching computer system", err.Error())
+		return d, diags
+	}
+	d.SystemID = types.StringValue(system.ID)
+
+	storage, err := system.Storage()
+	if err != nil {
+		diags.AddError("Error fetching storage", err.Error())
+		return d, diags
+	}
+
+	d.StorageControllers = make([]models.StorageControllerSummary, 0)
+	for _, s := range storage {
+		dellStorage, err := dell.Storage(s)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error when retrieving storage: %s", s.ID), err.Error())
+			continue
+		}
+		d.StorageControllers = append(d.StorageControllers, newStorageControllerSummary(*dellStorage))
+	}
+
+	return d, diags
+}
+
+// newStorageControllerSummary converts dell.StorageExtended to models.StorageControllerSummary
+func newStorageControllerSummary(extendedStorage dell.StorageExtended) models.StorageControllerSummary {
+	out := models.StorageControllerSummary{
+		ID:                   types.StringValue(extendedStorage.Storage.ID),
+		Name:                 types.StringValue(extendedStorage.Storage.Name),
+		Model:                types.StringValue(""),
+		FirmwareVersion:      types.StringValue(""),
+		SupportedRAIDTypes:   make([]types.String, 0),
+		EncryptionCapability: types.StringValue(extendedStorage.OemData.DellController.EncryptionCapability),
+	}
+	// the first controller describes the storage, as for the storage volume resource
+	if len(extendedStorage.Storage.StorageControllers) > 0 {
+		controller := extendedStorage.Storage.StorageControllers[0]
+		out.Model = types.StringValue(controller.Model)
+		out.FirmwareVersion = types.StringValue(controller.FirmwareVersion)
+		out.SupportedRAIDTypes = newRAIDTypes(controller.SupportedRAIDTypes)
+	}
+	return out
+}
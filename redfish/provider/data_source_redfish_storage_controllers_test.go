@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2021-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRedfishStorageControllersDataSource_fetch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceStorageControllersConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.redfish_storage_controllers.controllers", "system_id"),
+					resource.TestCheckResourceAttrSet("data.redfish_storage_controllers.controllers", "storage_controllers.0.storage_controller_id"),
+					resource.TestCheckResourceAttrSet("data.redfish_storage_controllers.controllers", "storage_controllers.0.model"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishStorageControllersDataSource_readError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(getSystemResource).Return(nil, fmt.Errorf(mockErrorMessage)).Build()
+				},
+				Config:      testAccRedfishDataSourceStorageControllersConfig(creds),
+				ExpectError: regexp.MustCompile(`.*` + mockErrorMessage + `*.`),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func testAccRedfishDataSourceStorageControllersConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "redfish_storage_controllers" "controllers" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+	  }
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
@@ -0,0 +1,259 @@
+/*
+Copyright (c) 2023-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	driveRaidStatusOnline  = "Online"
+	driveRaidStatusForeign = "Foreign"
+)
+
+var (
+	_ datasource.DataSource              = &StorageDrivesDatasource{}
+	_ datasource.DataSourceWithConfigure = &StorageDrivesDatasource{}
+)
+
+// NewStorageDrivesDatasource is new datasource for storage drives
+func NewStorageDrivesDatasource() datasource.DataSource {
+	return &StorageDrivesDatasource{}
+}
+
+// StorageDrivesDatasource to construct datasource
+type StorageDrivesDatasource struct {
+	p       *redfishProvider
+	ctx     context.Context
+	service *gofish.Service
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *StorageDrivesDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*StorageDrivesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "storage_drives"
+}
+
+// Schema implements datasource.DataSource
+func (*StorageDrivesDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to list the drives attached to a storage controller." +
+			" The drive names and IDs fetched from this block can be used as `drives` or `drive_ids` of the storage volume resource.",
+		Description: "This Terraform datasource is used to list the drives attached to a storage controller." +
+			" The drive names and IDs fetched from this block can be used as drives or drive_ids of the storage volume resource.",
+		Attributes: StorageDrivesDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// StorageDrivesDatasourceSchema to define the storage drives data-source schema
+func StorageDrivesDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage drives data-source",
+			Description:         "ID of the storage drives data-source",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system",
+			Description:         "System ID of the system",
+			Computed:            true,
+			Optional:            true,
+		},
+		"storage_controller_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage controller whose drives are listed",
+			Description:         "ID of the storage controller whose drives are listed",
+			Required:            true,
+		},
+		"media_type": schema.StringAttribute{
+			MarkdownDescription: "Only list the drives of this media type. Accepted values: `HDD`, `SSD`, `SMR`.",
+			Description:         "Only list the drives of this media type. Accepted values: HDD, SSD, SMR.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(redfish.HDDMediaType),
+					string(redfish.SSDMediaType),
+					string(redfish.SMRMediaType),
+				),
+			},
+		},
+		"drives": schema.ListNestedAttribute{
+			MarkdownDescription: "List of drives attached to the storage controller.",
+			Description:         "List of drives attached to the storage controller.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: StorageDriveSchema(),
+			},
+			Computed: true,
+		},
+	}
+}
+
+// StorageDriveSchema is a function that returns the schema for a drive
+func StorageDriveSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"drive_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the drive",
+			Description:         "ID of the drive",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the drive",
+			Description:         "Name of the drive",
+			Computed:            true,
+		},
+		"media_type": schema.StringAttribute{
+			MarkdownDescription: "Media type of the drive",
+			Description:         "Media type of the drive",
+			Computed:            true,
+		},
+		"protocol": schema.StringAttribute{
+			MarkdownDescription: "Protocol of the drive",
+			Description:         "Protocol of the drive",
+			Computed:            true,
+		},
+		"capacity_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Capacity of the drive in bytes",
+			Description:         "Capacity of the drive in bytes",
+			Computed:            true,
+		},
+		"block_size_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Block size of the drive in bytes",
+			Description:         "Block size of the drive in bytes",
+			Computed:            true,
+		},
+		"raid_status": schema.StringAttribute{
+			MarkdownDescription: "RAID status of the drive reported by the controller",
+			Description:         "RAID status of the drive reported by the controller",
+			Computed:            true,
+		},
+		"in_volume": schema.BoolAttribute{
+			MarkdownDescription: "Whether the drive is already a member of a volume",
+			Description:         "Whether the drive is already a member of a volume",
+			Computed:            true,
+		},
+		"foreign": schema.BoolAttribute{
+			MarkdownDescription: "Whether the drive holds a foreign configuration",
+			Description:         "Whether the drive holds a foreign configuration",
+			Computed:            true,
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *StorageDrivesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.StorageDrivesDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, err := NewConfig(ctx, g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
+	service := api.Service
+	defer api.Logout()
+	g.ctx = ctx
+	g.service = service
+
+	state, diags := g.readDatasourceRedfishStorageDrives(plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (g *StorageDrivesDatasource) readDatasourceRedfishStorageDrives(d models.StorageDrivesDatasource) (models.StorageDrivesDatasource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	// write the current time as ID
+	d.ID = types.StringValue(fmt.Sprintf("%d", time.Now().Unix()))
+
+	storage, system, err := getStorage(g.service, d.SystemID.ValueString(), d.StorageControllerID.ValueString())
+	if errors.Is(err, errNoStorageSubsystem) {
+		diags.AddError(noStorageSubsystemErrorMsg, err.Error())
+		return d, diags
+	}
+	if err != nil {
+		diags.AddError("Error fetching storage", err.Error())
+		return d, diags
+	}
+	d.SystemID = types.StringValue(system.ID)
+
+	drives, err := storage.Drives()
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error when retrieving drives: %s", storage.ID), err.Error())
+		return d, diags
+	}
+
+	d.Drives = make([]models.StorageDrive, 0)
+	for _, drive := range drives {
+		if !d.MediaType.IsNull() && string(drive.MediaType) != d.MediaType.ValueString() {
+			continue
+		}
+		terraformData, err := newStorageDrive(drive)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error when retrieving drive: %s", drive.ID), err.Error())
+			continue
+		}
+		d.Drives = append(d.Drives, terraformData)
+	}
+
+	return d, diags
+}
+
+// newStorageDrive converts redfish.Drive enriched with Dell OEM data to models.StorageDrive
+func newStorageDrive(drive *redfish.Drive) (models.StorageDrive, error) {
+	dellDrive, err := dell.Drive(drive)
+	if err != nil {
+		return models.StorageDrive{}, err
+	}
+	raidStatus := dellDrive.Oem.Dell.DellPhysicalDisk.RaidStatus
+	return models.StorageDrive{
+		ID:             types.StringValue(drive.ID),
+		Name:           types.StringValue(drive.Name),
+		MediaType:      types.StringValue(string(drive.MediaType)),
+		Protocol:       types.StringValue(string(drive.Protocol)),
+		CapacityBytes:  types.Int64Value(drive.CapacityBytes),
+		BlockSizeBytes: types.Int64Value(int64(drive.BlockSizeBytes)),
+		RaidStatus:     types.StringValue(raidStatus),
+		InVolume:       types.BoolValue(drive.VolumesCount > 0 || raidStatus == driveRaidStatusOnline),
+		Foreign:        types.BoolValue(raidStatus == driveRaidStatusForeign),
+	}, nil
+}
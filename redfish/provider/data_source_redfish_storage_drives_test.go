@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2021-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRedfishStorageDrivesDataSource_fetch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceStorageDrivesConfig(creds, "RAID.Integrated.1-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.redfish_storage_drives.drives", "system_id"),
+					resource.TestCheckResourceAttrSet("data.redfish_storage_drives.drives", "drives.0.drive_id"),
+					resource.TestCheckResourceAttrSet("data.redfish_storage_drives.drives", "drives.0.media_type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishStorageDrivesDataSource_readError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(getSystemResource).Return(nil, fmt.Errorf(mockErrorMessage)).Build()
+				},
+				Config:      testAccRedfishDataSourceStorageDrivesConfig(creds, "RAID.Integrated.1-1"),
+				ExpectError: regexp.MustCompile(`.*` + mockErrorMessage + `*.`),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func testAccRedfishDataSourceStorageDrivesConfig(testingInfo TestingServerCredentials, storageControllerID string) string {
+	return fmt.Sprintf(`
+	data "redfish_storage_drives" "drives" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+		storage_controller_id = "%s"
+	  }
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		storageControllerID,
+	)
+}
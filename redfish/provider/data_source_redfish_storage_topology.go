@@ -0,0 +1,279 @@
+/*
+Copyright (c) 2023-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &StorageTopologyDatasource{}
+	_ datasource.DataSourceWithConfigure = &StorageTopologyDatasource{}
+)
+
+// NewStorageTopologyDatasource is new datasource for storage topology
+func NewStorageTopologyDatasource() datasource.DataSource {
+	return &StorageTopologyDatasource{}
+}
+
+// StorageTopologyDatasource to construct datasource
+type StorageTopologyDatasource struct {
+	p       *redfishProvider
+	ctx     context.Context
+	service *gofish.Service
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *StorageTopologyDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*StorageTopologyDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "storage_topology"
+}
+
+// Schema implements datasource.DataSource
+func (*StorageTopologyDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query every volume of a system across all storage controllers," +
+			" along with the member drives of each volume.",
+		Description: "This Terraform datasource is used to query every volume of a system across all storage controllers," +
+			" along with the member drives of each volume.",
+		Attributes: StorageTopologyDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// StorageTopologyDatasourceSchema to define the storage topology data-source schema
+func StorageTopologyDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage topology data-source",
+			Description:         "ID of the storage topology data-source",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system",
+			Description:         "System ID of the system",
+			Computed:            true,
+			Optional:            true,
+		},
+		"volumes": schema.ListNestedAttribute{
+			MarkdownDescription: "List of volumes fetched from all storage controllers of the system.",
+			Description:         "List of volumes fetched from all storage controllers of the system.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: StorageTopologyVolumeSchema(),
+			},
+			Computed: true,
+		},
+	}
+}
+
+// StorageTopologyVolumeSchema is a function that returns the schema for a volume of the storage topology
+func StorageTopologyVolumeSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"volume_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the volume",
+			Description:         "ID of the volume",
+			Computed:            true,
+		},
+		"volume_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the volume",
+			Description:         "Name of the volume",
+			Computed:            true,
+		},
+		"storage_controller_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage controller hosting the volume",
+			Description:         "ID of the storage controller hosting the volume",
+			Computed:            true,
+		},
+		"raid_type": schema.StringAttribute{
+			MarkdownDescription: "RAID type of the volume",
+			Description:         "RAID type of the volume",
+			Computed:            true,
+		},
+		"capacity_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Capacity of the volume in bytes",
+			Description:         "Capacity of the volume in bytes",
+			Computed:            true,
+		},
+		"physical_disks": schema.ListNestedAttribute{
+			MarkdownDescription: "List of member drives of the volume",
+			Description:         "List of member drives of the volume",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: StorageTopologyPhysicalDiskSchema(),
+			},
+			Computed: true,
+		},
+	}
+}
+
+// StorageTopologyPhysicalDiskSchema is a function that returns the schema for a member drive of a volume
+func StorageTopologyPhysicalDiskSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"drive_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the drive",
+			Description:         "ID of the drive",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the drive",
+			Description:         "Name of the drive",
+			Computed:            true,
+		},
+		"slot": schema.Int64Attribute{
+			MarkdownDescription: "Slot number of the drive",
+			Description:         "Slot number of the drive",
+			Computed:            true,
+		},
+		"serial_number": schema.StringAttribute{
+			MarkdownDescription: "Serial number of the drive",
+			Description:         "Serial number of the drive",
+			Computed:            true,
+		},
+		"capacity_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Capacity of the drive in bytes",
+			Description:         "Capacity of the drive in bytes",
+			Computed:            true,
+		},
+		"media_type": schema.StringAttribute{
+			MarkdownDescription: "Media type of the drive",
+			Description:         "Media type of the drive",
+			Computed:            true,
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *StorageTopologyDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.StorageTopologyDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, err := NewConfig(ctx, g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
+	service := api.Service
+	defer api.Logout()
+	g.ctx = ctx
+	g.service = service
+
+	state, diags := g.readDatasourceRedfishStorageTopology(plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (g *StorageTopologyDatasource) readDatasourceRedfishStorageTopology(d models.StorageTopologyDatasource) (models.StorageTopologyDatasource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	// write the current time as ID
+	d.ID = types.StringValue(fmt.Sprintf("%d", time.Now().Unix()))
+
+	system, err := getSystemResource(g.service, d.SystemID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching computer system", err.Error())
+		return d, diags
+	}
+	d.SystemID = types.StringValue(system.ID)
+
+	storage, err := system.Storage()
+	if err != nil {
+		diags.AddError("Error fetching storage", err.Error())
+		return d, diags
+	}
+
+	d.Volumes = make([]models.StorageTopologyVolume, 0)
+	for _, s := range storage {
+		volumes, err := s.Volumes()
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error when retrieving volumes: %s", s.ID), err.Error())
+			continue
+		}
+		for _, volume := range volumes {
+			terraformData, err := newStorageTopologyVolume(s, volume)
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Error when retrieving member drives of volume: %s", volume.ID), err.Error())
+				continue
+			}
+			d.Volumes = append(d.Volumes, terraformData)
+		}
+	}
+
+	return d, diags
+}
+
+// newStorageTopologyVolume converts redfish.Volume along with its member drives to models.StorageTopologyVolume
+func newStorageTopologyVolume(storage *redfish.Storage, volume *redfish.Volume) (models.StorageTopologyVolume, error) {
+	out := models.StorageTopologyVolume{
+		ID:                  types.StringValue(volume.ID),
+		Name:                types.StringValue(volume.Name),
+		StorageControllerID: types.StringValue(storage.ID),
+		RaidType:            types.StringValue(string(volume.RAIDType)),
+		CapacityBytes:       types.Int64Value(int64(volume.CapacityBytes)),
+		PhysicalDisks:       make([]models.StorageTopologyPhysicalDisk, 0),
+	}
+
+	drives, err := volume.Drives()
+	if err != nil {
+		return out, err
+	}
+	for _, drive := range drives {
+		disk, err := newStorageTopologyPhysicalDisk(drive)
+		if err != nil {
+			return out, err
+		}
+		out.PhysicalDisks = append(out.PhysicalDisks, disk)
+	}
+	return out, nil
+}
+
+// newStorageTopologyPhysicalDisk converts redfish.Drive enriched with Dell OEM data to models.StorageTopologyPhysicalDisk
+func newStorageTopologyPhysicalDisk(drive *redfish.Drive) (models.StorageTopologyPhysicalDisk, error) {
+	dellDrive, err := dell.Drive(drive)
+	if err != nil {
+		return models.StorageTopologyPhysicalDisk{}, err
+	}
+	return models.StorageTopologyPhysicalDisk{
+		ID:            types.StringValue(drive.ID),
+		Name:          types.StringValue(drive.Name),
+		Slot:          types.Int64Value(dellDrive.Oem.Dell.DellPhysicalDisk.Slot),
+		SerialNumber:  types.StringValue(drive.SerialNumber),
+		CapacityBytes: types.Int64Value(drive.CapacityBytes),
+		MediaType:     types.StringValue(string(drive.MediaType)),
+	}, nil
+}
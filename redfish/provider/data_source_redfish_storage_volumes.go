@@ -0,0 +1,253 @@
+/*
+Copyright (c) 2023-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &StorageVolumesDatasource{}
+	_ datasource.DataSourceWithConfigure = &StorageVolumesDatasource{}
+)
+
+// NewStorageVolumesDatasource is new datasource for storage volumes
+func NewStorageVolumesDatasource() datasource.DataSource {
+	return &StorageVolumesDatasource{}
+}
+
+// StorageVolumesDatasource to construct datasource
+type StorageVolumesDatasource struct {
+	p       *redfishProvider
+	ctx     context.Context
+	service *gofish.Service
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *StorageVolumesDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*StorageVolumesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "storage_volumes"
+}
+
+// Schema implements datasource.DataSource
+func (*StorageVolumesDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to list the existing volumes of a storage controller." +
+			" The volume IDs fetched from this block can be used to import the storage volume resource.",
+		Description: "This Terraform datasource is used to list the existing volumes of a storage controller." +
+			" The volume IDs fetched from this block can be used to import the storage volume resource.",
+		Attributes: StorageVolumesDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// StorageVolumesDatasourceSchema to define the storage volumes data-source schema
+func StorageVolumesDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage volumes data-source",
+			Description:         "ID of the storage volumes data-source",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system",
+			Description:         "System ID of the system",
+			Computed:            true,
+			Optional:            true,
+		},
+		"storage_controller_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage controller whose volumes are listed",
+			Description:         "ID of the storage controller whose volumes are listed",
+			Required:            true,
+		},
+		"volumes": schema.ListNestedAttribute{
+			MarkdownDescription: "List of volumes of the storage controller.",
+			Description:         "List of volumes of the storage controller.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: StorageVolumeSchema(),
+			},
+			Computed: true,
+		},
+	}
+}
+
+// StorageVolumeSchema is a function that returns the schema for an existing volume
+func StorageVolumeSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"volume_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the volume, usable as `id` when importing the storage volume resource",
+			Description:         "ID of the volume, usable as id when importing the storage volume resource",
+			Computed:            true,
+		},
+		"volume_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the volume",
+			Description:         "Name of the volume",
+			Computed:            true,
+		},
+		"raid_type": schema.StringAttribute{
+			MarkdownDescription: "RAID type of the volume",
+			Description:         "RAID type of the volume",
+			Computed:            true,
+		},
+		"capacity_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Capacity of the volume in bytes",
+			Description:         "Capacity of the volume in bytes",
+			Computed:            true,
+		},
+		"read_cache_policy": schema.StringAttribute{
+			MarkdownDescription: "Read cache policy of the volume",
+			Description:         "Read cache policy of the volume",
+			Computed:            true,
+		},
+		"write_cache_policy": schema.StringAttribute{
+			MarkdownDescription: "Write cache policy of the volume",
+			Description:         "Write cache policy of the volume",
+			Computed:            true,
+		},
+		"disk_cache_policy": schema.StringAttribute{
+			MarkdownDescription: "Disk cache policy of the volume",
+			Description:         "Disk cache policy of the volume",
+			Computed:            true,
+		},
+		"encrypted": schema.BoolAttribute{
+			MarkdownDescription: "Whether the volume is encrypted",
+			Description:         "Whether the volume is encrypted",
+			Computed:            true,
+		},
+		"drives": schema.ListAttribute{
+			MarkdownDescription: "Names of the member drives of the volume",
+			Description:         "Names of the member drives of the volume",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"drive_ids": schema.ListAttribute{
+			MarkdownDescription: "IDs of the member drives of the volume",
+			Description:         "IDs of the member drives of the volume",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *StorageVolumesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.StorageVolumesDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, err := NewConfig(ctx, g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
+	service := api.Service
+	defer api.Logout()
+	g.ctx = ctx
+	g.service = service
+
+	state, diags := g.readDatasourceRedfishStorageVolumes(plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (g *StorageVolumesDatasource) readDatasourceRedfishStorageVolumes(d models.StorageVolumesDatasource) (models.StorageVolumesDatasource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	// write the current time as ID
+	d.ID = types.StringValue(fmt.Sprintf("%d", time.Now().Unix()))
+
+	storage, system, err := getStorage(g.service, d.SystemID.ValueString(), d.StorageControllerID.ValueString())
+	if errors.Is(err, errNoStorageSubsystem) {
+		diags.AddError(noStorageSubsystemErrorMsg, err.Error())
+		return d, diags
+	}
+	if err != nil {
+		diags.AddError("Error fetching storage", err.Error())
+		return d, diags
+	}
+	d.SystemID = types.StringValue(system.ID)
+
+	volumes, err := storage.Volumes()
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error when retrieving volumes: %s", storage.ID), err.Error())
+		return d, diags
+	}
+
+	d.Volumes = make([]models.StorageVolume, 0)
+	for _, volume := range volumes {
+		terraformData, err := newStorageVolume(volume)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error when retrieving member drives of volume: %s", volume.ID), err.Error())
+			continue
+		}
+		d.Volumes = append(d.Volumes, terraformData)
+	}
+
+	return d, diags
+}
+
+// newStorageVolume converts redfish.Volume to models.StorageVolume, with the same mapping as the storage volume resource
+func newStorageVolume(volume *redfish.Volume) (models.StorageVolume, error) {
+	out := models.StorageVolume{
+		ID:               types.StringValue(volume.ODataID),
+		Name:             types.StringValue(volume.Name),
+		RaidType:         types.StringValue(string(volume.RAIDType)),
+		CapacityBytes:    types.Int64Value(int64(volume.CapacityBytes)),
+		ReadCachePolicy:  types.StringValue(normalizeReadCachePolicy(string(volume.ReadCachePolicy))),
+		WriteCachePolicy: types.StringValue(normalizeWriteCachePolicy(string(volume.WriteCachePolicy))),
+		DiskCachePolicy:  types.StringValue(""),
+		Encrypted:        types.BoolValue(volume.Encrypted),
+		Drives:           make([]types.String, 0),
+		DriveIDs:         make([]types.String, 0),
+	}
+	if volumeExtended, err := dell.Volume(volume); err == nil {
+		out.DiskCachePolicy = types.StringValue(volumeExtended.Oem.Dell.DellVolume.DiskCachePolicy)
+	}
+
+	drives, err := volume.Drives()
+	if err != nil {
+		return out, err
+	}
+	for _, drive := range drives {
+		out.Drives = append(out.Drives, types.StringValue(drive.Name))
+		out.DriveIDs = append(out.DriveIDs, types.StringValue(drive.ID))
+	}
+	return out, nil
+}
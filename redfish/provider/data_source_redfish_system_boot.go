@@ -81,8 +81,8 @@ func SystemBootDatasourceSchema() map[string]schema.Attribute {
 			Computed:            true,
 		},
 		"system_id": schema.StringAttribute{
-			MarkdownDescription: "System ID of the computer system. If not provided, the first system resource is used",
-			Description:         "System ID of the computer system. If not provided, the first system resource is used",
+			MarkdownDescription: "System ID of the computer system. If not provided, the only system resource is used",
+			Description:         "System ID of the computer system. If not provided, the only system resource is used",
 			Optional:            true,
 			Computed:            true,
 			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
@@ -121,11 +121,12 @@ func (g *SystemBootDatasource) Read(ctx context.Context, req datasource.ReadRequ
 	var plan models.SystemBootDataSource
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
-	api, err := NewConfig(g.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, g.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 	state, diags := readRedfishSystemBoot(service, plan)
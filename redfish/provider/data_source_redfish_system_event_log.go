@@ -0,0 +1,222 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var (
+	_ datasource.DataSource              = &SystemEventLogDatasource{}
+	_ datasource.DataSourceWithConfigure = &SystemEventLogDatasource{}
+)
+
+// NewSystemEventLogDatasource is new datasource for the system event log
+func NewSystemEventLogDatasource() datasource.DataSource {
+	return &SystemEventLogDatasource{}
+}
+
+// SystemEventLogDatasource to construct datasource
+type SystemEventLogDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *SystemEventLogDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*SystemEventLogDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "system_event_log"
+}
+
+// Schema implements datasource.DataSource
+func (*SystemEventLogDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to query the entries of the System Event Log (SEL)." +
+			" The information fetched from this block can be further used for resource block.",
+		Description: "This Terraform datasource is used to query the entries of the System Event Log (SEL)." +
+			" The information fetched from this block can be further used for resource block.",
+		Attributes: SystemEventLogDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// SystemEventLogDatasourceSchema to define the System Event Log data-source schema
+func SystemEventLogDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the System Event Log data-source. It is the URI of the SEL log service.",
+			Description:         "ID of the System Event Log data-source. It is the URI of the SEL log service.",
+			Computed:            true,
+		},
+		"severity": schema.StringAttribute{
+			MarkdownDescription: "Only return the entries of this severity. Accepted values are `OK`, `Warning` and `Critical`.",
+			Description:         "Only return the entries of this severity. Accepted values are OK, Warning and Critical.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(redfishcommon.OKHealth),
+					string(redfishcommon.WarningHealth),
+					string(redfishcommon.CriticalHealth),
+				),
+			},
+		},
+		"entries": schema.ListNestedAttribute{
+			MarkdownDescription: "Entries of the System Event Log.",
+			Description:         "Entries of the System Event Log.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "ID of the log entry",
+						Description:         "ID of the log entry",
+						Computed:            true,
+					},
+					"severity": schema.StringAttribute{
+						MarkdownDescription: "Severity of the log entry",
+						Description:         "Severity of the log entry",
+						Computed:            true,
+					},
+					"created": schema.StringAttribute{
+						MarkdownDescription: "Date and time the log entry was created",
+						Description:         "Date and time the log entry was created",
+						Computed:            true,
+					},
+					"message": schema.StringAttribute{
+						MarkdownDescription: "Message of the log entry",
+						Description:         "Message of the log entry",
+						Computed:            true,
+					},
+					"message_id": schema.StringAttribute{
+						MarkdownDescription: "Message ID of the log entry",
+						Description:         "Message ID of the log entry",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *SystemEventLogDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.SystemEventLogDatasource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, err := NewConfig(ctx, g.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	logService, err := getSelLogService(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to fetch the System Event Log", err.Error())
+		return
+	}
+	entries, err := logService.Entries()
+	if err != nil {
+		resp.Diagnostics.AddError("failed to fetch the System Event Log entries", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(logService.ODataID)
+	state.Entries = getSelEntries(entries, state.Severity.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// getSelEntries converts the log entries, keeping only those of the given severity when it is set.
+func getSelEntries(entries []*redfish.LogEntry, severity string) []models.SelEntry {
+	selEntries := make([]models.SelEntry, 0, len(entries))
+	for _, entry := range entries {
+		if severity != "" && !strings.EqualFold(string(entry.Severity), severity) {
+			continue
+		}
+		selEntries = append(selEntries, models.SelEntry{
+			ID:        types.StringValue(entry.ID),
+			Severity:  types.StringValue(string(entry.Severity)),
+			Created:   types.StringValue(entry.Created),
+			Message:   types.StringValue(entry.Message),
+			MessageID: types.StringValue(entry.MessageID),
+		})
+	}
+	return selEntries
+}
+
+// getSelLogService returns the SEL log service, looking at the managers first and then at the systems.
+func getSelLogService(service *gofish.Service) (*redfish.LogService, error) {
+	managers, err := service.Managers()
+	if err != nil {
+		return nil, err
+	}
+	for _, manager := range managers {
+		logServices, err := manager.LogServices()
+		if err != nil {
+			return nil, err
+		}
+		if logService := findSelLogService(logServices); logService != nil {
+			return logService, nil
+		}
+	}
+
+	systems, err := service.Systems()
+	if err != nil {
+		return nil, err
+	}
+	for _, system := range systems {
+		logServices, err := system.LogServices()
+		if err != nil {
+			return nil, err
+		}
+		if logService := findSelLogService(logServices); logService != nil {
+			return logService, nil
+		}
+	}
+	return nil, fmt.Errorf("no System Event Log service was found")
+}
+
+// findSelLogService returns the log service holding SEL entries, or nil when there is none.
+func findSelLogService(logServices []*redfish.LogService) *redfish.LogService {
+	for _, logService := range logServices {
+		if strings.EqualFold(logService.ID, "Sel") || logService.LogEntryType == redfish.SELLogEntryTypes {
+			return logService
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test case for System Event Log DataSource
+func TestAccRedfishSystemEventLogDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceSystemEventLogConfig(creds, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.redfish_system_event_log.sel", "id"),
+				),
+			},
+			{
+				Config: testAccRedfishDataSourceSystemEventLogConfig(creds, `severity = "Critical"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.redfish_system_event_log.sel", "severity", "Critical"),
+				),
+			},
+		},
+	})
+}
+
+// Test case for System Event Log DataSource with invalid severity - Negative
+func TestAccRedfishSystemEventLogDataSource_InvalidSeverity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishDataSourceSystemEventLogConfig(creds, `severity = "Informational"`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func TestGetSelEntries(t *testing.T) {
+	entries := []*redfish.LogEntry{
+		{Severity: redfish.OKEventSeverity, Message: "ok"},
+		{Severity: redfish.CriticalEventSeverity, Message: "critical"},
+		{Severity: redfish.WarningEventSeverity, Message: "warning"},
+	}
+
+	if got := getSelEntries(entries, ""); len(got) != len(entries) {
+		t.Errorf("expected %d entries without filter, got %d", len(entries), len(got))
+	}
+	got := getSelEntries(entries, "Critical")
+	if len(got) != 1 || got[0].Message.ValueString() != "critical" {
+		t.Errorf("expected only the critical entry, got %v", got)
+	}
+	if got := getSelEntries(nil, ""); got == nil || len(got) != 0 {
+		t.Errorf("expected an empty list for no entries, got %v", got)
+	}
+}
+
+func testAccRedfishDataSourceSystemEventLogConfig(testingInfo TestingServerCredentials, severity string) string {
+	return fmt.Sprintf(`
+		
+		data "redfish_system_event_log" "sel" {
+		
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		severity,
+	)
+}
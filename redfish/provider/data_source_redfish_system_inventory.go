@@ -0,0 +1,398 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var (
+	_ datasource.DataSource              = &SystemInventoryDatasource{}
+	_ datasource.DataSourceWithConfigure = &SystemInventoryDatasource{}
+)
+
+// NewSystemInventoryDatasource is new datasource for the system inventory
+func NewSystemInventoryDatasource() datasource.DataSource {
+	return &SystemInventoryDatasource{}
+}
+
+// SystemInventoryDatasource to construct datasource
+type SystemInventoryDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *SystemInventoryDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*SystemInventoryDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "system_inventory"
+}
+
+// Schema implements datasource.DataSource
+func (*SystemInventoryDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to fetch the hardware inventory of a computer system via RedFish." +
+			" The information fetched from this block can be further used for resource block.",
+		Description: "Data source to fetch the hardware inventory of a computer system via RedFish." +
+			" The information fetched from this block can be further used for resource block.",
+		Attributes: SystemInventoryDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// SystemInventoryDatasourceSchema to define the system inventory datasource schema
+func SystemInventoryDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Resource ID of the computer system used.",
+			Description:         "Resource ID of the computer system used.",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the computer system. If not provided, the only system resource is used",
+			Description:         "System ID of the computer system. If not provided, the only system resource is used",
+			Optional:            true,
+			Computed:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"processor_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of physical processors in the system",
+			Description:         "Number of physical processors in the system",
+			Computed:            true,
+		},
+		"logical_processor_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of logical processors in the system",
+			Description:         "Number of logical processors in the system",
+			Computed:            true,
+		},
+		"processor_model": schema.StringAttribute{
+			MarkdownDescription: "Model of the processors in the system",
+			Description:         "Model of the processors in the system",
+			Computed:            true,
+		},
+		"total_memory_gib": schema.Float64Attribute{
+			MarkdownDescription: "Total system memory in GiB",
+			Description:         "Total system memory in GiB",
+			Computed:            true,
+		},
+		"memory_dimms": schema.ListNestedAttribute{
+			MarkdownDescription: "Memory DIMMs of the system",
+			Description:         "Memory DIMMs of the system",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: inventoryMemoryDimmSchema(),
+			},
+		},
+		"network_interfaces": schema.ListNestedAttribute{
+			MarkdownDescription: "Ethernet interfaces of the system",
+			Description:         "Ethernet interfaces of the system",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: inventoryNetworkPortSchema(),
+			},
+		},
+		"pcie_devices": schema.ListNestedAttribute{
+			MarkdownDescription: "PCIe devices of the system",
+			Description:         "PCIe devices of the system",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: inventoryPCIeDeviceSchema(),
+			},
+		},
+		"drive_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of drives attached to the storage controllers of the system",
+			Description:         "Number of drives attached to the storage controllers of the system",
+			Computed:            true,
+		},
+		"total_drive_capacity_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Total capacity of the drives in bytes",
+			Description:         "Total capacity of the drives in bytes",
+			Computed:            true,
+		},
+		"drives": schema.ListNestedAttribute{
+			MarkdownDescription: "Drives attached to the storage controllers of the system",
+			Description:         "Drives attached to the storage controllers of the system",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: StorageDriveSchema(),
+			},
+		},
+	}
+}
+
+func inventoryMemoryDimmSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the DIMM",
+			Description:         "ID of the DIMM",
+			Computed:            true,
+		},
+		"device_locator": schema.StringAttribute{
+			MarkdownDescription: "Location of the DIMM on the board",
+			Description:         "Location of the DIMM on the board",
+			Computed:            true,
+		},
+		"capacity_mib": schema.Int64Attribute{
+			MarkdownDescription: "Capacity of the DIMM in MiB",
+			Description:         "Capacity of the DIMM in MiB",
+			Computed:            true,
+		},
+		"memory_device_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the DIMM, e.g. `DDR4`",
+			Description:         "Type of the DIMM, e.g. DDR4",
+			Computed:            true,
+		},
+		"operating_speed_mhz": schema.Int64Attribute{
+			MarkdownDescription: "Operating speed of the DIMM in MHz",
+			Description:         "Operating speed of the DIMM in MHz",
+			Computed:            true,
+		},
+		"manufacturer": schema.StringAttribute{
+			MarkdownDescription: "Manufacturer of the DIMM",
+			Description:         "Manufacturer of the DIMM",
+			Computed:            true,
+		},
+		"part_number": schema.StringAttribute{
+			MarkdownDescription: "Part number of the DIMM",
+			Description:         "Part number of the DIMM",
+			Computed:            true,
+		},
+		"serial_number": schema.StringAttribute{
+			MarkdownDescription: "Serial number of the DIMM",
+			Description:         "Serial number of the DIMM",
+			Computed:            true,
+		},
+	}
+}
+
+func inventoryNetworkPortSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the ethernet interface",
+			Description:         "ID of the ethernet interface",
+			Computed:            true,
+		},
+		"mac_address": schema.StringAttribute{
+			MarkdownDescription: "Currently configured MAC address of the ethernet interface",
+			Description:         "Currently configured MAC address of the ethernet interface",
+			Computed:            true,
+		},
+		"permanent_mac_address": schema.StringAttribute{
+			MarkdownDescription: "Permanent MAC address assigned to the ethernet interface",
+			Description:         "Permanent MAC address assigned to the ethernet interface",
+			Computed:            true,
+		},
+		"link_status": schema.StringAttribute{
+			MarkdownDescription: "Link status of the ethernet interface",
+			Description:         "Link status of the ethernet interface",
+			Computed:            true,
+		},
+		"speed_mbps": schema.Int64Attribute{
+			MarkdownDescription: "Current speed of the ethernet interface in Mbps",
+			Description:         "Current speed of the ethernet interface in Mbps",
+			Computed:            true,
+		},
+	}
+}
+
+func inventoryPCIeDeviceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the PCIe device",
+			Description:         "ID of the PCIe device",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the PCIe device",
+			Description:         "Name of the PCIe device",
+			Computed:            true,
+		},
+		"manufacturer": schema.StringAttribute{
+			MarkdownDescription: "Manufacturer of the PCIe device",
+			Description:         "Manufacturer of the PCIe device",
+			Computed:            true,
+		},
+		"model": schema.StringAttribute{
+			MarkdownDescription: "Model of the PCIe device",
+			Description:         "Model of the PCIe device",
+			Computed:            true,
+		},
+		"device_type": schema.StringAttribute{
+			MarkdownDescription: "Device type of the PCIe device",
+			Description:         "Device type of the PCIe device",
+			Computed:            true,
+		},
+		"firmware_version": schema.StringAttribute{
+			MarkdownDescription: "Firmware version of the PCIe device",
+			Description:         "Firmware version of the PCIe device",
+			Computed:            true,
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *SystemInventoryDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.SystemInventoryDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, err := NewConfig(ctx, g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
+	service := api.Service
+	defer api.Logout()
+	state, diags := readRedfishSystemInventory(service, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func readRedfishSystemInventory(service *gofish.Service, d models.SystemInventoryDatasource) (models.SystemInventoryDatasource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	system, err := getSystemResource(service, d.SystemID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching computer system", err.Error())
+		return d, diags
+	}
+	d.SystemID = types.StringValue(system.ID)
+	d.ID = types.StringValue(system.ODataID)
+	d.ProcessorCount = types.Int64Value(int64(system.ProcessorSummary.Count))
+	d.LogicalProcessorCount = types.Int64Value(int64(system.ProcessorSummary.LogicalProcessorCount))
+	d.ProcessorModel = types.StringValue(system.ProcessorSummary.Model)
+	d.TotalMemoryGiB = types.Float64Value(float64(system.MemorySummary.TotalSystemMemoryGiB))
+
+	dimms, err := system.Memory()
+	if err != nil {
+		diags.AddError("Error fetching memory", err.Error())
+		return d, diags
+	}
+	d.MemoryDimms = newInventoryMemoryDimms(dimms)
+
+	ethernetInterfaces, err := system.EthernetInterfaces()
+	if err != nil {
+		diags.AddError("Error fetching ethernet interfaces", err.Error())
+		return d, diags
+	}
+	d.NetworkInterfaces = newInventoryNetworkPorts(ethernetInterfaces)
+
+	pcieDevices, err := system.PCIeDevices()
+	if err != nil {
+		diags.AddError("Error fetching PCIe devices", err.Error())
+		return d, diags
+	}
+	d.PCIeDevices = newInventoryPCIeDevices(pcieDevices)
+
+	storages, err := system.Storage()
+	if err != nil {
+		diags.AddError("Error fetching storage", err.Error())
+		return d, diags
+	}
+	d.Drives = make([]models.StorageDrive, 0)
+	var totalCapacity int64
+	for _, storage := range storages {
+		drives, err := storage.Drives()
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error when retrieving drives: %s", storage.ID), err.Error())
+			return d, diags
+		}
+		for _, drive := range drives {
+			terraformData, err := newStorageDrive(drive)
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Error when retrieving drive: %s", drive.ID), err.Error())
+				continue
+			}
+			totalCapacity += drive.CapacityBytes
+			d.Drives = append(d.Drives, terraformData)
+		}
+	}
+	d.DriveCount = types.Int64Value(int64(len(d.Drives)))
+	d.TotalDriveCapacityBytes = types.Int64Value(totalCapacity)
+
+	return d, diags
+}
+
+func newInventoryMemoryDimms(dimms []*redfish.Memory) []models.InventoryMemoryDimm {
+	result := make([]models.InventoryMemoryDimm, 0, len(dimms))
+	for _, dimm := range dimms {
+		result = append(result, models.InventoryMemoryDimm{
+			ID:               types.StringValue(dimm.ID),
+			DeviceLocator:    types.StringValue(dimm.DeviceLocator),
+			CapacityMiB:      types.Int64Value(int64(dimm.CapacityMiB)),
+			MemoryDeviceType: types.StringValue(string(dimm.MemoryDeviceType)),
+			OperatingSpeed:   types.Int64Value(int64(dimm.OperatingSpeedMhz)),
+			Manufacturer:     types.StringValue(dimm.Manufacturer),
+			PartNumber:       types.StringValue(dimm.PartNumber),
+			SerialNumber:     types.StringValue(dimm.SerialNumber),
+		})
+	}
+	return result
+}
+
+func newInventoryNetworkPorts(ethernetInterfaces []*redfish.EthernetInterface) []models.InventoryNetworkPort {
+	result := make([]models.InventoryNetworkPort, 0, len(ethernetInterfaces))
+	for _, ethernetInterface := range ethernetInterfaces {
+		result = append(result, models.InventoryNetworkPort{
+			ID:                  types.StringValue(ethernetInterface.ID),
+			MACAddress:          types.StringValue(ethernetInterface.MACAddress),
+			PermanentMACAddress: types.StringValue(ethernetInterface.PermanentMACAddress),
+			LinkStatus:          types.StringValue(string(ethernetInterface.LinkStatus)),
+			SpeedMbps:           types.Int64Value(int64(ethernetInterface.SpeedMbps)),
+		})
+	}
+	return result
+}
+
+func newInventoryPCIeDevices(pcieDevices []*redfish.PCIeDevice) []models.InventoryPCIeDevice {
+	result := make([]models.InventoryPCIeDevice, 0, len(pcieDevices))
+	for _, device := range pcieDevices {
+		result = append(result, models.InventoryPCIeDevice{
+			ID:              types.StringValue(device.ID),
+			Name:            types.StringValue(device.Name),
+			Manufacturer:    types.StringValue(device.Manufacturer),
+			Model:           types.StringValue(device.Model),
+			DeviceType:      types.StringValue(string(device.DeviceType)),
+			FirmwareVersion: types.StringValue(device.FirmwareVersion),
+		})
+	}
+	return result
+}
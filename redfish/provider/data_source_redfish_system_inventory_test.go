@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test case for System Inventory DataSource
+func TestAccRedfishSystemInventoryDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceSystemInventoryConfig(creds, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.redfish_system_inventory.inventory", "system_id", "System.Embedded.1"),
+					resource.TestCheckResourceAttrSet("data.redfish_system_inventory.inventory", "processor_count"),
+					resource.TestCheckResourceAttrSet("data.redfish_system_inventory.inventory", "drive_count"),
+				),
+			},
+		},
+	})
+}
+
+// Test case for System Inventory DataSource with invalid system ID - Negative
+func TestAccRedfishSystemInventoryDataSource_InvalidSystemID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishDataSourceSystemInventoryConfig(creds, `system_id = "invalid"`),
+				ExpectError: regexp.MustCompile("no computer system found with given system id"),
+			},
+		},
+	})
+}
+
+func TestNewInventoryMemoryDimms(t *testing.T) {
+	dimms := []*redfish.Memory{
+		{DeviceLocator: "A1", CapacityMiB: 16384, MemoryDeviceType: redfish.DDR4MemoryDeviceType},
+	}
+	got := newInventoryMemoryDimms(dimms)
+	if len(got) != 1 || got[0].DeviceLocator.ValueString() != "A1" || got[0].CapacityMiB.ValueInt64() != 16384 ||
+		got[0].MemoryDeviceType.ValueString() != "DDR4" {
+		t.Errorf("unexpected DIMMs: %v", got)
+	}
+	if got := newInventoryMemoryDimms(nil); got == nil || len(got) != 0 {
+		t.Errorf("expected an empty list for no DIMMs, got %v", got)
+	}
+}
+
+func testAccRedfishDataSourceSystemInventoryConfig(testingInfo TestingServerCredentials, systemID string) string {
+	return fmt.Sprintf(`
+		
+		data "redfish_system_inventory" "inventory" {
+		
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		systemID,
+	)
+}
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+)
+
+var (
+	_ datasource.DataSource              = &SystemPowerDatasource{}
+	_ datasource.DataSourceWithConfigure = &SystemPowerDatasource{}
+)
+
+// NewSystemPowerDatasource is new datasource for system power
+func NewSystemPowerDatasource() datasource.DataSource {
+	return &SystemPowerDatasource{}
+}
+
+// SystemPowerDatasource to construct datasource
+type SystemPowerDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *SystemPowerDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*SystemPowerDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "system_power"
+}
+
+// Schema implements datasource.DataSource
+func (*SystemPowerDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to fetch the power state of a computer system via RedFish without changing it." +
+			" The information fetched from this block can be used to decide whether a reboot is needed.",
+		Description: "Data source to fetch the power state of a computer system via RedFish without changing it." +
+			" The information fetched from this block can be used to decide whether a reboot is needed.",
+		Attributes: SystemPowerDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// SystemPowerDatasourceSchema to define the system power datasource schema
+func SystemPowerDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Resource ID of the computer system used.",
+			Description:         "Resource ID of the computer system used.",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the computer system. If not provided, the only system resource is used",
+			Description:         "System ID of the computer system. If not provided, the only system resource is used",
+			Optional:            true,
+			Computed:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"power_state": schema.StringAttribute{
+			MarkdownDescription: "Current power state of the computer system",
+			Description:         "Current power state of the computer system",
+			Computed:            true,
+		},
+		"power_on_time": schema.StringAttribute{
+			MarkdownDescription: "Date and time when the computer system last came out of a reset or was rebooted",
+			Description:         "Date and time when the computer system last came out of a reset or was rebooted",
+			Computed:            true,
+		},
+		"allowable_reset_types": schema.ListAttribute{
+			MarkdownDescription: "Reset types the computer system supports. Empty when the system doesn't advertise them",
+			Description:         "Reset types the computer system supports. Empty when the system doesn't advertise them",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *SystemPowerDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.SystemPowerDataSource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, err := NewConfig(ctx, g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
+	service := api.Service
+	defer api.Logout()
+	state, diags := readRedfishSystemPower(service, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func readRedfishSystemPower(service *gofish.Service, d models.SystemPowerDataSource) (models.SystemPowerDataSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	system, err := getSystemResource(service, d.SystemID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching computer system", err.Error())
+		return d, diags
+	}
+
+	resetTypes := []attr.Value{}
+	for _, resetType := range system.SupportedResetTypes {
+		resetTypes = append(resetTypes, types.StringValue(string(resetType)))
+	}
+
+	d.AllowableResetTypes, diags = types.ListValue(types.StringType, resetTypes)
+	d.PowerState = types.StringValue(string(system.PowerState))
+	d.PowerOnTime = types.StringValue(system.LastResetTime)
+	d.SystemID = types.StringValue(system.ID)
+	d.ID = types.StringValue(system.ODataID)
+
+	return d, diags
+}
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2023-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRedfishSystemPower_fetch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDatasourceSystemPowerConfig(creds, "System.Embedded.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.redfish_system_power.system_power", "system_id", "System.Embedded.1"),
+					resource.TestCheckResourceAttrSet("data.redfish_system_power.system_power", "power_state"),
+					resource.TestCheckResourceAttrSet("data.redfish_system_power.system_power", "allowable_reset_types.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishSystemPower_fetchInvalidID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishDatasourceSystemPowerConfig(creds, "invalid-id"),
+				ExpectError: regexp.MustCompile("Error fetching computer system"),
+			},
+		},
+	})
+}
+
+func testAccRedfishDatasourceSystemPowerConfig(testingInfo TestingServerCredentials, id string) string {
+	return fmt.Sprintf(`
+	data "redfish_system_power" "system_power" {
+		system_id = "%s"
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+	  }
+	`,
+		id,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
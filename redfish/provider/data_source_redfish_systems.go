@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var (
+	_ datasource.DataSource              = &SystemsDatasource{}
+	_ datasource.DataSourceWithConfigure = &SystemsDatasource{}
+)
+
+// NewSystemsDatasource is new datasource for the computer systems
+func NewSystemsDatasource() datasource.DataSource {
+	return &SystemsDatasource{}
+}
+
+// SystemsDatasource to construct datasource
+type SystemsDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *SystemsDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*SystemsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "systems"
+}
+
+// Schema implements datasource.DataSource
+func (*SystemsDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform datasource is used to list the computer systems of the server BMC, e.g. the sleds" +
+			" of a modular chassis, whose IDs are the `system_id` values of the other resources.",
+		Description: "This Terraform datasource is used to list the computer systems of the server BMC, e.g. the sleds" +
+			" of a modular chassis, whose IDs are the system_id values of the other resources.",
+		Attributes: SystemsDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// SystemsDatasourceSchema to define the systems data-source schema
+func SystemsDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the systems data-source. It is the URI of the systems collection.",
+			Description:         "ID of the systems data-source. It is the URI of the systems collection.",
+			Computed:            true,
+		},
+		"systems": schema.ListNestedAttribute{
+			MarkdownDescription: "Computer systems of the server BMC.",
+			Description:         "Computer systems of the server BMC.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "ID of the system, e.g. `System.Embedded.1`",
+						Description:         "ID of the system, e.g. System.Embedded.1",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the system",
+						Description:         "Name of the system",
+						Computed:            true,
+					},
+					"model": schema.StringAttribute{
+						MarkdownDescription: "Model of the system",
+						Description:         "Model of the system",
+						Computed:            true,
+					},
+					"serial_number": schema.StringAttribute{
+						MarkdownDescription: "Serial number of the system",
+						Description:         "Serial number of the system",
+						Computed:            true,
+					},
+					"power_state": schema.StringAttribute{
+						MarkdownDescription: "Power state of the system, e.g. `On` or `Off`",
+						Description:         "Power state of the system, e.g. On or Off",
+						Computed:            true,
+					},
+					"health": schema.StringAttribute{
+						MarkdownDescription: "Health of the system, e.g. `OK`, `Warning` or `Critical`",
+						Description:         "Health of the system, e.g. OK, Warning or Critical",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *SystemsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.SystemsDatasource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, err := NewConfig(ctx, g.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	systems, err := getSystems(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to fetch the computer systems", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(strings.TrimSuffix(api.Service.ODataID, "/") + "/Systems")
+	state.Systems = getSystemSummaries(systems)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// getSystemSummaries converts the computer systems.
+func getSystemSummaries(systems []*redfish.ComputerSystem) []models.SystemSummary {
+	summaries := make([]models.SystemSummary, 0, len(systems))
+	for _, system := range systems {
+		summaries = append(summaries, models.SystemSummary{
+			ID:           types.StringValue(system.ID),
+			Name:         types.StringValue(system.Name),
+			Model:        types.StringValue(system.Model),
+			SerialNumber: types.StringValue(system.SerialNumber),
+			PowerState:   types.StringValue(string(system.PowerState)),
+			Health:       types.StringValue(string(system.Status.Health)),
+		})
+	}
+	return summaries
+}
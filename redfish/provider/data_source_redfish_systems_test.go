@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test case for Systems DataSource
+func TestAccRedfishSystemsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceSystemsConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.redfish_systems.systems", "id", "/redfish/v1/Systems"),
+					resource.TestCheckResourceAttrSet("data.redfish_systems.systems", "systems.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetSystemSummaries(t *testing.T) {
+	system := &redfish.ComputerSystem{
+		Model:        "PowerEdge R750",
+		SerialNumber: "CN1234567890",
+		PowerState:   redfish.OnPowerState,
+		Status:       redfishcommon.Status{Health: redfishcommon.OKHealth},
+	}
+	system.ID = "System.Embedded.1"
+
+	got := getSystemSummaries([]*redfish.ComputerSystem{system})
+	if len(got) != 1 {
+		t.Fatalf("expected 1 system, got %d", len(got))
+	}
+	if got[0].ID.ValueString() != "System.Embedded.1" || got[0].Model.ValueString() != "PowerEdge R750" ||
+		got[0].PowerState.ValueString() != "On" || got[0].Health.ValueString() != "OK" {
+		t.Errorf("unexpected system %v", got[0])
+	}
+	if got := getSystemSummaries(nil); got == nil || len(got) != 0 {
+		t.Errorf("expected an empty list for no systems, got %v", got)
+	}
+}
+
+func testAccRedfishDataSourceSystemsConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+		
+		data "redfish_systems" "systems" {
+		
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
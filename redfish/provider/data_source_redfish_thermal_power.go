@@ -0,0 +1,277 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+var (
+	_ datasource.DataSource              = &ThermalPowerDatasource{}
+	_ datasource.DataSourceWithConfigure = &ThermalPowerDatasource{}
+)
+
+// NewThermalPowerDatasource is new datasource for the thermal and power metrics
+func NewThermalPowerDatasource() datasource.DataSource {
+	return &ThermalPowerDatasource{}
+}
+
+// ThermalPowerDatasource to construct datasource
+type ThermalPowerDatasource struct {
+	p *redfishProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *ThermalPowerDatasource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*redfishProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*ThermalPowerDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "thermal_power"
+}
+
+// Schema implements datasource.DataSource
+func (*ThermalPowerDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to fetch the thermal and power metrics of a chassis via RedFish." +
+			" The information fetched from this block can be further used for resource block.",
+		Description: "Data source to fetch the thermal and power metrics of a chassis via RedFish." +
+			" The information fetched from this block can be further used for resource block.",
+		Attributes: ThermalPowerDatasourceSchema(),
+		Blocks:     RedfishServerDatasourceBlockMap(),
+	}
+}
+
+// ThermalPowerDatasourceSchema to define the thermal and power metrics datasource schema
+func ThermalPowerDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Resource ID of the chassis used.",
+			Description:         "Resource ID of the chassis used.",
+			Computed:            true,
+		},
+		"chassis_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the chassis. If not provided, the first chassis resource is used",
+			Description:         "ID of the chassis. If not provided, the first chassis resource is used",
+			Optional:            true,
+			Computed:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"fans": schema.ListNestedAttribute{
+			MarkdownDescription: "Fan speed sensors of the chassis",
+			Description:         "Fan speed sensors of the chassis",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name":          thermalPowerStringAttribute("Name of the fan"),
+					"reading":       thermalPowerInt64Attribute("Fan speed reading"),
+					"reading_units": thermalPowerStringAttribute("Units of the fan speed reading, e.g. `RPM` or `Percent`"),
+					"health":        thermalPowerStringAttribute("Health of the fan"),
+					"state":         thermalPowerStringAttribute("State of the fan"),
+				},
+			},
+		},
+		"temperatures": schema.ListNestedAttribute{
+			MarkdownDescription: "Temperature sensors of the chassis",
+			Description:         "Temperature sensors of the chassis",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name":             thermalPowerStringAttribute("Name of the temperature sensor"),
+					"reading_celsius":  thermalPowerFloat64Attribute("Temperature reading in degrees Celsius"),
+					"physical_context": thermalPowerStringAttribute("Area or device the temperature sensor measures"),
+					"health":           thermalPowerStringAttribute("Health of the temperature sensor"),
+					"state":            thermalPowerStringAttribute("State of the temperature sensor"),
+				},
+			},
+		},
+		"power_supplies": schema.ListNestedAttribute{
+			MarkdownDescription: "Power supplies of the chassis",
+			Description:         "Power supplies of the chassis",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name":                    thermalPowerStringAttribute("Name of the power supply"),
+					"power_supply_type":       thermalPowerStringAttribute("Type of the power supply, e.g. `AC` or `DC`"),
+					"power_capacity_watts":    thermalPowerFloat64Attribute("Maximum capacity of the power supply in watts"),
+					"last_power_output_watts": thermalPowerFloat64Attribute("Average power output of the power supply in watts"),
+					"health":                  thermalPowerStringAttribute("Health of the power supply"),
+					"state":                   thermalPowerStringAttribute("State of the power supply"),
+				},
+			},
+		},
+		"power_consumed_watts": schema.Float64Attribute{
+			MarkdownDescription: "Current power consumption of the chassis in watts. Null when not reported.",
+			Description:         "Current power consumption of the chassis in watts. Null when not reported.",
+			Computed:            true,
+		},
+		"average_consumed_watts": schema.Float64Attribute{
+			MarkdownDescription: "Average power consumption of the chassis in watts. Null when not reported.",
+			Description:         "Average power consumption of the chassis in watts. Null when not reported.",
+			Computed:            true,
+		},
+	}
+}
+
+func thermalPowerStringAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Description:         description,
+		Computed:            true,
+	}
+}
+
+func thermalPowerInt64Attribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: description,
+		Description:         description,
+		Computed:            true,
+	}
+}
+
+func thermalPowerFloat64Attribute(description string) schema.Float64Attribute {
+	return schema.Float64Attribute{
+		MarkdownDescription: description,
+		Description:         description,
+		Computed:            true,
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *ThermalPowerDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.ThermalPowerDatasource
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, err := NewConfig(ctx, g.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
+	service := api.Service
+	defer api.Logout()
+	state, diags := readRedfishThermalPower(service, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readRedfishThermalPower reads the Thermal and Power resources of the chassis. Platforms that don't
+// expose one of them, or don't populate every sensor, get empty lists and null power readings.
+func readRedfishThermalPower(service *gofish.Service, d models.ThermalPowerDatasource) (models.ThermalPowerDatasource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	chassis, err := getChassisResource(service, d.ChassisID.ValueString())
+	if err != nil {
+		diags.AddError("Error fetching chassis", err.Error())
+		return d, diags
+	}
+	d.ChassisID = types.StringValue(chassis.ID)
+	d.ID = types.StringValue(chassis.ODataID)
+
+	thermal, err := chassis.Thermal()
+	if err != nil {
+		diags.AddError("Error fetching thermal metrics", err.Error())
+		return d, diags
+	}
+	d.Fans, d.Temperatures = newThermalMetrics(thermal)
+
+	power, err := chassis.Power()
+	if err != nil {
+		diags.AddError("Error fetching power metrics", err.Error())
+		return d, diags
+	}
+	d.PowerSupplies, d.PowerConsumedWatts, d.AverageConsumedWatts = newPowerMetrics(power)
+
+	return d, diags
+}
+
+func newThermalMetrics(thermal *redfish.Thermal) ([]models.Fan, []models.Temperature) {
+	fans := make([]models.Fan, 0)
+	temperatures := make([]models.Temperature, 0)
+	if thermal == nil {
+		return fans, temperatures
+	}
+	for _, fan := range thermal.Fans {
+		fans = append(fans, models.Fan{
+			Name:         types.StringValue(fan.Name),
+			Reading:      types.Int64Value(int64(fan.Reading)),
+			ReadingUnits: types.StringValue(string(fan.ReadingUnits)),
+			Health:       types.StringValue(string(fan.Status.Health)),
+			State:        types.StringValue(string(fan.Status.State)),
+		})
+	}
+	for _, temperature := range thermal.Temperatures {
+		temperatures = append(temperatures, models.Temperature{
+			Name:            types.StringValue(temperature.Name),
+			ReadingCelsius:  types.Float64Value(float64(temperature.ReadingCelsius)),
+			PhysicalContext: types.StringValue(string(temperature.PhysicalContext)),
+			Health:          types.StringValue(string(temperature.Status.Health)),
+			State:           types.StringValue(string(temperature.Status.State)),
+		})
+	}
+	return fans, temperatures
+}
+
+func newPowerMetrics(power *redfish.Power) ([]models.PowerSupply, types.Float64, types.Float64) {
+	powerSupplies := make([]models.PowerSupply, 0)
+	consumed, average := types.Float64Null(), types.Float64Null()
+	if power == nil {
+		return powerSupplies, consumed, average
+	}
+	for _, powerSupply := range power.PowerSupplies {
+		powerSupplies = append(powerSupplies, models.PowerSupply{
+			Name:                 types.StringValue(powerSupply.Name),
+			PowerSupplyType:      types.StringValue(string(powerSupply.PowerSupplyType)),
+			PowerCapacityWatts:   types.Float64Value(float64(powerSupply.PowerCapacityWatts)),
+			LastPowerOutputWatts: types.Float64Value(float64(powerSupply.LastPowerOutputWatts)),
+			Health:               types.StringValue(string(powerSupply.Status.Health)),
+			State:                types.StringValue(string(powerSupply.Status.State)),
+		})
+	}
+	for _, powerControl := range power.PowerControl {
+		// the chassis wide reading is reported by the first control that is enabled
+		if powerControl.Status.State != "" && powerControl.Status.State != redfishcommon.EnabledState {
+			continue
+		}
+		consumed = types.Float64Value(float64(powerControl.PowerConsumedWatts))
+		average = types.Float64Value(float64(powerControl.PowerMetrics.AverageConsumedWatts))
+		break
+	}
+	return powerSupplies, consumed, average
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test case for Thermal and Power DataSource
+func TestAccRedfishThermalPowerDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishDataSourceThermalPowerConfig(creds, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.redfish_thermal_power.metrics", "chassis_id"),
+					resource.TestCheckResourceAttrSet("data.redfish_thermal_power.metrics", "fans.#"),
+				),
+			},
+			{
+				Config: testAccRedfishDataSourceThermalPowerConfig(creds, `chassis_id = "System.Embedded.1"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.redfish_thermal_power.metrics", "chassis_id", "System.Embedded.1"),
+					resource.TestCheckResourceAttrSet("data.redfish_thermal_power.metrics", "power_consumed_watts"),
+				),
+			},
+		},
+	})
+}
+
+// Test case for Thermal and Power DataSource with invalid chassis ID - Negative
+func TestAccRedfishThermalPowerDataSource_InvalidChassisID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishDataSourceThermalPowerConfig(creds, `chassis_id = "invalid"`),
+				ExpectError: regexp.MustCompile("no chassis found with given chassis id"),
+			},
+		},
+	})
+}
+
+func TestNewPowerMetrics(t *testing.T) {
+	supplies, consumed, average := newPowerMetrics(nil)
+	if len(supplies) != 0 || !consumed.IsNull() || !average.IsNull() {
+		t.Errorf("expected no power supplies and null readings without a Power resource")
+	}
+
+	power := &redfish.Power{
+		PowerControl: []redfish.PowerControl{
+			{Status: common.Status{State: common.AbsentState}, PowerConsumedWatts: 1},
+			{Status: common.Status{State: common.EnabledState}, PowerConsumedWatts: 250,
+				PowerMetrics: redfish.PowerMetric{AverageConsumedWatts: 230}},
+		},
+		PowerSupplies: []redfish.PowerSupply{{PowerCapacityWatts: 750}},
+	}
+	supplies, consumed, average = newPowerMetrics(power)
+	if len(supplies) != 1 || supplies[0].PowerCapacityWatts.ValueFloat64() != 750 {
+		t.Errorf("unexpected power supplies: %v", supplies)
+	}
+	if consumed.ValueFloat64() != 250 || average.ValueFloat64() != 230 {
+		t.Errorf("expected readings of the enabled power control, got %v and %v", consumed, average)
+	}
+}
+
+func TestNewThermalMetrics(t *testing.T) {
+	fans, temperatures := newThermalMetrics(nil)
+	if len(fans) != 0 || len(temperatures) != 0 {
+		t.Errorf("expected no sensors without a Thermal resource")
+	}
+}
+
+func testAccRedfishDataSourceThermalPowerConfig(testingInfo TestingServerCredentials, chassisID string) string {
+	return fmt.Sprintf(`
+		
+		data "redfish_thermal_power" "metrics" {
+		
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  %s
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		chassisID,
+	)
+}
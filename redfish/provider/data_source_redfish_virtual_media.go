@@ -102,11 +102,12 @@ func (g *DellVirtualMediaDatasource) Read(ctx context.Context, req datasource.Re
 	if state.ID.IsUnknown() {
 		state.ID = types.StringValue("placeholder")
 	}
-	api, err := NewConfig(g.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, g.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(g.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 	diags = readRedfishDellVirtualMediaCollection(service, &state)
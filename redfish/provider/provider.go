@@ -19,9 +19,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"terraform-provider-redfish/mutexkv"
 	"terraform-provider-redfish/redfish/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -48,11 +50,21 @@ var _ provider.Provider = &redfishProvider{}
 
 // New - returns new provider struct definition.
 func New() provider.Provider {
-	return &redfishProvider{}
+	return &redfishProvider{tlsAudit: &tlsAudit{}}
 }
 
 type redfishProvider struct {
 	models.ProviderConfig
+	// tlsAudit collects the TLS warnings of the connections to the servers
+	tlsAudit *tlsAudit
+}
+
+// tlsDiagnostics returns the TLS warnings of the connections opened since the last call
+func (p *redfishProvider) tlsDiagnostics() diag.Diagnostics {
+	if p == nil {
+		return nil
+	}
+	return p.tlsAudit.diagnostics()
 }
 
 // Metadata - provider metadata AKA name.
@@ -101,12 +113,61 @@ func (*redfishProvider) Schema(ctx context.Context, _ provider.SchemaRequest, re
 							Optional:    true,
 							Description: "This field indicates whether the SSL/TLS certificate must be verified or not",
 						},
+						minTLSVersionFieldName: schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: minTLSVersionMD,
+							Description:         minTLSVersionMD,
+							Validators: []validator.String{
+								stringvalidator.OneOf(minTLSVersions...),
+							},
+						},
+						"ca_cert": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: caCertMD,
+							Description:         caCertMD,
+							Validators: []validator.String{
+								caCertValidator{},
+							},
+						},
 					},
 				},
 				Validators: []validator.Map{
 					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
 				},
 			},
+			"http_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds of every request sent to the redfish API. No timeout is applied when not set.",
+				Description:         "Timeout in seconds of every request sent to the redfish API. No timeout is applied when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of times a read request is retried when it fails with a connection error or a 5xx status." +
+					" Requests changing the server, like job submissions, are never retried. Defaults to `0`.",
+				Description: "Number of times a read request is retried when it fails with a connection error or a 5xx status." +
+					" Requests changing the server, like job submissions, are never retried. Defaults to 0.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_delay": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Delay in seconds between two retries of a read request. Defaults to `%d`.", defaultRetryDelay),
+				Description:         fmt.Sprintf("Delay in seconds between two retries of a read request. Defaults to %d.", defaultRetryDelay),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"debug_http": schema.BoolAttribute{
+				MarkdownDescription: "Log the requests sent to the redfish API and their responses at the `DEBUG` level, e.g. with" +
+					" `TF_LOG=DEBUG`. Credentials, session tokens and keys are redacted. Defaults to `false`.",
+				Description: "Log the requests sent to the redfish API and their responses at the DEBUG level, e.g. with" +
+					" TF_LOG=DEBUG. Credentials, session tokens and keys are redacted. Defaults to false.",
+				Optional: true,
+			},
 		},
 	}
 	tflog.Trace(ctx, "resource schema created")
@@ -142,11 +203,14 @@ func (p *redfishProvider) Configure(ctx context.Context, req provider.ConfigureR
 	p.Username = config.Username
 	p.Password = config.Password
 	p.Servers = config.Servers
+	p.HTTPTimeout = config.HTTPTimeout
+	p.RetryCount = config.RetryCount
+	p.RetryDelay = config.RetryDelay
+	p.DebugHTTP = config.DebugHTTP
 
 	resp.ResourceData = p
 	resp.DataSourceData = p
 
-	tflog.Trace(ctx, config.Username.ValueString()+" "+config.Password.ValueString())
 	tflog.Trace(ctx, "Finished configuring the provider")
 }
 
@@ -159,6 +223,7 @@ func (*redfishProvider) Resources(_ context.Context) []func() resource.Resource
 		NewSimpleUpdateResource,
 		NewDellIdracAttributesResource,
 		NewRedfishStorageVolumeResource,
+		NewHotSpareResource,
 		NewBiosResource,
 		NewManagerResetResource,
 		NewBootOrderResource,
@@ -174,6 +239,20 @@ func (*redfishProvider) Resources(_ context.Context) []func() resource.Resource
 		NewRedfishStorageControllerResource,
 		NewRedfishDirectoryServiceAuthProviderResource,
 		NewRedfishDirectoryServiceAuthProviderCertificateResource,
+		NewIdracNetworkResource,
+		NewEventSubscriptionResource,
+		NewClearSelResource,
+		NewDriveIndicatorResource,
+		NewForeignConfigResource,
+		NewResetControllerConfigResource,
+		NewClearJobQueueResource,
+		NewControllerKeyResource,
+		NewConsistencyCheckResource,
+		NewTLSConfigResource,
+		NewBiosResetResource,
+		NewManagerNTPResource,
+		NewSNMPTrapResource,
+		NewSyslogResource,
 	}
 }
 
@@ -183,22 +262,37 @@ func (*redfishProvider) DataSources(_ context.Context) []func() datasource.DataS
 		NewBiosDatasource,
 		NewDellIdracAttributesDatasource,
 		NewStorageDatasource,
+		NewStorageTopologyDatasource,
+		NewStorageControllersDatasource,
+		NewStorageDrivesDatasource,
+		NewStorageVolumesDatasource,
 		NewDellVirtualMediaDatasource,
 		NewSystemBootDatasource,
+		NewSystemPowerDatasource,
 		NewFirmwareInventoryDatasource,
 		NewNICDatasource,
 		NewStorageControllerDatasource,
 		NewDirectoryServiceAuthProviderDatasource,
 		NewDirectoryServiceAuthProviderCertificateDatasource,
+		NewScpExportDatasource,
+		NewSystemEventLogDatasource,
+		NewSystemInventoryDatasource,
+		NewThermalPowerDatasource,
+		NewJobQueueDatasource,
+		NewSystemsDatasource,
+		NewStorageControllerApplyTimesDatasource,
+		NewSimpleStorageDatasource,
 	}
 }
 
 func (*redfishProvider) getProviderServersModelType() map[string]attr.Type {
 	return map[string]attr.Type{
-		fieldNameUser:  types.StringType,
-		fieldNamePass:  types.StringType,
-		"endpoint":     types.StringType,
-		"ssl_insecure": types.BoolType,
+		fieldNameUser:          types.StringType,
+		fieldNamePass:          types.StringType,
+		"endpoint":             types.StringType,
+		"ssl_insecure":         types.BoolType,
+		"ca_cert":              types.StringType,
+		minTLSVersionFieldName: types.StringType,
 	}
 }
 
@@ -215,10 +309,12 @@ func (p *redfishProvider) updateProviderServersByAlias(ctx context.Context, alia
 	}
 	for key, value := range serversMap {
 		serverItemMap := map[string]attr.Value{
-			fieldNameUser:  types.StringValue(value.User.ValueString()),
-			fieldNamePass:  types.StringValue(value.Password.ValueString()),
-			"endpoint":     types.StringValue(value.Endpoint.ValueString()),
-			"ssl_insecure": types.BoolValue(value.SslInsecure.ValueBool()),
+			fieldNameUser:          types.StringValue(value.User.ValueString()),
+			fieldNamePass:          types.StringValue(value.Password.ValueString()),
+			"endpoint":             types.StringValue(value.Endpoint.ValueString()),
+			"ssl_insecure":         types.BoolValue(value.SslInsecure.ValueBool()),
+			"ca_cert":              types.StringValue(value.CACert.ValueString()),
+			minTLSVersionFieldName: types.StringValue(value.MinTLSVersion.ValueString()),
 		}
 		if alias == key {
 			if newPassword != "" {
@@ -19,13 +19,20 @@ package provider
 
 import (
 	"bufio"
+	"context"
+	"crypto/tls"
+	encodingpem "encoding/pem"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
+	"terraform-provider-redfish/redfish/models"
 	"testing"
 
 	"github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -40,6 +47,7 @@ var (
 	virtualMediaTransferProtocolTypeValid   string
 	virtualMediaTransferProtocolTypeInvalid string
 	drive                                   string
+	hotSpareDriveID                         string
 	firmwareUpdateIP                        string
 	firmwareUpdateShareName                 string
 )
@@ -100,6 +108,8 @@ func init() {
 	virtualMediaTransferProtocolTypeInvalid = os.Getenv("TF_TESTING_VIRTUAL_MEDIA_TRANSFER_PROTOCOL_TYPE_INVALID")
 	// storage volume environment varibale
 	drive = os.Getenv("TF_TESTING_STORAGE_VOLUME_DRIVE")
+	// hot spare environment variable
+	hotSpareDriveID = os.Getenv("TF_TESTING_HOT_SPARE_DRIVE_ID")
 	firmwareUpdateIP = os.Getenv("TF_TESTING_FIRMWARE_UPDATE_IP")
 	firmwareUpdateShareName = os.Getenv("TF_TESTING_FIRMWARE_UPDATE_SHARE_NAME")
 }
@@ -174,3 +184,163 @@ func loadEnvFile(path string) (map[string]string, error) {
 	}
 	return envMap, nil
 }
+
+func TestRetryTransport(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newHTTPClient(models.ProviderConfig{
+		RetryCount: types.Int64Value(3),
+		RetryDelay: types.Int64Value(0),
+	}, &tls.Config{})
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls != 3 {
+		t.Errorf("expected success after 3 calls, got status %d after %d calls", resp.StatusCode, calls)
+	}
+
+	// job submissions must not be retried
+	calls = 0
+	resp, err = client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || calls != 1 {
+		t.Errorf("expected a single failed POST, got status %d after %d calls", resp.StatusCode, calls)
+	}
+
+	if newHTTPClient(models.ProviderConfig{}, &tls.Config{}) != nil {
+		t.Errorf("expected the gofish default client when nothing is configured")
+	}
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	caCert := string(encodingpem.EncodeToMemory(&encodingpem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	// the private CA is trusted
+	tlsConfig, err := newTLSConfig(models.RedfishServer{CACert: types.StringValue(caCert)})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp, err := newHTTPClient(models.ProviderConfig{}, tlsConfig).Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the server certificate to be trusted: %s", err)
+	}
+	resp.Body.Close()
+
+	// ssl_insecure wins over ca_cert
+	tlsConfig, err = newTLSConfig(models.RedfishServer{
+		SslInsecure: types.BoolValue(true),
+		CACert:      types.StringValue("not a certificate"),
+	})
+	if err != nil || !tlsConfig.InsecureSkipVerify || tlsConfig.RootCAs != nil {
+		t.Errorf("expected an insecure TLS configuration, got %v, %v", tlsConfig, err)
+	}
+
+	if _, err = newTLSConfig(models.RedfishServer{CACert: types.StringValue("not a certificate")}); err == nil {
+		t.Errorf("expected an error for an invalid ca_cert")
+	}
+}
+
+func TestNewTLSConfigMinVersion(t *testing.T) {
+	// a BMC whose old firmware only offers TLS 1.1
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS11} // #nosec G402
+	server.StartTLS()
+	defer server.Close()
+
+	tlsConfig, err := newTLSConfig(models.RedfishServer{
+		SslInsecure:   types.BoolValue(true),
+		MinTLSVersion: types.StringValue("1.2"),
+	})
+	if err != nil || tlsConfig.MinVersion != tls.VersionTLS12 {
+		t.Fatalf("expected a TLS 1.2 minimum version, got %v, %v", tlsConfig, err)
+	}
+	if resp, err := newHTTPClient(models.ProviderConfig{}, tlsConfig).Get(server.URL); err == nil {
+		resp.Body.Close()
+		t.Errorf("expected the TLS 1.1 connection to be rejected")
+	}
+
+	tlsConfig, err = newTLSConfig(models.RedfishServer{SslInsecure: types.BoolValue(true)})
+	if err != nil || tlsConfig.MinVersion != 0 {
+		t.Errorf("expected the default minimum version when min_tls_version is not set, got %v, %v", tlsConfig, err)
+	}
+}
+
+func TestTLSVersionAuditor(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	caCert := string(encodingpem.EncodeToMemory(&encodingpem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	audit := &tlsAudit{}
+	// every client opens its own connection, the negotiated version is reported once
+	for i := 0; i < 2; i++ {
+		tlsConfig, err := newTLSConfig(models.RedfishServer{CACert: types.StringValue(caCert)})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		tlsConfig.VerifyConnection = tlsVersionAuditor(context.Background(), audit, server.URL)
+		resp, err := newHTTPClient(models.ProviderConfig{}, tlsConfig).Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	diags := audit.diagnostics()
+	if diags.WarningsCount() != 1 || !strings.Contains(diags[0].Detail(), "TLS 1.3") {
+		t.Errorf("expected a single warning naming TLS 1.3, got %v", diags)
+	}
+	if diags = audit.diagnostics(); len(diags) != 0 {
+		t.Errorf("expected the reported warnings to be cleared, got %v", diags)
+	}
+
+	// clients created without a provider don't audit
+	var noAudit *tlsAudit
+	noAudit.warn("key", "summary", "detail")
+	if diags = noAudit.diagnostics(); len(diags) != 0 {
+		t.Errorf("expected no warnings without an audit, got %v", diags)
+	}
+}
+
+func TestRedactHTTPDump(t *testing.T) {
+	dump := "POST /redfish/v1/SessionService/Sessions HTTP/1.1\r\n" +
+		"Host: 10.0.0.1\r\n" +
+		"Authorization: Basic cm9vdDpjYWx2aW4=\r\n" +
+		"X-Auth-Token: 0123456789abcdef\r\n" +
+		"\r\n" +
+		`{"UserName":"root","Password":"ca\"lvin","NewKey":"Test123##","Keyid":"key1"}`
+
+	redacted := redactHTTPDump(dump)
+	for _, secret := range []string{"cm9vdDpjYWx2aW4=", "0123456789abcdef", "lvin", "Test123##"} {
+		if strings.Contains(redacted, secret) {
+			t.Errorf("expected %q to be redacted, got %s", secret, redacted)
+		}
+	}
+	for _, kept := range []string{"Host: 10.0.0.1", `"UserName":"root"`, `"Keyid":"key1"`, "Authorization: [REDACTED]"} {
+		if !strings.Contains(redacted, kept) {
+			t.Errorf("expected %q to be kept, got %s", kept, redacted)
+		}
+	}
+}
@@ -33,11 +33,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 const (
 	createSSLCertAPI = "/Oem/Dell/DelliDRACCardService/Actions/DelliDRACCardService.ImportSSLCertificate"
 	resetSSLCertAPI  = "/Oem/Dell/DelliDRACCardService/Actions/DelliDRACCardService.SSLResetCfg"
+	httpsCertsAPI    = "/NetworkProtocol/HTTPS/Certificates"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -136,6 +138,21 @@ func RedfishSSLCertificateSchema() map[string]schema.Attribute {
 				stringplanmodifier.RequiresReplace(),
 			},
 		},
+		"subject": schema.StringAttribute{
+			MarkdownDescription: "Subject of the certificate currently served by the iDRAC web server.",
+			Description:         "Subject of the certificate currently served by the iDRAC web server.",
+			Computed:            true,
+		},
+		"issuer": schema.StringAttribute{
+			MarkdownDescription: "Issuer of the certificate currently served by the iDRAC web server.",
+			Description:         "Issuer of the certificate currently served by the iDRAC web server.",
+			Computed:            true,
+		},
+		"valid_not_after": schema.StringAttribute{
+			MarkdownDescription: "Expiry date of the certificate currently served by the iDRAC web server.",
+			Description:         "Expiry date of the certificate currently served by the iDRAC web server.",
+			Computed:            true,
+		},
 	}
 }
 
@@ -173,6 +190,14 @@ func (r *certificateResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError(summary, details)
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+
+	// The iDRAC has just restarted, so a failure here must not fail the import itself
+	cert, err := readWebServerCertificate(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Couldn't read the imported certificate", err.Error())
+	}
+	updateCertificateState(&plan, cert)
 
 	tflog.Debug(ctx, "resource_certificate create: updating state finished, saving ...")
 	// Save into State
@@ -183,9 +208,28 @@ func (r *certificateResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 // Read refreshes the Terraform state with the latest data.
-func (*certificateResource) Read(_ context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// read refresh changes nothing
-	resp.State = req.State
+func (r *certificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_certificate read: started")
+
+	var state models.RedfishSSLCertificate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the imported content can't be read back, only the served certificate details are refreshed
+	cert, err := readWebServerCertificate(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("Couldn't read the iDRAC web server certificate", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	updateCertificateState(&state, cert)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_certificate read: finished")
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -226,6 +270,7 @@ func (r *certificateResource) Delete(ctx context.Context, req resource.DeleteReq
 		resp.Diagnostics.AddError(summary, details)
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 
 	resp.State.RemoveResource(ctx)
 	tflog.Trace(ctx, "resource_certificate delete: finished")
@@ -242,7 +287,7 @@ type CertUtilsParam struct {
 
 func certutils(params CertUtilsParam) (ok bool, summary string, details string) {
 	// Get service
-	api, err := NewConfig(params.pconfig, params.rserver)
+	api, err := NewConfig(params.ctx, params.pconfig, params.rserver)
 	if err != nil {
 		return false, ServiceErrorMsg, err.Error()
 	}
@@ -284,3 +329,46 @@ func certutils(params CertUtilsParam) (ok bool, summary string, details string)
 
 	return true, fmt.Sprintf("%v api", params.api), "successful execution"
 }
+
+// readWebServerCertificate returns the certificate currently used by the iDRAC web server
+func readWebServerCertificate(ctx context.Context, pconfig *redfishProvider, rserver *[]models.RedfishServer) (*redfish.Certificate, error) {
+	api, err := NewConfig(ctx, pconfig, rserver)
+	if err != nil {
+		return nil, err
+	}
+	service := api.Service
+	defer api.Logout()
+	managers, err := service.Managers()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't retrieve managers from redfish API: %w", err)
+	}
+	certs, err := redfish.ListReferencedCertificates(service.GetClient(), managers[0].ODataID+httpsCertsAPI)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't retrieve web server certificates: %w", err)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no web server certificate was found")
+	}
+	return certs[0], nil
+}
+
+// updateCertificateState sets the served certificate details, leaving them null when unknown
+func updateCertificateState(state *models.RedfishSSLCertificate, cert *redfish.Certificate) {
+	state.Subject = types.StringNull()
+	state.Issuer = types.StringNull()
+	state.ValidNotAfter = types.StringNull()
+	if cert == nil {
+		return
+	}
+	state.Subject = types.StringValue(certificateIdentifierString(cert.Subject))
+	state.Issuer = types.StringValue(certificateIdentifierString(cert.Issuer))
+	state.ValidNotAfter = types.StringValue(cert.ValidNotAfter)
+}
+
+// certificateIdentifierString prefers the display string reported by the service over the common name
+func certificateIdentifierString(id redfish.CertificateIdentifier) string {
+	if id.DisplayString != "" {
+		return id.DisplayString
+	}
+	return id.CommonName
+}
@@ -21,11 +21,36 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"terraform-provider-redfish/redfish/models"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
+func TestUpdateCertificateState(t *testing.T) {
+	var state models.RedfishSSLCertificate
+	updateCertificateState(&state, &redfish.Certificate{
+		Subject:       redfish.CertificateIdentifier{CommonName: "idrac.example.com"},
+		Issuer:        redfish.CertificateIdentifier{CommonName: "Example CA", DisplayString: "CN=Example CA, O=Example"},
+		ValidNotAfter: "2027-01-01T00:00:00Z",
+	})
+	if state.Subject.ValueString() != "idrac.example.com" {
+		t.Errorf("unexpected subject %s", state.Subject.ValueString())
+	}
+	if state.Issuer.ValueString() != "CN=Example CA, O=Example" {
+		t.Errorf("unexpected issuer %s", state.Issuer.ValueString())
+	}
+	if state.ValidNotAfter.ValueString() != "2027-01-01T00:00:00Z" {
+		t.Errorf("unexpected expiry %s", state.ValidNotAfter.ValueString())
+	}
+
+	updateCertificateState(&state, nil)
+	if !state.Subject.IsNull() || !state.Issuer.IsNull() || !state.ValidNotAfter.IsNull() {
+		t.Errorf("expected null certificate details, got %v", state)
+	}
+}
+
 // test redfish bios settings
 func TestAccRedfishCertificate_basic(t *testing.T) {
 	valid_cert := os.Getenv("VALID_CERT")
@@ -44,6 +69,7 @@ func TestAccRedfishCertificate_basic(t *testing.T) {
 					creds, valid_cert),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("redfish_certificate.cert", "certificate_type", "CustomCertificate"),
+					resource.TestCheckResourceAttrSet("redfish_certificate.cert", "valid_not_after"),
 				),
 			},
 			{
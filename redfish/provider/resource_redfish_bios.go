@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"terraform-provider-redfish/common"
@@ -111,12 +112,20 @@ func (*BiosResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"pending_attributes": schema.MapAttribute{
+				MarkdownDescription: "The Bios attributes which are pending and will be applied at the next reset.",
+				Description:         "The Bios attributes which are pending and will be applied at the next reset.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 			"settings_apply_time": schema.StringAttribute{
 				Optional: true,
-				Description: "The time when the BIOS settings can be applied. Applicable value is 'OnReset' only. " +
-					"In upcoming releases other apply time values will be supported. Default is \"OnReset\".",
+				Description: "The time when the BIOS settings can be applied. Applicable values are 'Immediate' and 'OnReset'. " +
+					"With 'OnReset' the server is reset with reset_type, with 'Immediate' the server applies them on its own. " +
+					"Default is \"OnReset\".",
 				Validators: []validator.String{
 					stringvalidator.OneOf([]string{
+						string(redfishcommon.ImmediateApplyTime),
 						string(redfishcommon.OnResetApplyTime),
 					}...),
 				},
@@ -178,11 +187,12 @@ func (r *BiosResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -210,11 +220,12 @@ func (r *BiosResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -246,11 +257,12 @@ func (r *BiosResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -354,6 +366,14 @@ func (r *BiosResource) updateRedfishDellBiosAttributes(ctx context.Context, serv
 		return nil, diags
 	}
 
+	// Validate the attributes against the BIOS attribute registry when the system exposes it
+	if registry, err := getBiosAttributeRegistry(service, bios); err != nil {
+		tflog.Debug(ctx, "skipping the BIOS attribute registry validation: "+err.Error())
+	} else if err := checkBiosAttributes(registry, attrsPayload); err != nil {
+		diags.AddError("Invalid BIOS attributes", err.Error())
+		return nil, diags
+	}
+
 	resetTimeout := plan.ResetTimeout.ValueInt64()
 	biosConfigJobTimeout := plan.JobTimeout.ValueInt64()
 	resetType := plan.ResetType.ValueString()
@@ -370,17 +390,20 @@ func (r *BiosResource) updateRedfishDellBiosAttributes(ctx context.Context, serv
 		}
 
 		tflog.Info(ctx, "Submitting patch request for bios attributes completed successfully")
-		tflog.Info(ctx, "rebooting the server")
-		// reboot the server
-		pOp := powerOperator{ctx, service, plan.SystemID.ValueString()}
-		_, err := pOp.PowerOperation(resetType, resetTimeout, intervalBiosConfigJobCheckTime)
-		if err != nil {
-			// TODO: handle this scenario
-			diags.AddError("there was an issue restarting the server", err.Error())
-			return nil, diags
-		}
+		// With Immediate the server is reset by the BIOS config job itself
+		if plan.SettingsApplyTime.ValueString() == string(redfishcommon.OnResetApplyTime) {
+			tflog.Info(ctx, "rebooting the server")
+			// reboot the server
+			pOp := powerOperator{ctx, service, plan.SystemID.ValueString()}
+			_, err := pOp.PowerOperation(resetType, resetTimeout, intervalBiosConfigJobCheckTime)
+			if err != nil {
+				// TODO: handle this scenario
+				diags.AddError("there was an issue restarting the server", err.Error())
+				return nil, diags
+			}
 
-		tflog.Info(ctx, "rebooting the server completed successfully")
+			tflog.Info(ctx, "rebooting the server completed successfully")
+		}
 		tflog.Info(ctx, "Waiting for the bios config job to finish")
 
 		// wait for the bios config job to finish
@@ -442,10 +465,122 @@ func (*BiosResource) readRedfishDellBiosAttributes(service *gofish.Service, d *m
 	}
 
 	d.Attributes = types.MapValueMust(types.StringType, attributesTF)
+
+	// Not every BIOS exposes a Settings resource, there is nothing pending then
+	pending, err := getBiosPendingAttributes(bios, attributes)
+	if err != nil {
+		pending = map[string]string{}
+	}
+	pendingTF := make(map[string]attr.Value)
+	for key, value := range pending {
+		pendingTF[key] = types.StringValue(value)
+	}
+	d.PendingAttributes = types.MapValueMust(types.StringType, pendingTF)
+
 	d.ID = types.StringValue(bios.ID)
 	return nil
 }
 
+// getBiosSettingsURI returns the URI of the BIOS Settings resource holding the pending attributes.
+func getBiosSettingsURI(bios *redfish.Bios) (string, error) {
+	oDataURI, err := url.Parse(bios.ODataID)
+	if err != nil {
+		return "", err
+	}
+	oDataURI.Path = path.Join(oDataURI.Path, "Settings")
+	return oDataURI.String(), nil
+}
+
+// getBiosPendingAttributes returns the attributes of the BIOS Settings resource which differ from the current ones.
+func getBiosPendingAttributes(bios *redfish.Bios, current map[string]string) (map[string]string, error) {
+	settingsURI, err := getBiosSettingsURI(bios)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := bios.GetClient().Get(settingsURI)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var settings struct {
+		Attributes map[string]interface{}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&settings); err != nil {
+		return nil, err
+	}
+
+	pending := make(map[string]string)
+	for key, value := range settings.Attributes {
+		strValue, ok := value.(string)
+		if !ok {
+			strValue = fmt.Sprintf("%v", value)
+		}
+		if current[key] != strValue {
+			pending[key] = strValue
+		}
+	}
+	return pending, nil
+}
+
+// getBiosAttributeRegistry returns the attribute registry the BIOS refers to.
+func getBiosAttributeRegistry(service *gofish.Service, bios *redfish.Bios) (*redfish.AttributeRegistry, error) {
+	if bios.AttributeRegistry == "" {
+		return nil, fmt.Errorf("the BIOS doesn't refer to an attribute registry")
+	}
+	registries, err := service.Registries()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range registries {
+		if r.ID == bios.AttributeRegistry && len(r.Location) > 0 {
+			return redfish.GetAttributeRegistry(service.GetClient(), r.Location[0].URI)
+		}
+	}
+	return nil, fmt.Errorf("couldn't find the %s attribute registry", bios.AttributeRegistry)
+}
+
+// checkBiosAttributes validates the attributes against the BIOS attribute registry, collecting all the errors.
+func checkBiosAttributes(registry *redfish.AttributeRegistry, attributes map[string]interface{}) error {
+	entries := make(map[string]redfish.Attribute, len(registry.RegistryEntries.Attributes))
+	for _, a := range registry.RegistryEntries.Attributes {
+		entries[a.AttributeName] = a
+	}
+
+	var errs []string
+	for key, value := range attributes {
+		entry, ok := entries[key]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%s - attribute not found in the registry", key))
+			continue
+		}
+		if entry.ReadOnly || entry.Immutable {
+			errs = append(errs, fmt.Sprintf("%s - attribute is read-only", key))
+			continue
+		}
+		if entry.Type != redfish.EnumerationAttributeType {
+			continue
+		}
+		allowed := make([]string, 0, len(entry.Value))
+		found := false
+		for _, v := range entry.Value {
+			if v.ValueName == fmt.Sprintf("%v", value) {
+				found = true
+				break
+			}
+			allowed = append(allowed, v.ValueName)
+		}
+		if !found {
+			errs = append(errs, fmt.Sprintf("%s - value %v must be one of: %s", key, value, strings.Join(allowed, ", ")))
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
 func copyBiosAttributes(bios *redfish.Bios, attributes map[string]string) error {
 	// TODO: BIOS Attributes' values might be any of several types.
 	// terraform-sdk currently does not support a map with different
@@ -526,13 +661,11 @@ func (r *BiosResource) patchBiosAttributes(d *models.Bios, bios *redfish.Bios, a
 		"ApplyTime": settingsApplyTime,
 	}
 
-	oDataURI, err := url.Parse(bios.ODataID)
+	settingsObjectURI, err := getBiosSettingsURI(bios)
 	if err != nil {
 		tflog.Trace(r.ctx, "error fetching data: "+err.Error())
 		return "", err
 	}
-	oDataURI.Path = path.Join(oDataURI.Path, "Settings")
-	settingsObjectURI := oDataURI.String()
 
 	resp, err := bios.GetClient().Patch(settingsObjectURI, payload)
 	if err != nil {
@@ -0,0 +1,369 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"terraform-provider-redfish/common"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource = &biosResetResource{}
+)
+
+const (
+	// biosResetDefaults resets the BIOS attributes to their defaults through the standard ResetBios action
+	biosResetDefaults = "Defaults"
+	// biosResetClearNVRAM clears the NVRAM through the OEM action of the BIOS, where the platform exposes it
+	biosResetClearNVRAM = "ClearNVRAM"
+)
+
+// NewBiosResetResource is a helper function to simplify the provider implementation.
+func NewBiosResetResource() resource.Resource {
+	return &biosResetResource{}
+}
+
+// biosResetResource is the resource implementation.
+type biosResetResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *biosResetResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_bios_reset configured")
+}
+
+// Metadata returns the resource type name.
+func (*biosResetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "bios_reset"
+}
+
+// BiosResetSchema to design the schema for BIOS reset resource.
+func BiosResetSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the BIOS reset resource. It is the URI of the BIOS.",
+			Description:         "ID of the BIOS reset resource. It is the URI of the BIOS.",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system",
+			Description:         "System ID of the system",
+			Computed:            true,
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"reset_mode": schema.StringAttribute{
+			MarkdownDescription: "What is reset. Accepted values: `Defaults` restores the default BIOS settings through the" +
+				" `ResetBios` action, `ClearNVRAM` clears the NVRAM, i.e. restores the factory defaults, through the OEM action" +
+				" of the BIOS where the platform exposes it. Default is `Defaults`.",
+			Description: "What is reset. Accepted values: Defaults restores the default BIOS settings through the" +
+				" ResetBios action, ClearNVRAM clears the NVRAM, i.e. restores the factory defaults, through the OEM action" +
+				" of the BIOS where the platform exposes it. Default is Defaults.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(biosResetDefaults),
+			Validators: []validator.String{
+				stringvalidator.OneOf(biosResetDefaults, biosResetClearNVRAM),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"reset_type": schema.StringAttribute{
+			MarkdownDescription: "Reset type of the server reboot applying the BIOS reset. Accepted values: `ForceRestart`," +
+				" `GracefulRestart`, `PowerCycle`. Default is `GracefulRestart`.",
+			Description: "Reset type of the server reboot applying the BIOS reset. Accepted values: ForceRestart," +
+				" GracefulRestart, PowerCycle. Default is GracefulRestart.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(string(redfish.GracefulRestartResetType)),
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(redfish.ForceRestartResetType),
+					string(redfish.GracefulRestartResetType),
+					string(redfish.PowerCycleResetType),
+				),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"reset_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Time in seconds that the provider waits for the server to be reset. Default is `120`.",
+			Description:         "Time in seconds that the provider waits for the server to be reset. Default is 120.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(int64(defaultBiosConfigServerResetTimeout)),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"bios_job_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Time in seconds that the provider waits for the BIOS configuration job applying the reset," +
+				" when the platform creates one. Default is `1200`.",
+			Description: "Time in seconds that the provider waits for the BIOS configuration job applying the reset," +
+				" when the platform creates one. Default is 1200.",
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(int64(defaultBiosConfigJobTimeout)),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"triggers": schema.MapAttribute{
+			MarkdownDescription: "Arbitrary values that trigger a new reset of the BIOS whenever they change.",
+			Description:         "Arbitrary values that trigger a new reset of the BIOS whenever they change.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*biosResetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to reset the BIOS of the server to its defaults, or to clear its NVRAM," +
+			" and to reboot the server to apply it. All the BIOS settings are lost.",
+		Description: "This resource is used to reset the BIOS of the server to its defaults, or to clear its NVRAM," +
+			" and to reboot the server to apply it. All the BIOS settings are lost.",
+		Attributes: BiosResetSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *biosResetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_bios_reset create: started")
+	var plan models.BiosReset
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(resetBios(ctx, api.Service, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_bios_reset create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (*biosResetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_bios_reset read: started")
+	var state models.BiosReset
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resetting the BIOS is a one-time action, there is nothing to refresh
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_bios_reset read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (*biosResetResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Update should never happen, it will destroy and create in case of update
+	resp.Diagnostics.AddError(
+		"Error updating BIOS Reset.",
+		"An update plan of BIOS Reset should never be invoked. This resource is supposed to be replaced on update.",
+	)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (*biosResetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_bios_reset delete: started")
+	var state models.BiosReset
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_bios_reset delete: finished")
+}
+
+// resetBios invokes the BIOS reset action of the system, reboots the server to apply it and waits for the
+// BIOS configuration job when the platform creates one.
+func resetBios(ctx context.Context, service *gofish.Service, d *models.BiosReset) (diags diag.Diagnostics) {
+	biosResetError := "Error when resetting the BIOS"
+
+	// Lock the mutex to avoid race conditions with other resources
+	redfishMutexKV.Lock(d.RedfishServer[0].Endpoint.ValueString())
+	defer redfishMutexKV.Unlock(d.RedfishServer[0].Endpoint.ValueString())
+
+	system, err := getSystemResource(service, d.SystemID.ValueString())
+	if err != nil {
+		diags.AddError("error fetching system resource", err.Error())
+		return diags
+	}
+	d.SystemID = types.StringValue(system.ID)
+	if err := checkResetTypeSupported(system, d.ResetType.ValueString()); err != nil {
+		diags.AddError(biosResetError, err.Error())
+		return diags
+	}
+
+	bios, err := system.Bios()
+	if err != nil {
+		diags.AddError("error fetching bios resource", err.Error())
+		return diags
+	}
+	d.ID = types.StringValue(bios.ODataID)
+
+	response, err := service.GetClient().Get(bios.ODataID)
+	if err != nil {
+		diags.AddError(biosResetError, err.Error())
+		return diags
+	}
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close() // #nosec G104
+	if err != nil {
+		diags.AddError(biosResetError, err.Error())
+		return diags
+	}
+	target, err := biosResetTarget(body, d.ResetMode.ValueString())
+	if err != nil {
+		diags.AddError(biosResetError, err.Error())
+		return diags
+	}
+
+	tflog.Info(ctx, "resetting the BIOS", map[string]interface{}{"mode": d.ResetMode.ValueString(), "target": target})
+	response, err = service.GetClient().Post(target, struct{}{})
+	if err != nil {
+		diags.AddError(biosResetError, err.Error())
+		return diags
+	}
+	response.Body.Close() // #nosec G104
+	jobURI := ""
+	if location, err := response.Location(); err == nil {
+		jobURI = location.EscapedPath()
+	}
+
+	tflog.Info(ctx, "rebooting the server")
+	pOp := powerOperator{ctx, service, system.ID}
+	if _, err := pOp.PowerOperation(d.ResetType.ValueString(), d.ResetTimeout.ValueInt64(), intervalBiosConfigJobCheckTime); err != nil {
+		diags.AddError("there was an issue restarting the server", err.Error())
+		return diags
+	}
+
+	if jobURI != "" {
+		tflog.Info(ctx, "Waiting for the BIOS reset job to finish")
+		err = common.WaitForTaskToFinishWithContext(ctx, service, jobURI, intervalBiosConfigJobCheckTime, d.JobTimeout.ValueInt64())
+		if err != nil {
+			diags.AddError("error waiting for the BIOS reset job to be completed", err.Error())
+		}
+	}
+	return diags
+}
+
+// biosResetTarget returns the target of the action of the BIOS body resetting it in mode: the standard ResetBios
+// action for Defaults, or the OEM action clearing the NVRAM for ClearNVRAM.
+func biosResetTarget(body []byte, mode string) (string, error) {
+	type action struct {
+		Target string `json:"target"`
+	}
+	var bios struct {
+		Actions struct {
+			ResetBios action            `json:"#Bios.ResetBios"`
+			Oem       map[string]action `json:"Oem"`
+		} `json:"Actions"`
+	}
+	if err := json.Unmarshal(body, &bios); err != nil {
+		return "", err
+	}
+
+	if mode != biosResetClearNVRAM {
+		if bios.Actions.ResetBios.Target == "" {
+			return "", fmt.Errorf("the BIOS doesn't expose the ResetBios action")
+		}
+		return bios.Actions.ResetBios.Target, nil
+	}
+
+	names := make([]string, 0, len(bios.Actions.Oem))
+	for name, oemAction := range bios.Actions.Oem {
+		if strings.Contains(strings.ToLower(name), strings.ToLower(biosResetClearNVRAM)) && oemAction.Target != "" {
+			return oemAction.Target, nil
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("the BIOS doesn't expose an OEM action clearing the NVRAM")
+	}
+	sort.Strings(names)
+	return "", fmt.Errorf("the BIOS doesn't expose an OEM action clearing the NVRAM, its OEM actions are: %s",
+		strings.Join(names, ", "))
+}
@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to reset the BIOS to its defaults
+func TestAccRedfishBiosReset_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceBiosResetConfig(creds, "Defaults"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("redfish_bios_reset.reset", "id"),
+					resource.TestCheckResourceAttr("redfish_bios_reset.reset", "reset_mode", "Defaults"),
+				),
+			},
+		},
+	})
+}
+
+// Test to reset the BIOS with an invalid mode - Negative
+func TestAccRedfishBiosReset_InvalidMode(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceBiosResetConfig(creds, "Invalid"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func TestBiosResetTarget(t *testing.T) {
+	body := []byte(`{
+		"Actions": {
+			"#Bios.ResetBios": {"target": "/redfish/v1/Systems/System.Embedded.1/Bios/Actions/Bios.ResetBios"},
+			"Oem": {
+				"#DellBios.ClearPending": {"target": "/redfish/v1/Systems/System.Embedded.1/Bios/Actions/Oem/DellBios.ClearPending"},
+				"#DellBios.ClearNVRAM": {"target": "/redfish/v1/Systems/System.Embedded.1/Bios/Actions/Oem/DellBios.ClearNVRAM"}
+			}
+		}
+	}`)
+
+	target, err := biosResetTarget(body, biosResetDefaults)
+	if err != nil || !strings.HasSuffix(target, "/Bios.ResetBios") {
+		t.Errorf("expected the ResetBios target, got %q, %v", target, err)
+	}
+	target, err = biosResetTarget(body, biosResetClearNVRAM)
+	if err != nil || !strings.HasSuffix(target, "/DellBios.ClearNVRAM") {
+		t.Errorf("expected the ClearNVRAM target, got %q, %v", target, err)
+	}
+
+	withoutNVRAM := []byte(`{"Actions": {"Oem": {"#DellBios.ClearPending": {"target": "/clear"}}}}`)
+	if _, err = biosResetTarget(withoutNVRAM, biosResetClearNVRAM); err == nil || !strings.Contains(err.Error(), "#DellBios.ClearPending") {
+		t.Errorf("expected an error listing the OEM actions, got %v", err)
+	}
+	if _, err = biosResetTarget(withoutNVRAM, biosResetDefaults); err == nil {
+		t.Errorf("expected an error when the BIOS doesn't expose ResetBios")
+	}
+}
+
+func testAccRedfishResourceBiosResetConfig(testingInfo TestingServerCredentials, mode string) string {
+	return fmt.Sprintf(`
+	resource "redfish_bios_reset" "reset" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+
+		reset_mode = "%s"
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		mode,
+	)
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 // test redfish bios settings
@@ -100,6 +101,30 @@ func TestAccRedfishBios_17Gbasic(t *testing.T) {
 	}
 }
 
+func TestCheckBiosAttributes(t *testing.T) {
+	registry := &redfish.AttributeRegistry{RegistryEntries: redfish.RegistryEntries{Attributes: []redfish.Attribute{
+		{AttributeName: "NumLock", Type: redfish.EnumerationAttributeType, Value: []redfish.AttributeValue{{ValueName: "On"}, {ValueName: "Off"}}},
+		{AttributeName: "AcPwrRcvryUserDelay", Type: redfish.IntegerAttributeType},
+		{AttributeName: "SystemServiceTag", Type: redfish.StringAttributeType, ReadOnly: true},
+	}}}
+
+	if err := checkBiosAttributes(registry, map[string]interface{}{"NumLock": "Off", "AcPwrRcvryUserDelay": 60}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	tests := map[string]map[string]interface{}{
+		"unknown attribute":   {"NumLocks": "Off"},
+		"invalid enumeration": {"NumLock": "Maybe"},
+		"read-only attribute": {"SystemServiceTag": "ABC1234"},
+	}
+	for name, attributes := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := checkBiosAttributes(registry, attributes); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
 func TestAccRedfishBios_InvalidSettings(t *testing.T) {
 	version := os.Getenv("TF_TESTING_REDFISH_VERSION")
 	if version == "17" {
@@ -191,11 +191,12 @@ func (r *BootOrderResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -231,11 +232,12 @@ func (r *BootOrderResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -274,11 +276,12 @@ func (r *BootOrderResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -289,7 +292,8 @@ func (r *BootOrderResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	diags = r.readRedfishBootAttributes(system, &newState, &state)
-	if diags.HasError() {
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -504,6 +508,27 @@ func (r *BootOrderResource) updateBootOptions(service *gofish.Service, d *models
 	if diags.HasError() {
 		return nil, diags
 	}
+	existing := make([]string, 0, len(bootOptions))
+	for _, option := range bootOptions {
+		existing = append(existing, option.BootOptionReference)
+	}
+	for _, ele := range serverBootOptions {
+		ref := ele.BootOptionReference.ValueString()
+		found := false
+		for _, e := range existing {
+			if e == ref {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diags.AddError("Invalid boot option reference",
+				fmt.Sprintf("boot option %s doesn't exist, the existing boot options are: %s", ref, strings.Join(existing, ", ")))
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
 	for _, ele := range serverBootOptions {
 		payload.BootOptionEnabled = ele.BootOptionEnabled.ValueBool()
 		finalURL := fmt.Sprintf("%s/%s", url, ele.BootOptionReference.ValueString())
@@ -525,26 +550,16 @@ func (*BootOrderResource) setBootOrder(service *gofish.Service, d *models.BootOr
 	}
 
 	boot := system.Boot
-	// get existing boot order
-	existingBootOrder := boot.BootOrder
 	newBootOrder := d.BootOrder.Elements()
 
 	// compare two boot orders
 	if len(newBootOrder) > 0 {
+		order := make([]string, 0, len(newBootOrder))
 		for _, d := range newBootOrder {
-			flag := false
-			for _, val := range existingBootOrder {
-				if strings.Trim(d.String(), "\"") == val {
-					flag = true
-				}
-			}
-			if !flag {
-				return nil, fmt.Errorf("new boot order and old boot order must be equal")
-			}
+			order = append(order, strings.Trim(d.String(), "\""))
 		}
-		// check if all boot devices are present
-		if len(newBootOrder) != len(existingBootOrder) {
-			return nil, fmt.Errorf("unable to complete the operation because all boot devices are required for this operation")
+		if err := validateBootOrder(order, boot.BootOrder); err != nil {
+			return nil, err
 		}
 	}
 
@@ -585,6 +600,36 @@ func (*BootOrderResource) setBootOrder(service *gofish.Service, d *models.BootOr
 	return resp, nil
 }
 
+// validateBootOrder checks the new boot order references every existing boot option exactly once.
+func validateBootOrder(newBootOrder, existingBootOrder []string) error {
+	existing := make(map[string]bool, len(existingBootOrder))
+	for _, ref := range existingBootOrder {
+		existing[ref] = true
+	}
+	seen := make(map[string]bool, len(newBootOrder))
+	for _, ref := range newBootOrder {
+		if !existing[ref] {
+			return fmt.Errorf("boot option %s doesn't exist, the existing boot options are: %s", ref, strings.Join(existingBootOrder, ", "))
+		}
+		if seen[ref] {
+			return fmt.Errorf("boot option %s is referenced more than once", ref)
+		}
+		seen[ref] = true
+	}
+	// check if all boot devices are present
+	if len(newBootOrder) != len(existingBootOrder) {
+		missing := []string{}
+		for _, ref := range existingBootOrder {
+			if !seen[ref] {
+				missing = append(missing, ref)
+			}
+		}
+		return fmt.Errorf("unable to complete the operation because all boot devices are required for this operation,"+
+			" missing: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
 func (r *BootOrderResource) updateServer(service *gofish.Service, plan models.BootOrder) (*models.BootOrder, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	// Fetch Updated details
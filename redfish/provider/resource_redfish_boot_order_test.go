@@ -205,3 +205,28 @@ func testAccRedfishResourceBootOptions(testingInfo TestingServerCredentials, boo
 		bootOptionEnabled,
 	)
 }
+
+func TestValidateBootOrder(t *testing.T) {
+	existing := []string{"Boot0001", "Boot0002", "Boot0003"}
+	tests := []struct {
+		name  string
+		order []string
+		valid bool
+	}{
+		{"reordered", []string{"Boot0003", "Boot0001", "Boot0002"}, true},
+		{"unknown option", []string{"Boot0003", "Boot0001", "Boot0009"}, false},
+		{"duplicate option", []string{"Boot0003", "Boot0003", "Boot0001"}, false},
+		{"missing option", []string{"Boot0003", "Boot0001"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBootOrder(tt.order, existing)
+			if tt.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
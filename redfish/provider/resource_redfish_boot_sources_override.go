@@ -237,11 +237,12 @@ func (r *BootSourceOverrideResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -277,13 +278,54 @@ func (*BootSourceOverrideResource) Update(ctx context.Context, req resource.Upda
 	tflog.Trace(ctx, "resource_Boot_source update: finish")
 }
 
-// Delete implements resource.Resource.
-func (*BootSourceOverrideResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+// Delete resets the boot source override to Disabled.
+func (r *BootSourceOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_boot_source delete : Started")
+	var state models.BootSourceOverride
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	// Lock the mutex to avoid race conditions with other resources
+	redfishMutexKV.Lock(state.RedfishServer[0].Endpoint.ValueString())
+	defer redfishMutexKV.Unlock(state.RedfishServer[0].Endpoint.ValueString())
+
+	system, err := getSystemResource(api.Service, state.SystemID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("[ERROR]: Failed to get system resource", err.Error())
+		return
+	}
+
+	if system.Boot.BootSourceOverrideEnabled != redfish.DisabledBootSourceOverrideEnabled {
+		payload := map[string]interface{}{
+			"Boot": map[string]interface{}{
+				"BootSourceOverrideEnabled": redfish.DisabledBootSourceOverrideEnabled,
+			},
+		}
+		res, err := api.Service.GetClient().Patch(system.ODataID, payload)
+		if err != nil {
+			resp.Diagnostics.AddError("Cannot disable the boot source override", err.Error())
+			return
+		}
+		res.Body.Close()
+	}
+
 	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_boot_source delete: finish")
 }
 
-// Read implements resource.Resource.
-func (*BootSourceOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+// Read refreshes the boot source override from the system.
+func (r *BootSourceOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	tflog.Trace(ctx, "resource_boot_source read : Started")
 	// Get Plan Data
 	var state models.BootSourceOverride
@@ -292,10 +334,44 @@ func (*BootSourceOverrideResource) Read(ctx context.Context, req resource.ReadRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	system, err := getSystemResource(api.Service, state.SystemID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("[ERROR]: Failed to get system resource", err.Error())
+		return
+	}
+	refreshBootSourceOverride(&state, system.Boot)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	tflog.Trace(ctx, "resource_Boot_source Read: finish")
 }
 
+// refreshBootSourceOverride updates the configured override attributes with the current boot settings of the system.
+// A Once override is consumed by the boot it was set for, it is kept as is once the system disabled it.
+func refreshBootSourceOverride(state *models.BootSourceOverride, boot redfish.Boot) {
+	if state.BootSourceOverrideEnabled.ValueString() == string(redfish.OnceBootSourceOverrideEnabled) &&
+		boot.BootSourceOverrideEnabled == redfish.DisabledBootSourceOverrideEnabled {
+		return
+	}
+	if !state.BootSourceOverrideEnabled.IsNull() {
+		state.BootSourceOverrideEnabled = types.StringValue(string(boot.BootSourceOverrideEnabled))
+	}
+	if !state.BootSourceOverrideTarget.IsNull() {
+		state.BootSourceOverrideTarget = types.StringValue(string(boot.BootSourceOverrideTarget))
+	}
+	if !state.BootSourceOverrideMode.IsNull() {
+		state.BootSourceOverrideMode = types.StringValue(string(boot.BootSourceOverrideMode))
+	}
+}
+
 func (r *BootSourceOverrideResource) bootOperation(ctx context.Context, service *gofish.Service, plan *models.BootSourceOverride) diag.Diagnostics {
 	// Lock the mutex to avoid race conditions with other resources
 	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
@@ -21,11 +21,14 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"terraform-provider-redfish/redfish/models"
 	"testing"
 	"time"
 
 	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 // test redfish Boot Order
@@ -308,3 +311,34 @@ func testAccRedfishResourceBootSourceResetType(testingInfo TestingServerCredenti
 		testingInfo.Endpoint,
 	)
 }
+
+func TestRefreshBootSourceOverride(t *testing.T) {
+	boot := redfish.Boot{
+		BootSourceOverrideEnabled: redfish.ContinuousBootSourceOverrideEnabled,
+		BootSourceOverrideTarget:  redfish.CdBootSourceOverrideTarget,
+		BootSourceOverrideMode:    redfish.UEFIBootSourceOverrideMode,
+	}
+	state := models.BootSourceOverride{
+		BootSourceOverrideEnabled: types.StringValue("Continuous"),
+		BootSourceOverrideTarget:  types.StringValue("Pxe"),
+		BootSourceOverrideMode:    types.StringNull(),
+	}
+	refreshBootSourceOverride(&state, boot)
+	if state.BootSourceOverrideTarget.ValueString() != "Cd" {
+		t.Errorf("expected the target to be refreshed, got %s", state.BootSourceOverrideTarget.ValueString())
+	}
+	if !state.BootSourceOverrideMode.IsNull() {
+		t.Errorf("expected the unconfigured mode to stay null")
+	}
+
+	// A consumed Once override is kept
+	state = models.BootSourceOverride{
+		BootSourceOverrideEnabled: types.StringValue("Once"),
+		BootSourceOverrideTarget:  types.StringValue("Pxe"),
+	}
+	refreshBootSourceOverride(&state, redfish.Boot{BootSourceOverrideEnabled: redfish.DisabledBootSourceOverrideEnabled})
+	if state.BootSourceOverrideEnabled.ValueString() != "Once" || state.BootSourceOverrideTarget.ValueString() != "Pxe" {
+		t.Errorf("expected the consumed Once override to be kept, got %s/%s",
+			state.BootSourceOverrideEnabled.ValueString(), state.BootSourceOverrideTarget.ValueString())
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-redfish/common"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource = &clearJobQueueResource{}
+)
+
+// NewClearJobQueueResource is a helper function to simplify the provider implementation.
+func NewClearJobQueueResource() resource.Resource {
+	return &clearJobQueueResource{}
+}
+
+// clearJobQueueResource is the resource implementation.
+type clearJobQueueResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *clearJobQueueResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_clear_job_queue configured")
+}
+
+// Metadata returns the resource type name.
+func (*clearJobQueueResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "clear_job_queue"
+}
+
+// ClearJobQueueSchema to design the schema for clear job queue resource.
+func ClearJobQueueSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the clear job queue resource. It is the URI of the iDRAC job service.",
+			Description:         "ID of the clear job queue resource. It is the URI of the iDRAC job service.",
+			Computed:            true,
+		},
+		"job_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the job to delete, e.g. `JID_123456789012`. It must be listed in the job queue." +
+				" When unset, all the jobs of the queue are deleted.",
+			Description: "ID of the job to delete, e.g. JID_123456789012. It must be listed in the job queue." +
+				" When unset, all the jobs of the queue are deleted.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"clear_on": schema.MapAttribute{
+			MarkdownDescription: "Arbitrary values that trigger a new deletion of the jobs whenever they change.",
+			Description:         "Arbitrary values that trigger a new deletion of the jobs whenever they change.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"cleared_at": schema.StringAttribute{
+			MarkdownDescription: "Date and time, in RFC3339 format, the jobs were deleted at.",
+			Description:         "Date and time, in RFC3339 format, the jobs were deleted at.",
+			Computed:            true,
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*clearJobQueueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to delete a job, or all the jobs, of the iDRAC job queue, e.g. to unblock" +
+			" a controller with a stuck configuration job.",
+		Description: "This resource is used to delete a job, or all the jobs, of the iDRAC job queue, e.g. to unblock" +
+			" a controller with a stuck configuration job.",
+		Attributes: ClearJobQueueSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *clearJobQueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_clear_job_queue create : Started")
+	// Get Plan Data
+	var plan models.ClearJobQueue
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while getting service", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	jobServiceURI, err := clearJobQueue(api.Service, plan.JobID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error clearing the job queue", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(jobServiceURI)
+	plan.ClearedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	tflog.Trace(ctx, "resource_clear_job_queue create: updating state finished, saving ...")
+	// Save into State
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_clear_job_queue create: finish")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (*clearJobQueueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_clear_job_queue read: started")
+	var state models.ClearJobQueue
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleting the jobs is a one-time action, there is nothing to refresh
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_clear_job_queue read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (*clearJobQueueResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Update should never happen, it will destroy and create in case of update
+	resp.Diagnostics.AddError(
+		"Error updating Clear Job Queue.",
+		"An update plan of Clear Job Queue should never be invoked. This resource is supposed to be replaced on update.",
+	)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (*clearJobQueueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_clear_job_queue delete: started")
+	// Get State Data
+	var state models.ClearJobQueue
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_clear_job_queue delete: finished")
+}
+
+// clearJobQueue deletes the job from the Dell job queue, or all the jobs when jobID is empty, and returns
+// the URI of the job service.
+func clearJobQueue(service *gofish.Service, jobID string) (string, error) {
+	dellManager, err := getDellManager(service)
+	if err != nil {
+		return "", err
+	}
+	jobServiceURI := dellManager.DellJobServiceURI()
+	if jobServiceURI == "" {
+		return "", fmt.Errorf("the manager has no Dell job service")
+	}
+
+	if jobID == "" {
+		jobID = common.DellJobQueueClearAll
+	} else {
+		jobs, err := common.GetDellJobQueue(service, dellManager.JobsURI())
+		if err != nil {
+			return "", err
+		}
+		if err := checkJobInQueue(jobs, jobID); err != nil {
+			return "", err
+		}
+	}
+
+	return jobServiceURI, common.DeleteDellJobQueue(service, jobServiceURI, jobID)
+}
+
+// checkJobInQueue fails when the job isn't listed in the job queue.
+func checkJobInQueue(jobs []common.DellJobQueueEntry, jobID string) error {
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		if job.ID == jobID {
+			return nil
+		}
+		ids = append(ids, job.ID)
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("job %s was not found, the job queue is empty", jobID)
+	}
+	return fmt.Errorf("job %s was not found, the jobs of the queue are: %s", jobID, strings.Join(ids, ", "))
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/common"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to clear the job queue
+func TestAccRedfishClearJobQueue_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceClearJobQueueConfig(creds, "", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("redfish_clear_job_queue.jobs", "id"),
+					resource.TestCheckResourceAttrSet("redfish_clear_job_queue.jobs", "cleared_at"),
+				),
+			},
+			{
+				// changing the trigger clears the queue again
+				Config: testAccRedfishResourceClearJobQueueConfig(creds, "", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_clear_job_queue.jobs", "clear_on.run", "2"),
+					resource.TestCheckResourceAttrSet("redfish_clear_job_queue.jobs", "cleared_at"),
+				),
+			},
+		},
+	})
+}
+
+// Test to delete a job missing from the job queue - Negative
+func TestAccRedfishClearJobQueue_UnknownJob(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceClearJobQueueConfig(creds, `job_id = "JID_000000000000"`, "1"),
+				ExpectError: regexp.MustCompile("job JID_000000000000 was not found"),
+			},
+		},
+	})
+}
+
+func TestCheckJobInQueue(t *testing.T) {
+	jobs := []common.DellJobQueueEntry{{ID: "JID_1"}, {ID: "JID_2"}}
+
+	if err := checkJobInQueue(jobs, "JID_2"); err != nil {
+		t.Errorf("expected JID_2 to be found, got %v", err)
+	}
+	err := checkJobInQueue(jobs, "JID_3")
+	if err == nil || err.Error() != "job JID_3 was not found, the jobs of the queue are: JID_1, JID_2" {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := checkJobInQueue(nil, "JID_3"); err == nil {
+		t.Error("expected an error for an empty queue")
+	}
+}
+
+func testAccRedfishResourceClearJobQueueConfig(testingInfo TestingServerCredentials, jobID, run string) string {
+	return fmt.Sprintf(`
+		
+	resource "redfish_clear_job_queue" "jobs" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+	  
+		%s
+		clear_on = {
+		  run = "%s"
+		}
+	}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		jobID,
+		run,
+	)
+}
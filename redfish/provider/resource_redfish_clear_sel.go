@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource = &clearSelResource{}
+)
+
+// NewClearSelResource is a helper function to simplify the provider implementation.
+func NewClearSelResource() resource.Resource {
+	return &clearSelResource{}
+}
+
+// clearSelResource is the resource implementation.
+type clearSelResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *clearSelResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_clear_sel configured")
+}
+
+// Metadata returns the resource type name.
+func (*clearSelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "clear_sel"
+}
+
+// ClearSelSchema to design the schema for clear SEL resource.
+func ClearSelSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the clear SEL resource. It is the URI of the SEL log service.",
+			Description:         "ID of the clear SEL resource. It is the URI of the SEL log service.",
+			Computed:            true,
+		},
+		"clear_on": schema.MapAttribute{
+			MarkdownDescription: "Arbitrary values that trigger a new clear of the System Event Log whenever they change.",
+			Description:         "Arbitrary values that trigger a new clear of the System Event Log whenever they change.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"cleared_at": schema.StringAttribute{
+			MarkdownDescription: "Date and time, in RFC3339 format, the System Event Log was cleared at.",
+			Description:         "Date and time, in RFC3339 format, the System Event Log was cleared at.",
+			Computed:            true,
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*clearSelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to clear the System Event Log (SEL).",
+		Description:         "This resource is used to clear the System Event Log (SEL).",
+		Attributes:          ClearSelSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *clearSelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_clear_sel create : Started")
+	// Get Plan Data
+	var plan models.ClearSel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while getting service", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	logService, err := getSelLogService(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while retrieving the System Event Log", err.Error())
+		return
+	}
+	if err := logService.ClearLog(); err != nil {
+		resp.Diagnostics.AddError("Error clearing the System Event Log", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(logService.ODataID)
+	plan.ClearedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	tflog.Trace(ctx, "resource_clear_sel create: updating state finished, saving ...")
+	// Save into State
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_clear_sel create: finish")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (*clearSelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_clear_sel read: started")
+	var state models.ClearSel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Clearing the log is a one-time action, there is nothing to refresh
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_clear_sel read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (*clearSelResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Update should never happen, it will destroy and create in case of update
+	resp.Diagnostics.AddError(
+		"Error updating Clear SEL.",
+		"An update plan of Clear SEL should never be invoked. This resource is supposed to be replaced on update.",
+	)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (*clearSelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_clear_sel delete: started")
+	// Get State Data
+	var state models.ClearSel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_clear_sel delete: finished")
+}
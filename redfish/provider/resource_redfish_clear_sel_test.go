@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to clear the System Event Log
+func TestAccRedfishClearSel_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceClearSelConfig(creds, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("redfish_clear_sel.sel", "id"),
+					resource.TestCheckResourceAttrSet("redfish_clear_sel.sel", "cleared_at"),
+				),
+			},
+			{
+				// changing the trigger clears the log again
+				Config: testAccRedfishResourceClearSelConfig(creds, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_clear_sel.sel", "clear_on.run", "2"),
+					resource.TestCheckResourceAttrSet("redfish_clear_sel.sel", "cleared_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceClearSelConfig(testingInfo TestingServerCredentials, run string) string {
+	return fmt.Sprintf(`
+		
+	resource "redfish_clear_sel" "sel" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+	  
+		clear_on = {
+		  run = "%s"
+		}
+	}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		run,
+	)
+}
@@ -0,0 +1,382 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"terraform-provider-redfish/common"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource = &consistencyCheckResource{}
+)
+
+// checkConsistencyAction is the Dell RAID service action checking the consistency of a redundant volume
+const checkConsistencyAction = "CheckConsistency"
+
+// NewConsistencyCheckResource is a helper function to simplify the provider implementation.
+func NewConsistencyCheckResource() resource.Resource {
+	return &consistencyCheckResource{}
+}
+
+// consistencyCheckResource is the resource implementation.
+type consistencyCheckResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *consistencyCheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_consistency_check configured")
+}
+
+// Metadata returns the resource type name.
+func (*consistencyCheckResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "consistency_check"
+}
+
+// ConsistencyCheckSchema to design the schema for consistency check resource.
+func ConsistencyCheckSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the consistency check resource. It is the URI of the volume.",
+			Description:         "ID of the consistency check resource. It is the URI of the volume.",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system",
+			Description:         "System ID of the system",
+			Computed:            true,
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"storage_controller_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage controller of the volume",
+			Description:         "ID of the storage controller of the volume",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"volume_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the volume whose consistency is checked. It must be a redundant volume, e.g. RAID1 or RAID5.",
+			Description:         "Name of the volume whose consistency is checked. It must be a redundant volume, e.g. RAID1 or RAID5.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"apply_time": schema.StringAttribute{
+			MarkdownDescription: "When the consistency check starts. Accepted values: `Immediate`, `OnReset`." +
+				" With `OnReset`, the server is reset with `reset_type` to start it. Default is `Immediate`.",
+			Description: "When the consistency check starts. Accepted values: Immediate, OnReset." +
+				" With OnReset, the server is reset with reset_type to start it. Default is Immediate.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(string(redfishcommon.ImmediateApplyTime)),
+			Validators: []validator.String{
+				stringvalidator.OneOf(string(redfishcommon.ImmediateApplyTime), string(redfishcommon.OnResetApplyTime)),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"reset_type": schema.StringAttribute{
+			MarkdownDescription: "Reset type of the server when `apply_time` is `OnReset`",
+			Description:         "Reset type of the server when apply_time is OnReset",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(string(redfish.ForceRestartResetType)),
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					string(redfish.ForceRestartResetType),
+					string(redfish.GracefulRestartResetType),
+					string(redfish.PowerCycleResetType),
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"reset_timeout": schema.Int64Attribute{
+			MarkdownDescription: "The maximum amount of time in seconds to wait for the server reset when `apply_time` is `OnReset`",
+			Description:         "The maximum amount of time in seconds to wait for the server reset when apply_time is OnReset",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultStorageVolumeResetTimeout),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"job_timeout": schema.Int64Attribute{
+			MarkdownDescription: "The maximum amount of time in seconds to wait for the consistency check job to be completed",
+			Description:         "The maximum amount of time in seconds to wait for the consistency check job to be completed",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultStorageVolumeJobTimeout),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"check_on": schema.MapAttribute{
+			MarkdownDescription: "Arbitrary values that trigger a new consistency check whenever they change.",
+			Description:         "Arbitrary values that trigger a new consistency check whenever they change.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"job_id": schema.StringAttribute{
+			MarkdownDescription: "URI of the job of the last consistency check",
+			Description:         "URI of the job of the last consistency check",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "State of the job of the last consistency check, e.g. `Completed`. It is refreshed while the job" +
+				" is listed by the iDRAC.",
+			Description: "State of the job of the last consistency check, e.g. Completed. It is refreshed while the job" +
+				" is listed by the iDRAC.",
+			Computed: true,
+		},
+		"message": schema.StringAttribute{
+			MarkdownDescription: "Last message reported by the job of the last consistency check",
+			Description:         "Last message reported by the job of the last consistency check",
+			Computed:            true,
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*consistencyCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to run a consistency check on a redundant volume, to find and fix the" +
+			" inconsistencies of its redundant data.",
+		Description: "This resource is used to run a consistency check on a redundant volume, to find and fix the" +
+			" inconsistencies of its redundant data.",
+		Attributes: ConsistencyCheckSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *consistencyCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_consistency_check create: started")
+	var plan models.ConsistencyCheck
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(checkVolumeConsistency(ctx, api.Service, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_consistency_check create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *consistencyCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_consistency_check read: started")
+	var state models.ConsistencyCheck
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	// The job is purged from the job queue after a while, the last known status is kept then
+	if task, err := getTask(api.Service, state.JobID.ValueString()); err == nil {
+		setConsistencyCheckStatus(&state, task)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_consistency_check read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (*consistencyCheckResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Update should never happen, it will destroy and create in case of update
+	resp.Diagnostics.AddError(
+		"Error updating Consistency Check.",
+		"An update plan of Consistency Check should never be invoked. This resource is supposed to be replaced on update.",
+	)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (*consistencyCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_consistency_check delete: started")
+	var state models.ConsistencyCheck
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_consistency_check delete: finished")
+}
+
+// checkVolumeConsistency starts the consistency check of the volume through the Dell RAID service, resets the
+// server when it is applied on reset, and waits for its job.
+func checkVolumeConsistency(ctx context.Context, service *gofish.Service, d *models.ConsistencyCheck) (diags diag.Diagnostics) {
+	onReset := d.ApplyTime.ValueString() == string(redfishcommon.OnResetApplyTime)
+
+	// Lock the controller to avoid race conditions with other resources
+	defer lockStorageController(d.RedfishServer[0].Endpoint.ValueString(), d.StorageControllerID.ValueString(), onReset)()
+
+	storage, system, err := getStorage(service, d.SystemID.ValueString(), d.StorageControllerID.ValueString())
+	if errors.Is(err, errNoStorageSubsystem) {
+		diags.AddError(noStorageSubsystemErrorMsg, err.Error())
+		return diags
+	}
+	if err != nil {
+		diags.AddError("Error when retreiving the Storage from the Redfish API", err.Error())
+		return diags
+	}
+	d.SystemID = types.StringValue(system.ID)
+
+	volumes, err := storage.Volumes()
+	if err != nil {
+		diags.AddError("Error when retreiving the volumes of the controller", err.Error())
+		return diags
+	}
+	volumeID, err := getVolumeID(volumes, d.VolumeName.ValueString())
+	if err != nil {
+		diags.AddError("Error when retreiving the volume", err.Error())
+		return diags
+	}
+	d.ID = types.StringValue(volumeID)
+	volumeFQDD := volumeID[strings.LastIndex(volumeID, "/")+1:]
+
+	if !isDellService(service) || !dellRaidServiceSupports(service, system, checkConsistencyAction) {
+		diags.AddError("Error when checking the consistency of the volume",
+			fmt.Sprintf("the service doesn't support the %s action of the Dell RAID service", checkConsistencyAction))
+		return diags
+	}
+
+	jobID, err := submitDellRaidServiceAction(service, system, checkConsistencyAction, volumeFQDD, map[string]interface{}{
+		"TargetFQDD":                  volumeFQDD,
+		"@Redfish.OperationApplyTime": d.ApplyTime.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Error when checking the consistency of the volume", err.Error())
+		return diags
+	}
+	d.JobID = types.StringValue(jobID)
+
+	if onReset {
+		pOp := powerOperator{ctx, service, system.ID}
+		if _, err := pOp.PowerOperation(d.ResetType.ValueString(), d.ResetTimeout.ValueInt64(), defaultStorageVolumeJobCheckInterval); err != nil {
+			diags.AddError(RedfishJobErrorMsg, err.Error())
+			return diags
+		}
+	}
+
+	err = common.WaitForTaskToFinishWithContext(ctx, service, jobID, defaultStorageVolumeJobCheckInterval, d.JobTimeout.ValueInt64())
+	if err != nil {
+		diags.AddError("Error when checking the consistency of the volume", fmt.Sprintf("%s: %s", RedfishJobErrorMsg, err.Error()))
+		return diags
+	}
+
+	d.Status = types.StringValue(string(redfish.CompletedTaskState))
+	d.Message = types.StringValue("")
+	if task, err := getTask(service, jobID); err == nil {
+		setConsistencyCheckStatus(d, task)
+	}
+	return diags
+}
+
+// getTask returns the task of the job. 17G servers return the task monitor of the job, whose task is read instead.
+func getTask(service *gofish.Service, jobID string) (*redfish.Task, error) {
+	if jobID == "" {
+		return nil, fmt.Errorf("no job to read")
+	}
+	return redfish.GetTask(service.GetClient(), strings.Replace(jobID, "TaskMonitors", "Tasks", 1))
+}
+
+// setConsistencyCheckStatus sets the status of the consistency check from its task.
+func setConsistencyCheckStatus(d *models.ConsistencyCheck, task *redfish.Task) {
+	d.Status = types.StringValue(string(task.TaskState))
+	d.Message = types.StringValue("")
+	if len(task.Messages) > 0 {
+		d.Message = types.StringValue(task.Messages[len(task.Messages)-1].Message)
+	}
+}
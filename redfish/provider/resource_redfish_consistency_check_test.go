@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test to check the consistency of a volume
+func TestAccRedfishConsistencyCheck_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceConsistencyCheckConfig(creds, "TerraformVol1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("redfish_consistency_check.check", "job_id"),
+					resource.TestCheckResourceAttr("redfish_consistency_check.check", "status", "Completed"),
+				),
+			},
+		},
+	})
+}
+
+// Test to check the consistency of a missing volume - Negative
+func TestAccRedfishConsistencyCheck_InvalidVolume(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceConsistencyCheckConfig(creds, "InvalidVolume"),
+				ExpectError: regexp.MustCompile("Error when retreiving the volume"),
+			},
+		},
+	})
+}
+
+func TestSetConsistencyCheckStatus(t *testing.T) {
+	task := &redfish.Task{
+		TaskState: redfish.CompletedTaskState,
+		Messages: []redfishcommon.Message{
+			{Message: "Job in progress."},
+			{Message: "Consistency check completed."},
+		},
+	}
+	var d models.ConsistencyCheck
+	setConsistencyCheckStatus(&d, task)
+	if d.Status.ValueString() != "Completed" || d.Message.ValueString() != "Consistency check completed." {
+		t.Errorf("unexpected status %q, message %q", d.Status.ValueString(), d.Message.ValueString())
+	}
+
+	setConsistencyCheckStatus(&d, &redfish.Task{TaskState: redfish.RunningTaskState})
+	if d.Status.ValueString() != "Running" || d.Message.IsNull() || d.Message.ValueString() != "" {
+		t.Errorf("unexpected status %q, message %q", d.Status.ValueString(), d.Message.ValueString())
+	}
+}
+
+func testAccRedfishResourceConsistencyCheckConfig(testingInfo TestingServerCredentials, volumeName string) string {
+	return fmt.Sprintf(`
+	resource "redfish_consistency_check" "check" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+
+		storage_controller_id = "RAID.Integrated.1-1"
+		volume_name           = "%s"
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		volumeName,
+	)
+}
@@ -0,0 +1,453 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &controllerKeyResource{}
+	_ resource.ResourceWithValidateConfig = &controllerKeyResource{}
+)
+
+const (
+	// localKeyManagement is the mode where the key is a passphrase stored on the controller
+	localKeyManagement = "LKM"
+	// secureEnterpriseKeyManager is the mode where the key is provided by a key manager through the iDRAC
+	secureEnterpriseKeyManager = "SEKM"
+	controllerKeyErrorMsg      = "Error while managing the controller security key"
+)
+
+// NewControllerKeyResource is a helper function to simplify the provider implementation.
+func NewControllerKeyResource() resource.Resource {
+	return &controllerKeyResource{}
+}
+
+// controllerKeyResource is the resource implementation.
+type controllerKeyResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *controllerKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_controller_key configured")
+}
+
+// Metadata returns the resource type name.
+func (*controllerKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "controller_key"
+}
+
+// ControllerKeySchema to design the schema for controller key resource.
+func ControllerKeySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the controller key resource. It is the URI of the storage controller.",
+			Description:         "ID of the controller key resource. It is the URI of the storage controller.",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system",
+			Description:         "System ID of the system",
+			Computed:            true,
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"storage_controller_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage controller whose security key is managed",
+			Description:         "ID of the storage controller whose security key is managed",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"mode": schema.StringAttribute{
+			MarkdownDescription: "Key management mode of the controller. Accepted values: `LKM`, `SEKM`." +
+				" With `LKM` (Local Key Management), the key is a passphrase set on the controller." +
+				" With `SEKM` (Secure Enterprise Key Manager), the key is provided by the key manager the iDRAC is enrolled in." +
+				" 17G servers only support `SEKM`.",
+			Description: "Key management mode of the controller. Accepted values: LKM, SEKM." +
+				" With LKM (Local Key Management), the key is a passphrase set on the controller." +
+				" With SEKM (Secure Enterprise Key Manager), the key is provided by the key manager the iDRAC is enrolled in." +
+				" 17G servers only support SEKM.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(localKeyManagement),
+			Validators: []validator.String{
+				stringvalidator.OneOf(localKeyManagement, secureEnterpriseKeyManager),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"key_id": schema.StringAttribute{
+			MarkdownDescription: "Identifier of the key, of at most 32 characters without spaces. It is required with the `LKM` mode.",
+			Description:         "Identifier of the key, of at most 32 characters without spaces. It is required with the LKM mode.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 32),
+				stringvalidator.RegexMatches(regexp.MustCompile(`^\S+$`), "must not contain spaces"),
+			},
+		},
+		"key": schema.StringAttribute{
+			MarkdownDescription: "Passphrase of the key. It is required with the `LKM` mode." +
+				" Changing it, or `key_id`, rekeys the controller with the passphrase in state as the old key.",
+			Description: "Passphrase of the key. It is required with the LKM mode." +
+				" Changing it, or key_id, rekeys the controller with the passphrase in state as the old key.",
+			Optional:  true,
+			Sensitive: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"job_timeout": schema.Int64Attribute{
+			MarkdownDescription: "The maximum amount of time in seconds to wait for the security key jobs to be completed",
+			Description:         "The maximum amount of time in seconds to wait for the security key jobs to be completed",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultStorageVolumeJobTimeout),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*controllerKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the security key of a storage controller, required to create encrypted volumes." +
+			" Destroying it removes the key from the controller.",
+		Description: "This resource is used to manage the security key of a storage controller, required to create encrypted volumes." +
+			" Destroying it removes the key from the controller.",
+		Attributes: ControllerKeySchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// ValidateConfig validates the resource config.
+func (*controllerKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.ControllerKey
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Mode.IsUnknown() {
+		return
+	}
+	if config.Mode.ValueString() == secureEnterpriseKeyManager {
+		if !config.KeyID.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("key_id"), "Invalid key_id",
+				"key_id can't be set with the SEKM mode, the key is provided by the key manager")
+		}
+		if !config.Key.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("key"), "Invalid key",
+				"key can't be set with the SEKM mode, the key is provided by the key manager")
+		}
+		return
+	}
+	if config.KeyID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("key_id"), "Missing key_id", "key_id is required with the LKM mode")
+	}
+	if config.Key.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("key"), "Missing key", "key is required with the LKM mode")
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *controllerKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_controller_key create: started")
+	var plan models.ControllerKey
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	// Lock the controller to avoid race conditions with other resources
+	defer lockStorageController(plan.RedfishServer[0].Endpoint.ValueString(), plan.StorageControllerID.ValueString(), false)()
+
+	system, is17G, diags := getControllerKeyContext(api.Service, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	action, parameters, err := controllerKeySetAction(&plan, is17G)
+	if err != nil {
+		resp.Diagnostics.AddError(controllerKeyErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(runControllerKeyAction(ctx, api.Service, system, action, &plan, parameters)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_controller_key create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *controllerKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_controller_key read: started")
+	var state models.ControllerKey
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	storage, _, err := getStorage(api.Service, state.SystemID.ValueString(), state.StorageControllerID.ValueString())
+	if errors.Is(err, errNoStorageSubsystem) {
+		resp.Diagnostics.AddError(noStorageSubsystemErrorMsg, err.Error())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error when retreiving the Storage from the Redfish API", err.Error())
+		return
+	}
+	controller, err := getDellStorageController(storage)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when retreiving the storage controller", err.Error())
+		return
+	}
+
+	dellController := controller.Oem.Dell.DellStorageController
+	if !controllerKeyAssigned(dellController) {
+		// The key was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if state.Mode.ValueString() == localKeyManagement && dellController.KeyID != "" {
+		state.KeyID = types.StringValue(dellController.KeyID)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_controller_key read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *controllerKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_controller_key update: started")
+	var plan, state models.ControllerKey
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	// Only the LKM passphrase can be changed, the other attributes replace the resource
+	if !plan.Key.Equal(state.Key) || !plan.KeyID.Equal(state.KeyID) {
+		api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+		if err != nil {
+			resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+			return
+		}
+		resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+		defer api.Logout()
+
+		defer lockStorageController(plan.RedfishServer[0].Endpoint.ValueString(), plan.StorageControllerID.ValueString(), false)()
+
+		system, is17G, diags := getControllerKeyContext(api.Service, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if is17G {
+			resp.Diagnostics.AddError(controllerKeyErrorMsg, "rekeying the controller is not supported on 17G servers")
+			return
+		}
+
+		parameters := map[string]interface{}{
+			"TargetFQDD": plan.StorageControllerID.ValueString(),
+			"Mode":       localKeyManagement,
+			"Keyid":      plan.KeyID.ValueString(),
+			"NewKey":     plan.Key.ValueString(),
+			"OldKey":     state.Key.ValueString(),
+		}
+		resp.Diagnostics.Append(runControllerKeyAction(ctx, api.Service, system, "ReKey", &plan, parameters)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_controller_key update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *controllerKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_controller_key delete: started")
+	var state models.ControllerKey
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	defer lockStorageController(state.RedfishServer[0].Endpoint.ValueString(), state.StorageControllerID.ValueString(), false)()
+
+	system, is17G, diags := getControllerKeyContext(api.Service, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	action, parameters := controllerKeyRemoveAction(state.StorageControllerID.ValueString(), is17G)
+	resp.Diagnostics.Append(runControllerKeyAction(ctx, api.Service, system, action, &state, parameters)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_controller_key delete: finished")
+}
+
+// getControllerKeyContext returns the system of the controller and whether the server is 17G or above.
+// It sets the ID and the system ID of the resource.
+func getControllerKeyContext(service *gofish.Service, d *models.ControllerKey) (*redfish.ComputerSystem, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !isDellService(service) {
+		diags.AddError(controllerKeyErrorMsg, "the security key of the controllers can only be managed on Dell servers")
+		return nil, false, diags
+	}
+
+	storage, system, err := getStorage(service, d.SystemID.ValueString(), d.StorageControllerID.ValueString())
+	if errors.Is(err, errNoStorageSubsystem) {
+		diags.AddError(noStorageSubsystemErrorMsg, err.Error())
+		return nil, false, diags
+	}
+	if err != nil {
+		diags.AddError("Error when retreiving the Storage from the Redfish API", err.Error())
+		return nil, false, diags
+	}
+	d.SystemID = types.StringValue(system.ID)
+	d.ID = types.StringValue(storage.ODataID)
+
+	is17G, err := isServerGenerationSeventeenAndAbove(service)
+	if err != nil {
+		diags.AddError("Error retrieving the server generation", err.Error())
+		return nil, false, diags
+	}
+	return system, is17G, diags
+}
+
+// runControllerKeyAction checks the Dell RAID service supports the action, then runs it and waits for its job.
+func runControllerKeyAction(ctx context.Context, service *gofish.Service, system *redfish.ComputerSystem,
+	action string, d *models.ControllerKey, parameters map[string]interface{},
+) (diags diag.Diagnostics) {
+	if !dellRaidServiceSupports(service, system, action) {
+		diags.AddError(controllerKeyErrorMsg, fmt.Sprintf("the service doesn't support the %s action of the Dell RAID service", action))
+		return diags
+	}
+	err := runDellRaidServiceAction(ctx, service, system, action, d.StorageControllerID.ValueString(), parameters, d.JobTimeout.ValueInt64())
+	if err != nil {
+		diags.AddError(controllerKeyErrorMsg, err.Error())
+	}
+	return diags
+}
+
+// controllerKeySetAction returns the Dell RAID service action, and its parameters, setting the key of the controller.
+func controllerKeySetAction(d *models.ControllerKey, is17G bool) (string, map[string]interface{}, error) {
+	controllerID := d.StorageControllerID.ValueString()
+	if d.Mode.ValueString() == secureEnterpriseKeyManager {
+		if is17G {
+			return "EnableSecurity", map[string]interface{}{"TargetFQDD": controllerID}, nil
+		}
+		return "EnableControllerEncryption", map[string]interface{}{
+			"TargetFQDD": controllerID,
+			"Mode":       secureEnterpriseKeyManager,
+		}, nil
+	}
+	if is17G {
+		return "", nil, fmt.Errorf("the LKM mode is not supported on 17G servers, use the SEKM mode")
+	}
+	return "SetControllerKey", map[string]interface{}{
+		"TargetFQDD": controllerID,
+		"Keyid":      d.KeyID.ValueString(),
+		"Key":        d.Key.ValueString(),
+	}, nil
+}
+
+// controllerKeyRemoveAction returns the Dell RAID service action, and its parameters, removing the key of the controller.
+func controllerKeyRemoveAction(controllerID string, is17G bool) (string, map[string]interface{}) {
+	if is17G {
+		return "DisableSecurity", map[string]interface{}{"ControllerFQDD": controllerID}
+	}
+	return "RemoveControllerKey", map[string]interface{}{"TargetFQDD": controllerID}
+}
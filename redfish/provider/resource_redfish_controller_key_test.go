@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to set, then rekey, the security key of a storage controller
+func TestAccRedfishControllerKey_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceControllerKeyConfig(creds, "RAID.Integrated.1-1", `key_id = "key1"
+				key = "Test123##"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("redfish_controller_key.key", "id"),
+					resource.TestCheckResourceAttr("redfish_controller_key.key", "key_id", "key1"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceControllerKeyConfig(creds, "RAID.Integrated.1-1", `key_id = "key2"
+				key = "Test123###"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_controller_key.key", "key_id", "key2"),
+				),
+			},
+		},
+	})
+}
+
+// Test to set the security key of a storage controller without passphrase - Negative
+func TestAccRedfishControllerKey_MissingKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceControllerKeyConfig(creds, "RAID.Integrated.1-1", `key_id = "key1"`),
+				ExpectError: regexp.MustCompile("key is required with the LKM mode"),
+			},
+			{
+				Config: testAccRedfishResourceControllerKeyConfig(creds, "RAID.Integrated.1-1", `mode = "SEKM"
+				key = "Test123##"`),
+				ExpectError: regexp.MustCompile("key can't be set with the SEKM mode"),
+			},
+		},
+	})
+}
+
+func TestControllerKeyActions(t *testing.T) {
+	lkm := &models.ControllerKey{
+		StorageControllerID: types.StringValue("RAID.Integrated.1-1"),
+		Mode:                types.StringValue(localKeyManagement),
+		KeyID:               types.StringValue("key1"),
+		Key:                 types.StringValue("Test123##"),
+	}
+	sekm := &models.ControllerKey{
+		StorageControllerID: types.StringValue("RAID.Integrated.1-1"),
+		Mode:                types.StringValue(secureEnterpriseKeyManager),
+	}
+
+	tests := []struct {
+		name       string
+		d          *models.ControllerKey
+		is17G      bool
+		wantAction string
+		wantErr    bool
+	}{
+		{name: "LKM", d: lkm, wantAction: "SetControllerKey"},
+		{name: "LKM on 17G", d: lkm, is17G: true, wantErr: true},
+		{name: "SEKM", d: sekm, wantAction: "EnableControllerEncryption"},
+		{name: "SEKM on 17G", d: sekm, is17G: true, wantAction: "EnableSecurity"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, parameters, err := controllerKeySetAction(tt.d, tt.is17G)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("controllerKeySetAction() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if action != tt.wantAction {
+				t.Errorf("controllerKeySetAction() action = %q, want %q", action, tt.wantAction)
+			}
+			if !tt.wantErr && parameters["TargetFQDD"] != "RAID.Integrated.1-1" {
+				t.Errorf("controllerKeySetAction() parameters = %v, want the controller as TargetFQDD", parameters)
+			}
+		})
+	}
+
+	if action, parameters := controllerKeyRemoveAction("RAID.Integrated.1-1", false); action != "RemoveControllerKey" ||
+		parameters["TargetFQDD"] != "RAID.Integrated.1-1" {
+		t.Errorf("controllerKeyRemoveAction() = %q, %v", action, parameters)
+	}
+	if action, parameters := controllerKeyRemoveAction("RAID.Integrated.1-1", true); action != "DisableSecurity" ||
+		parameters["ControllerFQDD"] != "RAID.Integrated.1-1" {
+		t.Errorf("controllerKeyRemoveAction() = %q, %v", action, parameters)
+	}
+}
+
+func TestControllerKeyAssigned(t *testing.T) {
+	tests := []struct {
+		name       string
+		controller dell.DellStorageController
+		want       bool
+	}{
+		{name: "no key", controller: dell.DellStorageController{EncryptionMode: "None", SecurityStatus: "SecurityKeyNotAssigned"}},
+		{name: "LKM", controller: dell.DellStorageController{EncryptionMode: "LocalKeyManagement"}, want: true},
+		{name: "SEKM", controller: dell.DellStorageController{EncryptionMode: "SecureEnterpriseKeyManager"}, want: true},
+		{name: "key assigned", controller: dell.DellStorageController{SecurityStatus: "SecurityKeyAssigned"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := controllerKeyAssigned(tt.controller); got != tt.want {
+				t.Errorf("controllerKeyAssigned() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testAccRedfishResourceControllerKeyConfig(testingInfo TestingServerCredentials, controllerID, keyConfig string) string {
+	return fmt.Sprintf(`
+	resource "redfish_controller_key" "key" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+
+		storage_controller_id = "%s"
+		%s
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		controllerID,
+		keyConfig,
+	)
+}
@@ -21,20 +21,29 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"slices"
 	"strconv"
 	"strings"
 	"terraform-provider-redfish/gofish/dell"
 	"terraform-provider-redfish/redfish/models"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -101,6 +110,48 @@ func DellIdracAttributesSchema() map[string]schema.Attribute {
 			ElementType: types.StringType,
 			Required:    true,
 		},
+		"settings_apply_time": schema.StringAttribute{
+			MarkdownDescription: "The time when the iDRAC attributes are applied. Applicable values are `Immediate` and `OnReset`. " +
+				"With `OnReset` the iDRAC is reset with `reset_type` once the attributes are set, " +
+				"which is needed by attributes that only take effect after an iDRAC reset. Default is `Immediate`.",
+			Description: "The time when the iDRAC attributes are applied. Applicable values are 'Immediate' and 'OnReset'. " +
+				"With 'OnReset' the iDRAC is reset with reset_type once the attributes are set, " +
+				"which is needed by attributes that only take effect after an iDRAC reset. Default is 'Immediate'.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(string(redfishcommon.ImmediateApplyTime)),
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					string(redfishcommon.ImmediateApplyTime),
+					string(redfishcommon.OnResetApplyTime),
+				}...),
+			},
+		},
+		"reset_type": schema.StringAttribute{
+			MarkdownDescription: "Reset type used to reset the iDRAC when `settings_apply_time` is `OnReset`. " +
+				"Applicable values are `GracefulRestart` and `ForceRestart`. Default is `GracefulRestart`.",
+			Description: "Reset type used to reset the iDRAC when settings_apply_time is 'OnReset'. " +
+				"Applicable values are 'GracefulRestart' and 'ForceRestart'. Default is 'GracefulRestart'.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(string(redfish.GracefulRestartResetType)),
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					string(redfish.GracefulRestartResetType),
+					string(redfish.ForceRestartResetType),
+				}...),
+			},
+		},
+		"reset_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Time in seconds that the provider waits for the iDRAC to come back after a reset. Default is `300`.",
+			Description:         "Time in seconds that the provider waits for the iDRAC to come back after a reset. Default is 300.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(int64(defaultCheckTimeout)),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(int64(managerRestartGracePeriod)),
+			},
+		},
 	}
 }
 
@@ -108,22 +159,14 @@ func DellIdracAttributesSchema() map[string]schema.Attribute {
 func (r *dellIdracAttributesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "resource_DellIdracAttributes create : Started")
 	// Get Plan Data
-	var plan models.DellIdracAttributes
+	var plan models.DellIdracAttributesResource
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
-	if err != nil {
-		resp.Diagnostics.AddError("service error", err.Error())
-		return
-	}
-	service := api.Service
-	defer api.Logout()
-
-	diags = updateRedfishDellIdracAttributes(ctx, service, &plan)
+	diags = r.applyDellIdracAttributes(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -139,26 +182,29 @@ func (r *dellIdracAttributesResource) Create(ctx context.Context, req resource.C
 // Read refreshes the Terraform state with the latest data.
 func (r *dellIdracAttributesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	tflog.Trace(ctx, "resource_DellIdracAttributes read: started")
-	var state models.DellIdracAttributes
+	var state models.DellIdracAttributesResource
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
-	diags = readRedfishDellIdracAttributes(ctx, service, &state)
+	idracAttributes := models.DellIdracAttributes{ID: state.ID, RedfishServer: state.RedfishServer, Attributes: state.Attributes}
+	diags = readRedfishDellIdracAttributes(ctx, service, &idracAttributes)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.Attributes = idracAttributes.Attributes
 
 	tflog.Trace(ctx, "resource_DellIdracAttributes read: finished reading state")
 	// Save into State
@@ -171,7 +217,7 @@ func (r *dellIdracAttributesResource) Read(ctx context.Context, req resource.Rea
 func (r *dellIdracAttributesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get state Data
 	tflog.Trace(ctx, "resource_DellIdracAttributes update: started")
-	var plan models.DellIdracAttributes
+	var plan models.DellIdracAttributesResource
 
 	// Get plan Data
 	diags := req.Plan.Get(ctx, &plan)
@@ -180,15 +226,7 @@ func (r *dellIdracAttributesResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
-	if err != nil {
-		resp.Diagnostics.AddError("service error", err.Error())
-		return
-	}
-	service := api.Service
-	defer api.Logout()
-
-	diags = updateRedfishDellIdracAttributes(ctx, service, &plan)
+	diags = r.applyDellIdracAttributes(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -205,7 +243,7 @@ func (r *dellIdracAttributesResource) Update(ctx context.Context, req resource.U
 func (*dellIdracAttributesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Trace(ctx, "resource_DellIdracAttributes delete: started")
 	// Get State Data
-	var state models.DellIdracAttributes
+	var state models.DellIdracAttributesResource
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -243,7 +281,7 @@ func (r *dellIdracAttributesResource) ImportState(ctx context.Context, req resou
 
 	srv := []models.RedfishServer{server}
 
-	api, d := r.getiDRACEnv(&srv)
+	api, d := r.getiDRACEnv(ctx, &srv)
 	resp.Diagnostics = append(resp.Diagnostics, d...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -256,6 +294,9 @@ func (r *dellIdracAttributesResource) ImportState(ctx context.Context, req resou
 
 	redfishServer := path.Root("redfish_server")
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, redfishServer, []models.RedfishServer{server})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("settings_apply_time"), string(redfishcommon.ImmediateApplyTime))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reset_type"), string(redfish.GracefulRestartResetType))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reset_timeout"), int64(defaultCheckTimeout))...)
 
 	attributes := path.Root("attributes")
 	if c.Attributes == nil {
@@ -290,18 +331,116 @@ func (r *dellIdracAttributesResource) ImportState(ctx context.Context, req resou
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attributes, types.MapValueMust(types.StringType, readAttributes))...)
 }
 
-func (r *dellIdracAttributesResource) getiDRACEnv(rserver *[]models.RedfishServer) (*gofish.APIClient, diag.Diagnostics) {
+func (r *dellIdracAttributesResource) getiDRACEnv(ctx context.Context, rserver *[]models.RedfishServer) (*gofish.APIClient, diag.Diagnostics) {
 	var d diag.Diagnostics
 	// Get service
-	api, err := NewConfig(r.p, rserver)
+	api, err := NewConfig(ctx, r.p, rserver)
 	if err != nil {
 		d.AddError(ServiceErrorMsg, err.Error())
 		return nil, d
 	}
+	d.Append(r.p.tlsDiagnostics()...)
 	return api, nil
 }
 
+// applyDellIdracAttributes sets the planned iDRAC attributes and, when settings_apply_time is OnReset,
+// resets the iDRAC and waits for it to come back before reading the attributes back.
+func (r *dellIdracAttributesResource) applyDellIdracAttributes(ctx context.Context, plan *models.DellIdracAttributesResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+	idracAttributes := models.DellIdracAttributes{ID: plan.ID, RedfishServer: plan.RedfishServer, Attributes: plan.Attributes}
+	onReset := plan.SettingsApplyTime.ValueString() == string(redfishcommon.OnResetApplyTime)
+
+	// The iDRAC reset interrupts every other operation on the endpoint
+	if onReset {
+		redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
+		defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError("service error", err.Error())
+		return diags
+	}
+	diags.Append(r.p.tlsDiagnostics()...)
+	resetRequired, d := patchRedfishDellIdracAttributes(ctx, api.Service, &idracAttributes)
+	diags.Append(d...)
+	if diags.HasError() {
+		api.Logout()
+		return diags
+	}
+
+	if !onReset {
+		if resetRequired {
+			diags.AddWarning("iDRAC reset required",
+				"Some of the iDRAC attributes only take effect after an iDRAC reset. Set settings_apply_time to OnReset to reset the iDRAC.")
+		}
+		diags.Append(readRedfishDellIdracAttributes(ctx, api.Service, &idracAttributes)...)
+		api.Logout()
+		plan.ID, plan.Attributes = idracAttributes.ID, idracAttributes.Attributes
+		return diags
+	}
+
+	err = resetIdrac(api.Service, redfish.ResetType(plan.ResetType.ValueString()))
+	api.Logout()
+	if err != nil {
+		diags.AddError("Error resetting iDRAC", err.Error())
+		return diags
+	}
+	// The session doesn't survive the reset, so a new one is opened on every attempt
+	connect := func() error {
+		api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+		if err != nil {
+			return err
+		}
+		api.Logout()
+		return nil
+	}
+	err = waitForManagerRestart(ctx, connect, time.Duration(defaultCheckInterval)*time.Second,
+		time.Duration(managerRestartGracePeriod)*time.Second, time.Duration(plan.ResetTimeout.ValueInt64())*time.Second)
+	if err != nil {
+		diags.AddError("Error while rebooting iDRAC. Operation may take longer duration to complete", err.Error())
+		return diags
+	}
+
+	api, err = NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError("service error", err.Error())
+		return diags
+	}
+	diags.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+	diags.Append(readRedfishDellIdracAttributes(ctx, api.Service, &idracAttributes)...)
+	plan.ID, plan.Attributes = idracAttributes.ID, idracAttributes.Attributes
+	return diags
+}
+
+// resetIdrac resets the first manager of the service with the given reset type.
+func resetIdrac(service *gofish.Service, resetType redfish.ResetType) error {
+	managers, err := service.Managers()
+	if err != nil {
+		return err
+	}
+	if len(managers) == 0 {
+		return fmt.Errorf("no managers were found on the server")
+	}
+	if err := checkManagerResetType(managers[0], resetType); err != nil {
+		return err
+	}
+	managers[0].Entity.SetETag("")
+	return managers[0].Reset(resetType)
+}
+
 func updateRedfishDellIdracAttributes(ctx context.Context, service *gofish.Service, d *models.DellIdracAttributes) diag.Diagnostics {
+	_, diags := patchRedfishDellIdracAttributes(ctx, service, d)
+	if diags.HasError() {
+		return diags
+	}
+	return readRedfishDellIdracAttributes(ctx, service, d)
+}
+
+// patchRedfishDellIdracAttributes validates the attributes against the manager attribute registry and patches them.
+// It reports whether the iDRAC asked for a reset to apply them.
+func patchRedfishDellIdracAttributes(ctx context.Context, service *gofish.Service, d *models.DellIdracAttributes) (bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	idracError := "there was an issue when creating/updating idrac attributes"
 	d.ID = types.StringValue("placeholder")
@@ -313,13 +452,13 @@ func updateRedfishDellIdracAttributes(ctx context.Context, service *gofish.Servi
 	if err != nil {
 		idracError = "there was an issue when creating/updating idrac attributes- Testing"
 		diags.AddError(idracError, err.Error())
-		return diags
+		return false, diags
 	}
 
 	isGenerationSeventeenAndAbove, err := isServerGenerationSeventeenAndAbove(service)
 	if err != nil {
 		diags.AddError("Error retrieving the server generation", err.Error())
-		return diags
+		return false, diags
 	}
 
 	if isGenerationSeventeenAndAbove {
@@ -327,7 +466,7 @@ func updateRedfishDellIdracAttributes(ctx context.Context, service *gofish.Servi
 			if strings.HasPrefix(k, "Users.") && strings.HasSuffix(k, ".Privilege") {
 				diags.AddError("Need to use Role attribute for getting and setting the privileges 'Users.x.Role'",
 					"Need to use Role attribute for getting and setting the privileges 'Users.x.Role'")
-				return diags
+				return false, diags
 			}
 		}
 	} else {
@@ -335,7 +474,7 @@ func updateRedfishDellIdracAttributes(ctx context.Context, service *gofish.Servi
 			if strings.HasPrefix(k, "Users.") && strings.HasSuffix(k, ".Role") {
 				diags.AddError("Need to use Privilege attribute for getting and setting the privileges 'Users.x.Privilege'",
 					"Need to use Privilege attribute for getting and setting the privileges 'Users.x.Privilege'")
-				return diags
+				return false, diags
 			}
 		}
 	}
@@ -344,40 +483,40 @@ func updateRedfishDellIdracAttributes(ctx context.Context, service *gofish.Servi
 	attributesToPatch, err := setManagerAttributesRightType(attributesTf, managerAttributeRegistry)
 	if err != nil {
 		diags.AddError(idracError, err.Error())
-		return diags
+		return false, diags
 	}
 
 	// Check that all attributes passed are compliant with the API
 	err = checkManagerAttributes(managerAttributeRegistry, attributesToPatch)
 	if err != nil {
 		diags.AddError(idracError, err.Error())
-		return diags
+		return false, diags
 	}
 
 	// get managers (Dell servers have only the iDRAC)
 	managers, err := service.Managers()
 	if err != nil {
 		diags.AddError(idracError, err.Error())
-		return diags
+		return false, diags
 	}
 
 	// Get OEM
 	dellManager, err := dell.Manager(managers[0])
 	if err != nil {
 		diags.AddError(idracError, err.Error())
-		return diags
+		return false, diags
 	}
 
 	// Get Dell attributes
 	dellAttributes, err := dellManager.DellAttributes()
 	if err != nil {
 		diags.AddError(idracError, err.Error())
-		return diags
+		return false, diags
 	}
 	idracAttributes, err := getIdracAttributes(dellAttributes)
 	if err != nil {
 		diags.AddError(idracError, err.Error())
-		return diags
+		return false, diags
 	}
 
 	// Set the body to send
@@ -392,12 +531,35 @@ func updateRedfishDellIdracAttributes(ctx context.Context, service *gofish.Servi
 	response, err := service.GetClient().Patch(idracAttributes.ODataID, patchBody)
 	if err != nil {
 		diags.AddError(idracError, err.Error())
-		return diags
+		return false, diags
 	}
-	response.Body.Close() // #nosec G104
+	defer response.Body.Close() // #nosec G104
 	d.ID = types.StringValue(idracAttributes.ODataID)
-	diags = readRedfishDellIdracAttributes(ctx, service, d)
-	return diags
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return false, diags
+	}
+	return managerResetRequired(responseBody), diags
+}
+
+// managerResetRequired reports whether the extended info of an attributes PATCH response asks for an iDRAC reset.
+func managerResetRequired(body []byte) bool {
+	var response struct {
+		ExtendedInfo []struct {
+			Message    string `json:"Message"`
+			Resolution string `json:"Resolution"`
+		} `json:"@Message.ExtendedInfo"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return false
+	}
+	for _, info := range response.ExtendedInfo {
+		if strings.Contains(strings.ToLower(info.Message+" "+info.Resolution), "reset") {
+			return true
+		}
+	}
+	return false
 }
 
 func readRedfishDellIdracAttributes(_ context.Context, service *gofish.Service, d *models.DellIdracAttributes) diag.Diagnostics {
@@ -353,6 +353,41 @@ func TestAccRedfishIDRACAttributesBelow17GParam(t *testing.T) {
 	}
 }
 
+func TestAccRedfishIDRACAttributesOnReset(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceIDracAttributesOnResetConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_dell_idrac_attributes.idrac", "settings_apply_time", "OnReset"),
+					resource.TestCheckResourceAttr("redfish_dell_idrac_attributes.idrac", "attributes.Time.1.Timezone", "CST6CDT"),
+					resource.TestCheckResourceAttr("redfish_dell_idrac_attributes.idrac", "attributes.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestManagerResetRequired(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		want bool
+	}{
+		{"reset requested", `{"@Message.ExtendedInfo":[{"Message":"The changes will take effect after the iDRAC is reset."}]}`, true},
+		{"reset in resolution", `{"@Message.ExtendedInfo":[{"Message":"Pending.","Resolution":"Reset the iDRAC to apply."}]}`, true},
+		{"success", `{"@Message.ExtendedInfo":[{"Message":"Successfully Completed Request"}]}`, false},
+		{"empty body", ``, false},
+	}
+	for _, c := range cases {
+		if got := managerResetRequired([]byte(c.body)); got != c.want {
+			t.Errorf("%s: expected %t, got %t", c.name, c.want, got)
+		}
+	}
+}
+
 func testAccRedfishResourceIDracAttributesConfig(testingInfo TestingServerCredentials, username string) string {
 	return fmt.Sprintf(`
 	resource "redfish_dell_idrac_attributes" "idrac" {
@@ -458,3 +493,27 @@ func testAccRedfishResourceIDracBelow17GConfigError(testingInfo TestingServerCre
 		username,
 	)
 }
+
+func testAccRedfishResourceIDracAttributesOnResetConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	resource "redfish_dell_idrac_attributes" "idrac" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+
+		settings_apply_time = "OnReset"
+		reset_type          = "GracefulRestart"
+
+		attributes = {
+		  "Time.1.Timezone" = "CST6CDT"
+		}
+	  }
+	  `,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
@@ -116,11 +116,12 @@ func (r *dellLCAttributesResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -147,11 +148,12 @@ func (r *dellLCAttributesResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -181,11 +183,12 @@ func (r *dellLCAttributesResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -252,7 +255,7 @@ func (r *dellLCAttributesResource) ImportState(ctx context.Context, req resource
 		readAttributes[k] = types.StringValue("")
 	}
 
-	api, d := r.getLCEnv(&srv)
+	api, d := r.getLCEnv(ctx, &srv)
 	resp.Diagnostics = append(resp.Diagnostics, d...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -282,14 +285,15 @@ func (r *dellLCAttributesResource) ImportState(ctx context.Context, req resource
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attributes, types.MapValueMust(types.StringType, readAttributes))...)
 }
 
-func (r *dellLCAttributesResource) getLCEnv(rserver *[]models.RedfishServer) (*gofish.APIClient, diag.Diagnostics) {
+func (r *dellLCAttributesResource) getLCEnv(ctx context.Context, rserver *[]models.RedfishServer) (*gofish.APIClient, diag.Diagnostics) {
 	var d diag.Diagnostics
 	// Get service
-	api, err := NewConfig(r.p, rserver)
+	api, err := NewConfig(ctx, r.p, rserver)
 	if err != nil {
 		d.AddError(ServiceErrorMsg, err.Error())
 		return nil, d
 	}
+	d.Append(r.p.tlsDiagnostics()...)
 	return api, nil
 }
 
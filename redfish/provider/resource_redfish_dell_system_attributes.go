@@ -116,11 +116,12 @@ func (r *dellSystemAttributesResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -147,11 +148,12 @@ func (r *dellSystemAttributesResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -181,11 +183,12 @@ func (r *dellSystemAttributesResource) Update(ctx context.Context, req resource.
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -252,7 +255,7 @@ func (r *dellSystemAttributesResource) ImportState(ctx context.Context, req reso
 		readAttributes[k] = types.StringValue("")
 	}
 
-	api, d := r.getEnv(&srv)
+	api, d := r.getEnv(ctx, &srv)
 	resp.Diagnostics = append(resp.Diagnostics, d...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -282,14 +285,15 @@ func (r *dellSystemAttributesResource) ImportState(ctx context.Context, req reso
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attributes, types.MapValueMust(types.StringType, readAttributes))...)
 }
 
-func (r *dellSystemAttributesResource) getEnv(rserver *[]models.RedfishServer) (*gofish.APIClient, diag.Diagnostics) {
+func (r *dellSystemAttributesResource) getEnv(ctx context.Context, rserver *[]models.RedfishServer) (*gofish.APIClient, diag.Diagnostics) {
 	var d diag.Diagnostics
 	// Get service
-	api, err := NewConfig(r.p, rserver)
+	api, err := NewConfig(ctx, r.p, rserver)
 	if err != nil {
 		d.AddError(ServiceErrorMsg, err.Error())
 		return nil, d
 	}
+	d.Append(r.p.tlsDiagnostics()...)
 	return api, nil
 }
 
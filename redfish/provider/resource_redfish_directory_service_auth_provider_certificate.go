@@ -117,11 +117,12 @@ func (r *RedfishDirectoryServiceAuthProviderCertificateResource) Create(ctx cont
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 	certURI, count, diags := helper.GetCertificateDetailsURI(service)
@@ -187,11 +188,12 @@ func (r *RedfishDirectoryServiceAuthProviderCertificateResource) Update(ctx cont
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 	certURI, _, diags := helper.GetCertificateDetailsURI(service)
@@ -0,0 +1,335 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-redfish/common"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource = &driveIndicatorResource{}
+)
+
+const (
+	blinkTargetAction   = "BlinkTarget"
+	unblinkTargetAction = "UnblinkTarget"
+)
+
+// NewDriveIndicatorResource is a helper function to simplify the provider implementation.
+func NewDriveIndicatorResource() resource.Resource {
+	return &driveIndicatorResource{}
+}
+
+// driveIndicatorResource is the resource implementation.
+type driveIndicatorResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *driveIndicatorResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_drive_indicator configured")
+}
+
+// Metadata returns the resource type name.
+func (*driveIndicatorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "drive_indicator"
+}
+
+// DriveIndicatorSchema to design the schema for drive indicator resource.
+func DriveIndicatorSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the drive indicator resource. It is the URI of the drive.",
+			Description:         "ID of the drive indicator resource. It is the URI of the drive.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system",
+			Description:         "System ID of the system",
+			Computed:            true,
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"storage_controller_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage controller the drive is attached to",
+			Description:         "ID of the storage controller the drive is attached to",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"drive_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the drive to locate, either the `Id` or the `@odata.id` of the drive",
+			Description:         "ID of the drive to locate, either the Id or the @odata.id of the drive",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"blinking": schema.BoolAttribute{
+			MarkdownDescription: "Whether the indicator LED of the drive is blinking. The LED is turned off when the resource is destroyed.",
+			Description:         "Whether the indicator LED of the drive is blinking. The LED is turned off when the resource is destroyed.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*driveIndicatorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to blink the indicator LED of a drive, to physically locate it.",
+		Description:         "This resource is used to blink the indicator LED of a drive, to physically locate it.",
+		Attributes:          DriveIndicatorSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *driveIndicatorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_drive_indicator create: started")
+	var plan models.DriveIndicator
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(setDriveIndicator(ctx, api.Service, &plan, plan.Blinking.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_drive_indicator create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *driveIndicatorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_drive_indicator read: started")
+	var state models.DriveIndicator
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	drive, _, _, err := getIndicatorDrive(api.Service, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when retreiving the drive from the Redfish API", err.Error())
+		return
+	}
+	// The LED state is refreshed so that a LED turned off out of Terraform shows up as a change
+	state.Blinking = types.BoolValue(isDriveIndicatorBlinking(drive))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_drive_indicator read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *driveIndicatorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_drive_indicator update: started")
+	var plan models.DriveIndicator
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(setDriveIndicator(ctx, api.Service, &plan, plan.Blinking.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_drive_indicator update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *driveIndicatorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_drive_indicator delete: started")
+	var state models.DriveIndicator
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(setDriveIndicator(ctx, api.Service, &state, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_drive_indicator delete: finished")
+}
+
+// getIndicatorDrive resolves the drive of the resource on its storage controller.
+func getIndicatorDrive(service *gofish.Service, d *models.DriveIndicator) (*redfish.Drive, *redfish.Storage, *redfish.ComputerSystem, error) {
+	storage, system, err := getStorage(service, d.SystemID.ValueString(), d.StorageControllerID.ValueString())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	drives, err := storage.Drives()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error when getting the drives attached to controller: %w", err)
+	}
+	selected, err := getDrives(drives, nil, []string{d.DriveID.ValueString()})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return selected[0], storage, system, nil
+}
+
+// isDriveIndicatorBlinking reports whether the indicator LED of the drive is on.
+func isDriveIndicatorBlinking(drive *redfish.Drive) bool {
+	return drive.LocationIndicatorActive || drive.IndicatorLED == redfishcommon.BlinkingIndicatorLED ||
+		drive.IndicatorLED == redfishcommon.LitIndicatorLED
+}
+
+// setDriveIndicator turns the indicator LED of the drive on or off. The Dell RAID service actions are
+// used when the service advertises them, the drive properties are patched otherwise.
+func setDriveIndicator(ctx context.Context, service *gofish.Service, d *models.DriveIndicator, blinking bool) (diags diag.Diagnostics) {
+	// Lock the controller to avoid race conditions with other resources
+	defer lockStorageController(d.RedfishServer[0].Endpoint.ValueString(), d.StorageControllerID.ValueString(), false)()
+
+	drive, _, system, err := getIndicatorDrive(service, d)
+	if errors.Is(err, errNoStorageSubsystem) {
+		diags.AddError(noStorageSubsystemErrorMsg, err.Error())
+		return diags
+	}
+	if err != nil {
+		diags.AddError("Error when retreiving the drive from the Redfish API", err.Error())
+		return diags
+	}
+	d.SystemID = types.StringValue(system.ID)
+	d.ID = types.StringValue(drive.ODataID)
+
+	action := unblinkTargetAction
+	if blinking {
+		action = blinkTargetAction
+	}
+	if isDellService(service) && dellRaidServiceSupports(service, system, action) {
+		jobID, err := postDriveIndicatorAction(service, system, action, drive)
+		if err != nil {
+			diags.AddError("Error when setting the indicator LED of the drive", err.Error())
+			return diags
+		}
+		if jobID != "" {
+			err = common.WaitForTaskToFinishWithContext(ctx, service, jobID, defaultStorageVolumeJobCheckInterval, defaultStorageVolumeJobTimeout)
+			if err != nil {
+				diags.AddError(RedfishJobErrorMsg, err.Error())
+			}
+		}
+		return diags
+	}
+
+	drive.LocationIndicatorActive = blinking
+	if drive.IndicatorLED != "" {
+		drive.IndicatorLED = redfishcommon.OffIndicatorLED
+		if blinking {
+			drive.IndicatorLED = redfishcommon.BlinkingIndicatorLED
+		}
+	}
+	if err := drive.Update(); err != nil {
+		diags.AddError("Error when setting the indicator LED of the drive", err.Error())
+	}
+	return diags
+}
+
+// postDriveIndicatorAction submits the Dell RAID service blink action for the drive. It returns the URI of
+// the job the service created, if any, as the LED is usually updated in real time.
+func postDriveIndicatorAction(service *gofish.Service, system *redfish.ComputerSystem, action string, drive *redfish.Drive) (string, error) {
+	url := system.ODataID + "/Oem/Dell/DellRaidService/Actions/DellRaidService." + action
+	res, err := service.GetClient().Post(url, map[string]interface{}{"TargetFQDD": drive.ID})
+	if err != nil {
+		return "", fmt.Errorf("%s of drive %s failed: %w", action, drive.ID, err)
+	}
+	defer res.Body.Close()
+	return res.Header.Get("Location"), nil
+}
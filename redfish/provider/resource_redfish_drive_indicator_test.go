@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test to blink and stop blinking the indicator LED of a drive
+func TestAccRedfishDriveIndicator_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceDriveIndicatorConfig(creds, hotSpareDriveID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_drive_indicator.locate", "blinking", "true"),
+					resource.TestCheckResourceAttrSet("redfish_drive_indicator.locate", "id"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceDriveIndicatorConfig(creds, hotSpareDriveID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_drive_indicator.locate", "blinking", "false"),
+				),
+			},
+		},
+	})
+}
+
+// Test to blink the indicator LED of an invalid drive - Negative
+func TestAccRedfishDriveIndicator_InvalidDrive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceDriveIndicatorConfig(creds, "Disk.Bay.Invalid", true),
+				ExpectError: regexp.MustCompile("drives were not found on the controller"),
+			},
+		},
+	})
+}
+
+func TestIsDriveIndicatorBlinking(t *testing.T) {
+	tests := []struct {
+		drive redfish.Drive
+		want  bool
+	}{
+		{redfish.Drive{}, false},
+		{redfish.Drive{IndicatorLED: common.OffIndicatorLED}, false},
+		{redfish.Drive{IndicatorLED: common.BlinkingIndicatorLED}, true},
+		{redfish.Drive{LocationIndicatorActive: true}, true},
+	}
+	for _, test := range tests {
+		if got := isDriveIndicatorBlinking(&test.drive); got != test.want {
+			t.Errorf("isDriveIndicatorBlinking(%+v) = %v, want %v", test.drive, got, test.want)
+		}
+	}
+}
+
+func testAccRedfishResourceDriveIndicatorConfig(testingInfo TestingServerCredentials, driveID string, blinking bool) string {
+	return fmt.Sprintf(`
+	resource "redfish_drive_indicator" "locate" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+
+		storage_controller_id = "RAID.Integrated.1-1"
+		drive_id              = "%s"
+		blinking              = %t
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		driveID,
+		blinking,
+	)
+}
@@ -0,0 +1,445 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &eventSubscriptionResource{}
+	_ resource.ResourceWithImportState = &eventSubscriptionResource{}
+)
+
+// NewEventSubscriptionResource is a helper function to simplify the provider implementation.
+func NewEventSubscriptionResource() resource.Resource {
+	return &eventSubscriptionResource{}
+}
+
+// eventSubscriptionResource is the resource implementation.
+type eventSubscriptionResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *eventSubscriptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_event_subscription configured")
+}
+
+// Metadata returns the resource type name.
+func (*eventSubscriptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "event_subscription"
+}
+
+// Schema defines the schema for the resource.
+func (*eventSubscriptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to manage event subscriptions of the Redfish EventService. " +
+			"Events matching the subscription are sent to the destination URL.",
+		Description: "This Terraform resource is used to manage event subscriptions of the Redfish EventService. " +
+			"Events matching the subscription are sent to the destination URL.",
+		Attributes: EventSubscriptionSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// EventSubscriptionSchema to define the event subscription schema
+func EventSubscriptionSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the event subscription resource. It is the URI of the subscription.",
+			Description:         "ID of the event subscription resource. It is the URI of the subscription.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"destination": schema.StringAttribute{
+			MarkdownDescription: "URL events are sent to, e.g. `https://alerts.example.com/redfish`.",
+			Description:         "URL events are sent to, e.g. https://alerts.example.com/redfish.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"protocol": schema.StringAttribute{
+			MarkdownDescription: "Protocol used to send the events. Default is `Redfish`.",
+			Description:         "Protocol used to send the events. Default is Redfish.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(string(redfish.RedfishEventDestinationProtocol)),
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					string(redfish.RedfishEventDestinationProtocol),
+					string(redfish.SNMPv1EventDestinationProtocol),
+					string(redfish.SNMPv2cEventDestinationProtocol),
+					string(redfish.SNMPv3EventDestinationProtocol),
+					string(redfish.SMTPEventDestinationProtocol),
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"event_types": schema.ListAttribute{
+			MarkdownDescription: "Event types sent to the destination. " +
+				"Accepted values are `Alert`, `ResourceAdded`, `ResourceRemoved`, `ResourceUpdated`, `StatusChange`, `MetricReport` and `Other`.",
+			Description: "Event types sent to the destination. " +
+				"Accepted values are Alert, ResourceAdded, ResourceRemoved, ResourceUpdated, StatusChange, MetricReport and Other.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf([]string{
+					string(redfish.AlertEventType),
+					string(redfish.ResourceAddedEventType),
+					string(redfish.ResourceRemovedEventType),
+					string(redfish.ResourceUpdatedEventType),
+					string(redfish.StatusChangeEventType),
+					string(redfish.MetricReportEventType),
+					string(redfish.OtherEventType),
+				}...)),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+				listplanmodifier.RequiresReplace(),
+			},
+		},
+		"registry_prefixes": schema.ListAttribute{
+			MarkdownDescription: "Prefixes of the message registries whose messages are sent to the destination, e.g. `iDRAC`. " +
+				"All registries are subscribed to when unset.",
+			Description: "Prefixes of the message registries whose messages are sent to the destination, e.g. iDRAC. " +
+				"All registries are subscribed to when unset.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.List{
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+				listplanmodifier.RequiresReplace(),
+			},
+		},
+		"context": schema.StringAttribute{
+			MarkdownDescription: "Client supplied string sent along with every event of the subscription.",
+			Description:         "Client supplied string sent along with every event of the subscription.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *eventSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_event_subscription create: started")
+	var plan models.EventSubscription
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	eventService, err := api.Service.EventService()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving the event service", err.Error())
+		return
+	}
+	if eventService.Subscriptions == "" {
+		resp.Diagnostics.AddError("Error creating the event subscription", "the event service doesn't expose a subscription collection")
+		return
+	}
+
+	response, err := api.Service.GetClient().Post(eventService.Subscriptions, eventSubscriptionPayload(ctx, &plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating the event subscription", err.Error())
+		return
+	}
+	response.Body.Close() // #nosec G104
+	location, err := response.Location()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating the event subscription", "the service didn't return the subscription location: "+err.Error())
+		return
+	}
+	plan.ID = types.StringValue(location.EscapedPath())
+
+	diags, found := readEventSubscription(ctx, api.Service, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error creating the event subscription", "the subscription was not found once created")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_event_subscription create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *eventSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_event_subscription read: started")
+	var state models.EventSubscription
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	diags, found := readEventSubscription(ctx, api.Service, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// iDRAC drops subscriptions whose destination stops answering, they are recreated on the next apply
+	if !found {
+		tflog.Warn(ctx, "event subscription no longer exists, removing it from the state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_event_subscription read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Only the context can be changed in place, every other attribute forces replacement.
+func (r *eventSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_event_subscription update: started")
+	var plan models.EventSubscription
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	if !plan.Context.IsUnknown() {
+		response, err := api.Service.GetClient().Patch(plan.ID.ValueString(), map[string]interface{}{"Context": plan.Context.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating the event subscription", err.Error())
+			return
+		}
+		response.Body.Close() // #nosec G104
+	}
+
+	diags, found := readEventSubscription(ctx, api.Service, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error updating the event subscription", "the subscription no longer exists")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_event_subscription update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *eventSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_event_subscription delete: started")
+	var state models.EventSubscription
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	err = redfish.DeleteEventDestination(api.Service.GetClient(), state.ID.ValueString())
+	// A subscription the iDRAC already dropped is as good as deleted
+	var redfishErr *redfishcommon.Error
+	if err != nil && !(errors.As(err, &redfishErr) && redfishErr.HTTPReturnedStatusCode == http.StatusNotFound) {
+		resp.Diagnostics.AddError("Error deleting the event subscription", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_event_subscription delete: finished")
+}
+
+// ImportState import state for an existing event subscription
+func (r *eventSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	type creds struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Endpoint     string `json:"endpoint"`
+		SslInsecure  bool   `json:"ssl_insecure"`
+		RedfishAlias string `json:"redfish_alias"`
+		ID           string `json:"id"`
+	}
+
+	var c creds
+	err := json.Unmarshal([]byte(req.ID), &c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
+	}
+	if c.ID == "" {
+		resp.Diagnostics.AddError("Error while importing the event subscription", "id of the subscription is required")
+		return
+	}
+
+	state := models.EventSubscription{
+		ID: types.StringValue(c.ID),
+		RedfishServer: []models.RedfishServer{{
+			User:         types.StringValue(c.Username),
+			Password:     types.StringValue(c.Password),
+			Endpoint:     types.StringValue(c.Endpoint),
+			SslInsecure:  types.BoolValue(c.SslInsecure),
+			RedfishAlias: types.StringValue(c.RedfishAlias),
+		}},
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	diags, found := readEventSubscription(ctx, api.Service, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error while importing the event subscription", fmt.Sprintf("subscription %s doesn't exist", c.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// eventSubscriptionPayload builds the POST body of a new subscription from the plan.
+func eventSubscriptionPayload(ctx context.Context, plan *models.EventSubscription) map[string]interface{} {
+	payload := map[string]interface{}{
+		"Destination": plan.Destination.ValueString(),
+		"Protocol":    plan.Protocol.ValueString(),
+	}
+	if !plan.EventTypes.IsNull() && !plan.EventTypes.IsUnknown() {
+		var eventTypes []string
+		plan.EventTypes.ElementsAs(ctx, &eventTypes, false)
+		payload["EventTypes"] = eventTypes
+	}
+	if !plan.RegistryPrefixes.IsNull() && !plan.RegistryPrefixes.IsUnknown() {
+		var registryPrefixes []string
+		plan.RegistryPrefixes.ElementsAs(ctx, &registryPrefixes, false)
+		payload["RegistryPrefixes"] = registryPrefixes
+	}
+	if !plan.Context.IsNull() && !plan.Context.IsUnknown() {
+		payload["Context"] = plan.Context.ValueString()
+	}
+	return payload
+}
+
+// readEventSubscription refreshes d from the subscription it points to.
+// It reports false when the subscription doesn't exist anymore.
+func readEventSubscription(ctx context.Context, service *gofish.Service, d *models.EventSubscription) (diag.Diagnostics, bool) {
+	var diags diag.Diagnostics
+	subscription, err := redfish.GetEventDestination(service.GetClient(), d.ID.ValueString())
+	if err != nil {
+		var redfishErr *redfishcommon.Error
+		if errors.As(err, &redfishErr) && redfishErr.HTTPReturnedStatusCode == http.StatusNotFound {
+			return diags, false
+		}
+		diags.AddError("Error reading the event subscription", err.Error())
+		return diags, false
+	}
+
+	d.Destination = types.StringValue(subscription.Destination)
+	d.Protocol = types.StringValue(string(subscription.Protocol))
+	d.Context = types.StringValue(subscription.Context)
+
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+	var d2 diag.Diagnostics
+	d.EventTypes, d2 = types.ListValueFrom(ctx, types.StringType, eventTypes)
+	diags.Append(d2...)
+	registryPrefixes := subscription.RegistryPrefixes
+	if registryPrefixes == nil {
+		registryPrefixes = []string{}
+	}
+	d.RegistryPrefixes, d2 = types.ListValueFrom(ctx, types.StringType, registryPrefixes)
+	diags.Append(d2...)
+	return diags, true
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Test to create, update and import an event subscription
+func TestAccRedfishEventSubscription_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceEventSubscriptionConfig(creds, "terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_event_subscription.alerts", "destination", "https://192.168.1.100:8443/redfish"),
+					resource.TestCheckResourceAttr("redfish_event_subscription.alerts", "protocol", "Redfish"),
+					resource.TestCheckResourceAttr("redfish_event_subscription.alerts", "event_types.0", "Alert"),
+					resource.TestCheckResourceAttr("redfish_event_subscription.alerts", "context", "terraform"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceEventSubscriptionConfig(creds, "terraform-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_event_subscription.alerts", "context", "terraform-updated"),
+				),
+			},
+			{
+				ResourceName:      "redfish_event_subscription.alerts",
+				ImportState:       true,
+				ImportStateIdFunc: testAccEventSubscriptionImportID,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_event_subscription.alerts", "context", "terraform-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestEventSubscriptionPayload(t *testing.T) {
+	eventTypes, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"Alert"})
+	plan := &models.EventSubscription{
+		Destination:      types.StringValue("https://192.168.1.100/redfish"),
+		Protocol:         types.StringValue("Redfish"),
+		EventTypes:       eventTypes,
+		RegistryPrefixes: types.ListUnknown(types.StringType),
+		Context:          types.StringNull(),
+	}
+	payload := eventSubscriptionPayload(context.Background(), plan)
+	if payload["Destination"] != "https://192.168.1.100/redfish" || payload["Protocol"] != "Redfish" {
+		t.Errorf("unexpected payload %v", payload)
+	}
+	if eventTypes, ok := payload["EventTypes"].([]string); !ok || len(eventTypes) != 1 || eventTypes[0] != "Alert" {
+		t.Errorf("unexpected event types %v", payload["EventTypes"])
+	}
+	if _, ok := payload["RegistryPrefixes"]; ok {
+		t.Errorf("unknown registry prefixes must not be sent")
+	}
+	if _, ok := payload["Context"]; ok {
+		t.Errorf("unset context must not be sent")
+	}
+}
+
+func testAccEventSubscriptionImportID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["redfish_event_subscription.alerts"]
+	if !ok {
+		return "", fmt.Errorf("redfish_event_subscription.alerts not found in state")
+	}
+	return fmt.Sprintf(`{"username":"%s","password":"%s","endpoint":"%s","ssl_insecure":true,"id":"%s"}`,
+		creds.Username, creds.Password, creds.Endpoint, rs.Primary.ID), nil
+}
+
+func testAccRedfishResourceEventSubscriptionConfig(testingInfo TestingServerCredentials, subscriptionContext string) string {
+	return fmt.Sprintf(`
+	resource "redfish_event_subscription" "alerts" {
+		redfish_server {
+			user         = "%s"
+			password     = "%s"
+			endpoint     = "%s"
+			ssl_insecure = true
+		}
+
+		destination = "https://192.168.1.100:8443/redfish"
+		event_types = ["Alert"]
+		context     = "%s"
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		subscriptionContext,
+	)
+}
@@ -0,0 +1,259 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource = &foreignConfigResource{}
+)
+
+const (
+	foreignConfigImport = "Import"
+	foreignConfigClear  = "Clear"
+)
+
+// foreignConfigActions maps the resource actions to the Dell RAID service actions
+var foreignConfigActions = map[string]string{
+	foreignConfigImport: "ImportForeignConfig",
+	foreignConfigClear:  "ClearForeignConfig",
+}
+
+// NewForeignConfigResource is a helper function to simplify the provider implementation.
+func NewForeignConfigResource() resource.Resource {
+	return &foreignConfigResource{}
+}
+
+// foreignConfigResource is the resource implementation.
+type foreignConfigResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *foreignConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_foreign_config configured")
+}
+
+// Metadata returns the resource type name.
+func (*foreignConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "foreign_config"
+}
+
+// ForeignConfigSchema to design the schema for foreign config resource.
+func ForeignConfigSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the foreign config resource. It is the URI of the storage controller.",
+			Description:         "ID of the foreign config resource. It is the URI of the storage controller.",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system",
+			Description:         "System ID of the system",
+			Computed:            true,
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"storage_controller_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage controller whose foreign configuration is imported or cleared",
+			Description:         "ID of the storage controller whose foreign configuration is imported or cleared",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"action": schema.StringAttribute{
+			MarkdownDescription: "Action performed on the foreign configuration. Accepted values: `Import`, `Clear`." +
+				" `Import` brings the foreign volumes under the control of the controller," +
+				" `Clear` discards them so that the drives can be reused.",
+			Description: "Action performed on the foreign configuration. Accepted values: Import, Clear." +
+				" Import brings the foreign volumes under the control of the controller," +
+				" Clear discards them so that the drives can be reused.",
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(foreignConfigImport, foreignConfigClear),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"apply_on": schema.MapAttribute{
+			MarkdownDescription: "Arbitrary values that trigger the action again whenever they change," +
+				" e.g. the IDs of the drives moved to the controller.",
+			Description: "Arbitrary values that trigger the action again whenever they change," +
+				" e.g. the IDs of the drives moved to the controller.",
+			ElementType: types.StringType,
+			Optional:    true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"job_timeout": schema.Int64Attribute{
+			MarkdownDescription: "The maximum amount of time in seconds to wait for the foreign configuration job to be completed",
+			Description:         "The maximum amount of time in seconds to wait for the foreign configuration job to be completed",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultStorageVolumeJobTimeout),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*foreignConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to import or clear the foreign configuration of a storage controller.",
+		Description:         "This resource is used to import or clear the foreign configuration of a storage controller.",
+		Attributes:          ForeignConfigSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *foreignConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_foreign_config create: started")
+	var plan models.ForeignConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(applyForeignConfigAction(ctx, api.Service, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_foreign_config create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (*foreignConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_foreign_config read: started")
+	var state models.ForeignConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Importing or clearing the foreign configuration is a one-time action, there is nothing to refresh
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_foreign_config read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (*foreignConfigResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Update should never happen, it will destroy and create in case of update
+	resp.Diagnostics.AddError(
+		"Error updating Foreign Config.",
+		"An update plan of Foreign Config should never be invoked. This resource is supposed to be replaced on update.",
+	)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (*foreignConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_foreign_config delete: started")
+	var state models.ForeignConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_foreign_config delete: finished")
+}
+
+// applyForeignConfigAction imports or clears the foreign configuration of the controller through the
+// Dell RAID service and waits for the job to finish.
+func applyForeignConfigAction(ctx context.Context, service *gofish.Service, d *models.ForeignConfig) (diags diag.Diagnostics) {
+	// Lock the controller to avoid race conditions with other resources
+	defer lockStorageController(d.RedfishServer[0].Endpoint.ValueString(), d.StorageControllerID.ValueString(), false)()
+
+	storage, system, err := getStorage(service, d.SystemID.ValueString(), d.StorageControllerID.ValueString())
+	if errors.Is(err, errNoStorageSubsystem) {
+		diags.AddError(noStorageSubsystemErrorMsg, err.Error())
+		return diags
+	}
+	if err != nil {
+		diags.AddError("Error when retreiving the Storage from the Redfish API", err.Error())
+		return diags
+	}
+	d.SystemID = types.StringValue(system.ID)
+	d.ID = types.StringValue(storage.ODataID)
+
+	action := foreignConfigActions[d.Action.ValueString()]
+	if !isDellService(service) || !dellRaidServiceSupports(service, system, action) {
+		diags.AddError("Error when applying the foreign configuration action",
+			fmt.Sprintf("the service doesn't support the %s action of the Dell RAID service", action))
+		return diags
+	}
+
+	if err := runControllerRaidAction(ctx, service, system, action, storage.ID, d.JobTimeout.ValueInt64()); err != nil {
+		diags.AddError("Error when applying the foreign configuration action", err.Error())
+	}
+	return diags
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to clear the foreign configuration of a storage controller
+func TestAccRedfishForeignConfig_Clear(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceForeignConfigConfig(creds, "RAID.Integrated.1-1", "Clear"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_foreign_config.foreign", "action", "Clear"),
+					resource.TestCheckResourceAttrSet("redfish_foreign_config.foreign", "id"),
+				),
+			},
+		},
+	})
+}
+
+// Test to apply a foreign configuration action with an invalid action - Negative
+func TestAccRedfishForeignConfig_InvalidAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceForeignConfigConfig(creds, "RAID.Integrated.1-1", "Delete"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+// Test to apply a foreign configuration action on an invalid controller - Negative
+func TestAccRedfishForeignConfig_InvalidController(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceForeignConfigConfig(creds, "RAID.Invalid", "Import"),
+				ExpectError: regexp.MustCompile("Error when retreiving the Storage from the Redfish API"),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceForeignConfigConfig(testingInfo TestingServerCredentials, controllerID, action string) string {
+	return fmt.Sprintf(`
+	resource "redfish_foreign_config" "foreign" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+
+		storage_controller_id = "%s"
+		action                = "%s"
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		controllerID,
+		action,
+	)
+}
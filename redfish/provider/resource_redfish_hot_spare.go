@@ -0,0 +1,416 @@
+/*
+Copyright (c) 2023-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-redfish/common"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource = &hotSpareResource{}
+)
+
+const (
+	assignSpareAction   = "AssignSpare"
+	unassignSpareAction = "UnassignSpare"
+)
+
+// NewHotSpareResource is a helper function to simplify the provider implementation.
+func NewHotSpareResource() resource.Resource {
+	return &hotSpareResource{}
+}
+
+// hotSpareResource is the resource implementation.
+type hotSpareResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *hotSpareResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_hot_spare configured")
+}
+
+// Metadata returns the resource type name.
+func (*hotSpareResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "hot_spare"
+}
+
+// HotSpareSchema to design the schema for hot spare resource.
+func HotSpareSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the hot spare resource",
+			Description:         "ID of the hot spare resource",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system",
+			Description:         "System ID of the system",
+			Computed:            true,
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"storage_controller_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage controller the global hot spares belong to",
+			Description:         "ID of the storage controller the global hot spares belong to",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"drive_ids": schema.ListAttribute{
+			MarkdownDescription: "IDs of the drives to assign as global hot spares, either the `Id` or the `@odata.id` of the drives." +
+				" Drives removed from the list are unassigned.",
+			Description: "IDs of the drives to assign as global hot spares, either the Id or the @odata.id of the drives." +
+				" Drives removed from the list are unassigned.",
+			Required:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+			},
+		},
+		"settings_apply_time": schema.StringAttribute{
+			MarkdownDescription: "Settings Apply Time. With `OnReset`, the server is reset to apply the spare jobs.",
+			Description:         "Settings Apply Time. With OnReset, the server is reset to apply the spare jobs.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(string(redfishcommon.ImmediateApplyTime)),
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					string(redfishcommon.ImmediateApplyTime),
+					string(redfishcommon.OnResetApplyTime),
+				}...),
+			},
+		},
+		"reset_type": schema.StringAttribute{
+			MarkdownDescription: "Reset Type",
+			Description:         "Reset Type",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(string(redfish.ForceRestartResetType)),
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					string(redfish.ForceRestartResetType),
+					string(redfish.GracefulRestartResetType),
+					string(redfish.PowerCycleResetType),
+					string(redfish.NmiResetType),
+					string(redfish.PushPowerButtonResetType),
+				}...),
+			},
+		},
+		"reset_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Reset Timeout",
+			Description:         "Reset Timeout",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultStorageVolumeResetTimeout),
+		},
+		"job_timeout": schema.Int64Attribute{
+			MarkdownDescription: "The maximum amount of time in seconds to wait for each spare job to be completed",
+			Description:         "The maximum amount of time in seconds to wait for each spare job to be completed",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultStorageVolumeJobTimeout),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*hotSpareResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the global hot spares of a storage controller.",
+		Description:         "This resource is used to manage the global hot spares of a storage controller.",
+		Attributes:          HotSpareSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *hotSpareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_hot_spare create: started")
+	var plan models.RedfishHotSpare
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	var driveIDs []string
+	resp.Diagnostics.Append(plan.DriveIDs.ElementsAs(ctx, &driveIDs, true)...)
+	resp.Diagnostics.Append(updateHotSpares(ctx, api.Service, &plan, driveIDs, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_hot_spare create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *hotSpareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_hot_spare read: started")
+	var state models.RedfishHotSpare
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	storage, _, err := getStorage(api.Service, state.SystemID.ValueString(), state.StorageControllerID.ValueString())
+	if errors.Is(err, errNoStorageSubsystem) {
+		resp.Diagnostics.AddError(noStorageSubsystemErrorMsg, err.Error())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error when retreiving the Storage from the Redfish API", err.Error())
+		return
+	}
+	drives, err := storage.Drives()
+	if err != nil {
+		resp.Diagnostics.AddError("Error when getting the drives attached to controller", err.Error())
+		return
+	}
+
+	// Only the drives that are still global hot spares are kept, so that a drive unassigned
+	// out of Terraform shows up as a change
+	spares := []attr.Value{}
+	for _, id := range state.DriveIDs.Elements() {
+		value, ok := id.(types.String)
+		if !ok {
+			continue
+		}
+		found, err := getDrives(drives, nil, []string{value.ValueString()})
+		if err == nil && found[0].HotspareType == redfish.GlobalHotspareType {
+			spares = append(spares, value)
+		}
+	}
+	state.DriveIDs, diags = types.ListValue(types.StringType, spares)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_hot_spare read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *hotSpareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_hot_spare update: started")
+	var plan, state models.RedfishHotSpare
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	var planIDs, stateIDs []string
+	resp.Diagnostics.Append(plan.DriveIDs.ElementsAs(ctx, &planIDs, true)...)
+	resp.Diagnostics.Append(state.DriveIDs.ElementsAs(ctx, &stateIDs, true)...)
+	resp.Diagnostics.Append(updateHotSpares(ctx, api.Service, &plan, setDiff(planIDs, stateIDs), setDiff(stateIDs, planIDs))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_hot_spare update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *hotSpareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_hot_spare delete: started")
+	var state models.RedfishHotSpare
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	var driveIDs []string
+	resp.Diagnostics.Append(state.DriveIDs.ElementsAs(ctx, &driveIDs, true)...)
+	resp.Diagnostics.Append(updateHotSpares(ctx, api.Service, &state, nil, driveIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_hot_spare delete: finished")
+}
+
+// updateHotSpares assigns and unassigns the given drives as global hot spares of the controller,
+// resetting the server first when the jobs are applied on reset, and waits for the jobs to finish.
+func updateHotSpares(ctx context.Context, service *gofish.Service, d *models.RedfishHotSpare, assign, unassign []string) (diags diag.Diagnostics) {
+	// Lock the controller to avoid race conditions with other resources
+	defer lockStorageController(d.RedfishServer[0].Endpoint.ValueString(), d.StorageControllerID.ValueString(),
+		d.SettingsApplyTime.ValueString() == string(redfishcommon.OnResetApplyTime))()
+
+	storage, system, err := getStorage(service, d.SystemID.ValueString(), d.StorageControllerID.ValueString())
+	if errors.Is(err, errNoStorageSubsystem) {
+		diags.AddError(noStorageSubsystemErrorMsg, err.Error())
+		return diags
+	}
+	if err != nil {
+		diags.AddError("Error when retreiving the Storage from the Redfish API", err.Error())
+		return diags
+	}
+	d.SystemID = types.StringValue(system.ID)
+	d.ID = types.StringValue(storage.ODataID)
+
+	allStorageDrives, err := storage.Drives()
+	if err != nil {
+		diags.AddError("Error when getting the drives attached to controller", err.Error())
+		return diags
+	}
+	assignDrives, err := getDrives(allStorageDrives, nil, assign)
+	if err != nil {
+		diags.AddError("Error when getting the drives", err.Error())
+		return diags
+	}
+	unassignDrives, err := getDrives(allStorageDrives, nil, unassign)
+	if err != nil {
+		diags.AddError("Error when getting the drives", err.Error())
+		return diags
+	}
+
+	jobIDs := []string{}
+	for _, drive := range unassignDrives {
+		jobID, err := postHotSpareAction(service, system, unassignSpareAction, drive)
+		if err != nil {
+			diags.AddError("Error when unassigning the global hot spare", err.Error())
+			return diags
+		}
+		jobIDs = append(jobIDs, jobID)
+	}
+	for _, drive := range assignDrives {
+		jobID, err := postHotSpareAction(service, system, assignSpareAction, drive)
+		if err != nil {
+			diags.AddError("Error when assigning the global hot spare", err.Error())
+			return diags
+		}
+		jobIDs = append(jobIDs, jobID)
+	}
+	if len(jobIDs) == 0 {
+		return diags
+	}
+
+	if d.SettingsApplyTime.ValueString() == string(redfishcommon.OnResetApplyTime) {
+		pOp := powerOperator{ctx, service, system.ID}
+		_, err := pOp.PowerOperation(d.ResetType.ValueString(), d.ResetTimeout.ValueInt64(), defaultStorageVolumeJobCheckInterval)
+		if err != nil {
+			diags.AddError(RedfishJobErrorMsg, err.Error())
+			return diags
+		}
+	}
+
+	for _, jobID := range jobIDs {
+		err := common.WaitForTaskToFinishWithContext(ctx, service, jobID, defaultStorageVolumeJobCheckInterval, d.JobTimeout.ValueInt64())
+		if err != nil {
+			diags.AddError(RedfishJobErrorMsg, err.Error())
+			return diags
+		}
+	}
+	return diags
+}
+
+// postHotSpareAction submits the Dell RAID service spare action for the drive and returns the URI of its job.
+// Without a virtual disk, the drive is assigned as a global hot spare.
+func postHotSpareAction(service *gofish.Service, system *redfish.ComputerSystem, action string, drive *redfish.Drive) (string, error) {
+	url := system.ODataID + "/Oem/Dell/DellRaidService/Actions/DellRaidService." + action
+	res, err := service.GetClient().Post(url, map[string]interface{}{"TargetFQDD": drive.ID})
+	if err != nil {
+		return "", fmt.Errorf("%s of drive %s failed: %w", action, drive.ID, err)
+	}
+	defer res.Body.Close()
+	jobID := res.Header.Get("Location")
+	if len(jobID) == 0 {
+		return "", fmt.Errorf("there was some error when retreiving the jobID of %s for drive %s", action, drive.ID)
+	}
+	return jobID, nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to assign a global hot spare
+func TestAccRedfishHotSpare_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceHotSpareConfig(creds, "RAID.Integrated.1-1", hotSpareDriveID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_hot_spare.spares", "drive_ids.#", "1"),
+					resource.TestCheckResourceAttr("redfish_hot_spare.spares", "drive_ids.0", hotSpareDriveID),
+				),
+			},
+		},
+	})
+}
+
+// Test to assign a drive that doesn't exist as global hot spare- Negative
+func TestAccRedfishHotSpare_InvalidDrive_Negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceHotSpareConfig(creds, "RAID.Integrated.1-1", "Disk.Bay.Invalid"),
+				ExpectError: regexp.MustCompile("the following drives were not found on the controller"),
+			},
+		},
+	})
+}
+
+// Test to assign global hot spares on an invalid controller- Negative
+func TestAccRedfishHotSpare_InvalidController_Negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceHotSpareConfig(creds, "Invalid-ID", hotSpareDriveID),
+				ExpectError: regexp.MustCompile("Error when retreiving the Storage from the Redfish API"),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceHotSpareConfig(testingInfo TestingServerCredentials, storageControllerID, driveID string) string {
+	return fmt.Sprintf(`
+	resource "redfish_hot_spare" "spares" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+		storage_controller_id = "%s"
+		drive_ids             = ["%s"]
+	  }
+	  `,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		storageControllerID,
+		driveID,
+	)
+}
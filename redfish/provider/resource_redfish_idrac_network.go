@@ -0,0 +1,595 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &idracNetworkResource{}
+	_ resource.ResourceWithValidateConfig = &idracNetworkResource{}
+	_ resource.ResourceWithImportState    = &idracNetworkResource{}
+)
+
+const (
+	// idracNetworkSettlePeriod is how long the iDRAC is given to apply a network change before it is trusted again
+	idracNetworkSettlePeriod int = 15
+	// unsetIPv4Address is reported by the iDRAC for unused address and name server slots
+	unsetIPv4Address = "0.0.0.0"
+)
+
+// NewIdracNetworkResource is a helper function to simplify the provider implementation.
+func NewIdracNetworkResource() resource.Resource {
+	return &idracNetworkResource{}
+}
+
+// idracNetworkResource is the resource implementation.
+type idracNetworkResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *idracNetworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_idrac_network configured")
+}
+
+// Metadata returns the resource type name.
+func (*idracNetworkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "idrac_network"
+}
+
+// Schema defines the schema for the resource.
+func (*idracNetworkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to configure the network of the iDRAC itself: " +
+			"IPv4 static addressing or DHCP, DNS servers and VLAN.",
+		Description: "This Terraform resource is used to configure the network of the iDRAC itself: " +
+			"IPv4 static addressing or DHCP, DNS servers and VLAN.",
+		Attributes: IdracNetworkSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// IdracNetworkSchema to define the iDRAC network schema
+func IdracNetworkSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the iDRAC network resource. It is the URI of the manager ethernet interface.",
+			Description:         "ID of the iDRAC network resource. It is the URI of the manager ethernet interface.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"manager_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager to configure. Defaults to the first manager of the server.",
+			Description:         "ID of the manager to configure. Defaults to the first manager of the server.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"interface_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager ethernet interface to configure. Defaults to the first interface of the manager.",
+			Description:         "ID of the manager ethernet interface to configure. Defaults to the first interface of the manager.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"dhcp_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the iDRAC gets its IPv4 address from DHCP. Set to `false` to use `ipv4_address`.",
+			Description:         "Whether the iDRAC gets its IPv4 address from DHCP. Set to false to use ipv4_address.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"ipv4_address": schema.StringAttribute{
+			MarkdownDescription: "Static IPv4 address of the iDRAC. Requires `dhcp_enabled` to be `false`.",
+			Description:         "Static IPv4 address of the iDRAC. Requires dhcp_enabled to be false.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.AlsoRequires(path.MatchRoot("ipv4_subnet_mask")),
+			},
+		},
+		"ipv4_subnet_mask": schema.StringAttribute{
+			MarkdownDescription: "Subnet mask of the static IPv4 address.",
+			Description:         "Subnet mask of the static IPv4 address.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.AlsoRequires(path.MatchRoot("ipv4_address")),
+			},
+		},
+		"ipv4_gateway": schema.StringAttribute{
+			MarkdownDescription: "Gateway of the static IPv4 address.",
+			Description:         "Gateway of the static IPv4 address.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.AlsoRequires(path.MatchRoot("ipv4_address")),
+			},
+		},
+		"dns_servers": schema.ListAttribute{
+			MarkdownDescription: "Static DNS servers of the iDRAC. When DHCP is enabled, setting them stops the iDRAC from using DHCP provided DNS servers.",
+			Description:         "Static DNS servers of the iDRAC. When DHCP is enabled, setting them stops the iDRAC from using DHCP provided DNS servers.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.List{
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"vlan_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether VLAN tagging is enabled on the iDRAC network.",
+			Description:         "Whether VLAN tagging is enabled on the iDRAC network.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"vlan_id": schema.Int64Attribute{
+			MarkdownDescription: "VLAN ID of the iDRAC network.",
+			Description:         "VLAN ID of the iDRAC network.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 4094),
+			},
+		},
+		"new_endpoint": schema.StringAttribute{
+			MarkdownDescription: "Endpoint the iDRAC is reachable at once the network change is applied, e.g. `https://<ipv4_address>`. " +
+				"Changing the iDRAC address drops the current connection, so the provider reconnects through this endpoint " +
+				"to verify the change and to read the network configuration afterwards.",
+			Description: "Endpoint the iDRAC is reachable at once the network change is applied, e.g. https://<ipv4_address>. " +
+				"Changing the iDRAC address drops the current connection, so the provider reconnects through this endpoint " +
+				"to verify the change and to read the network configuration afterwards.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"mac_address": schema.StringAttribute{
+			MarkdownDescription: "MAC address of the iDRAC network interface.",
+			Description:         "MAC address of the iDRAC network interface.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the resource config.
+func (*idracNetworkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.IdracNetwork
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.DHCPEnabled.ValueBool() && !config.IPv4Address.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("ipv4_address"), "Invalid iDRAC network configuration",
+			"ipv4_address can't be set when dhcp_enabled is true")
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *idracNetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_idrac_network create: started")
+	var plan models.IdracNetwork
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyIdracNetwork(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_idrac_network create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *idracNetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_idrac_network read: started")
+	var state models.IdracNetwork
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	servers, err := idracNetworkServers(r.p, state.RedfishServer, state.NewEndpoint)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	api, err := NewConfig(ctx, r.p, &servers)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(readIdracNetwork(ctx, api.Service, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_idrac_network read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *idracNetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_idrac_network update: started")
+	var plan, state models.IdracNetwork
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The iDRAC is still reachable at the endpoint the previous apply moved it to
+	plan.ID = state.ID
+	resp.Diagnostics.Append(r.applyIdracNetworkFrom(ctx, &plan, state.NewEndpoint)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_idrac_network update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// The iDRAC network can't be unconfigured, so it is left as is.
+func (*idracNetworkResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_idrac_network delete: started")
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_idrac_network delete: finished")
+}
+
+// ImportState import state for existing iDRAC network configuration
+func (r *idracNetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	type creds struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Endpoint     string `json:"endpoint"`
+		SslInsecure  bool   `json:"ssl_insecure"`
+		RedfishAlias string `json:"redfish_alias"`
+		ManagerID    string `json:"manager_id"`
+		InterfaceID  string `json:"interface_id"`
+	}
+
+	var c creds
+	err := json.Unmarshal([]byte(req.ID), &c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
+	}
+
+	server := models.RedfishServer{
+		User:         types.StringValue(c.Username),
+		Password:     types.StringValue(c.Password),
+		Endpoint:     types.StringValue(c.Endpoint),
+		SslInsecure:  types.BoolValue(c.SslInsecure),
+		RedfishAlias: types.StringValue(c.RedfishAlias),
+	}
+	state := models.IdracNetwork{
+		RedfishServer: []models.RedfishServer{server},
+		ManagerID:     types.StringValue(c.ManagerID),
+		InterfaceID:   types.StringValue(c.InterfaceID),
+		NewEndpoint:   types.StringNull(),
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(readIdracNetwork(ctx, api.Service, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// applyIdracNetwork patches the planned network configuration through the configured endpoint.
+func (r *idracNetworkResource) applyIdracNetwork(ctx context.Context, plan *models.IdracNetwork) diag.Diagnostics {
+	return r.applyIdracNetworkFrom(ctx, plan, types.StringNull())
+}
+
+// applyIdracNetworkFrom patches the planned network configuration through currentEndpoint, or the configured
+// endpoint when it is null, then reconnects through new_endpoint to read the applied configuration back.
+func (r *idracNetworkResource) applyIdracNetworkFrom(ctx context.Context, plan *models.IdracNetwork, currentEndpoint types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	idracError := "there was an issue when configuring the iDRAC network"
+
+	// A network change interrupts every other operation on the endpoint
+	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
+	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+
+	servers, err := idracNetworkServers(r.p, plan.RedfishServer, currentEndpoint)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	api, err := NewConfig(ctx, r.p, &servers)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	diags.Append(r.p.tlsDiagnostics()...)
+	ethernetInterface, err := getIdracEthernetInterface(api.Service, plan.ManagerID.ValueString(), plan.InterfaceID.ValueString())
+	if err != nil {
+		api.Logout()
+		diags.AddError(idracError, err.Error())
+		return diags
+	}
+	plan.ManagerID = types.StringValue(ethernetInterface.managerID)
+
+	payload := idracNetworkPayload(ctx, plan)
+	if len(payload) > 0 {
+		response, err := api.Service.GetClient().Patch(ethernetInterface.ODataID, payload)
+		if err == nil {
+			response.Body.Close() // #nosec G104
+		}
+		// The iDRAC may drop the connection before answering once the change is applied
+		var redfishErr *redfishcommon.Error
+		if err != nil && errors.As(err, &redfishErr) {
+			api.Logout()
+			diags.AddError(idracError, err.Error())
+			return diags
+		}
+		if err != nil {
+			tflog.Debug(ctx, "connection dropped while changing the iDRAC network", map[string]interface{}{"error": err.Error()})
+		}
+	}
+	api.Logout()
+
+	servers, err = idracNetworkServers(r.p, plan.RedfishServer, plan.NewEndpoint)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	if len(payload) > 0 {
+		connect := func() error {
+			api, err := NewConfig(ctx, r.p, &servers)
+			if err != nil {
+				return err
+			}
+			api.Logout()
+			return nil
+		}
+		err = waitForManagerRestart(ctx, connect, time.Duration(defaultCheckInterval)*time.Second,
+			time.Duration(idracNetworkSettlePeriod)*time.Second, time.Duration(defaultCheckTimeout)*time.Second)
+		if err != nil {
+			diags.AddError("Error reconnecting to the iDRAC after the network change",
+				fmt.Sprintf("%s. If the iDRAC address changed, set new_endpoint to the endpoint it is reachable at.", err.Error()))
+			return diags
+		}
+	}
+
+	api, err = NewConfig(ctx, r.p, &servers)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	diags.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+	diags.Append(readIdracNetwork(ctx, api.Service, plan)...)
+	return diags
+}
+
+// idracNetworkServers returns the server configuration to reach the iDRAC through endpoint.
+// When endpoint is null the configured server is returned unchanged.
+func idracNetworkServers(p *redfishProvider, servers []models.RedfishServer, endpoint types.String) ([]models.RedfishServer, error) {
+	if endpoint.IsNull() || endpoint.IsUnknown() || endpoint.ValueString() == "" {
+		return servers, nil
+	}
+	server := servers[0]
+	if err := getActiveAliasRedfishServer(p, &server); err != nil {
+		return nil, err
+	}
+	server.RedfishAlias = types.StringNull()
+	server.Endpoint = endpoint
+	return []models.RedfishServer{server}, nil
+}
+
+// idracEthernetInterface is a manager ethernet interface along with the ID of its manager.
+type idracEthernetInterface struct {
+	*redfish.EthernetInterface
+	managerID string
+}
+
+// getIdracEthernetInterface returns the ethernet interface of the manager. Empty IDs select the first one.
+func getIdracEthernetInterface(service *gofish.Service, managerID, interfaceID string) (*idracEthernetInterface, error) {
+	managers, err := service.Managers()
+	if err != nil {
+		return nil, err
+	}
+	if len(managers) == 0 {
+		return nil, fmt.Errorf("no managers were found on the server")
+	}
+	manager := managers[0]
+	if managerID != "" {
+		if manager, err = getManagerFromCollection(managers, managerID); err != nil {
+			return nil, err
+		}
+	}
+
+	interfaces, err := manager.EthernetInterfaces()
+	if err != nil {
+		return nil, err
+	}
+	if len(interfaces) == 0 {
+		return nil, fmt.Errorf("no ethernet interfaces were found on manager %s", manager.ID)
+	}
+	if interfaceID == "" {
+		return &idracEthernetInterface{interfaces[0], manager.ID}, nil
+	}
+	for _, ethernetInterface := range interfaces {
+		if ethernetInterface.ID == interfaceID {
+			return &idracEthernetInterface{ethernetInterface, manager.ID}, nil
+		}
+	}
+	return nil, fmt.Errorf("ethernet interface %s was not found on manager %s", interfaceID, manager.ID)
+}
+
+// idracNetworkPayload builds the PATCH body for the configured network attributes.
+// Attributes left to the iDRAC are unknown in the plan and are not sent.
+func idracNetworkPayload(ctx context.Context, plan *models.IdracNetwork) map[string]interface{} {
+	payload := make(map[string]interface{})
+	dhcp := make(map[string]interface{})
+	if !plan.DHCPEnabled.IsNull() && !plan.DHCPEnabled.IsUnknown() {
+		dhcp["DHCPEnabled"] = plan.DHCPEnabled.ValueBool()
+	}
+	if !plan.DNSServers.IsNull() && !plan.DNSServers.IsUnknown() {
+		var dnsServers []string
+		plan.DNSServers.ElementsAs(ctx, &dnsServers, false)
+		payload["StaticNameServers"] = dnsServers
+		if plan.DHCPEnabled.ValueBool() {
+			dhcp["UseDNSServers"] = false
+		}
+	}
+	if len(dhcp) > 0 {
+		payload["DHCPv4"] = dhcp
+	}
+
+	if !plan.IPv4Address.IsNull() && !plan.IPv4Address.IsUnknown() {
+		address := map[string]interface{}{
+			"Address":    plan.IPv4Address.ValueString(),
+			"SubnetMask": plan.IPv4SubnetMask.ValueString(),
+		}
+		if !plan.IPv4Gateway.IsNull() && !plan.IPv4Gateway.IsUnknown() {
+			address["Gateway"] = plan.IPv4Gateway.ValueString()
+		}
+		payload["IPv4StaticAddresses"] = []map[string]interface{}{address}
+	}
+
+	vlan := make(map[string]interface{})
+	if !plan.VLANEnabled.IsNull() && !plan.VLANEnabled.IsUnknown() {
+		vlan["VLANEnable"] = plan.VLANEnabled.ValueBool()
+	}
+	if !plan.VLANID.IsNull() && !plan.VLANID.IsUnknown() {
+		vlan["VLANId"] = plan.VLANID.ValueInt64()
+	}
+	if len(vlan) > 0 {
+		payload["VLAN"] = vlan
+	}
+	return payload
+}
+
+// readIdracNetwork sets the live network configuration of the manager ethernet interface into d.
+func readIdracNetwork(ctx context.Context, service *gofish.Service, d *models.IdracNetwork) diag.Diagnostics {
+	var diags diag.Diagnostics
+	ethernetInterface, err := getIdracEthernetInterface(service, d.ManagerID.ValueString(), d.InterfaceID.ValueString())
+	if err != nil {
+		diags.AddError("there was an issue when reading the iDRAC network", err.Error())
+		return diags
+	}
+
+	d.ID = types.StringValue(ethernetInterface.ODataID)
+	d.ManagerID = types.StringValue(ethernetInterface.managerID)
+	d.InterfaceID = types.StringValue(ethernetInterface.ID)
+	d.MACAddress = types.StringValue(ethernetInterface.MACAddress)
+	d.DHCPEnabled = types.BoolValue(ethernetInterface.DHCPv4.DHCPEnabled)
+
+	address := idracIPv4Address(ethernetInterface.EthernetInterface)
+	d.IPv4Address = types.StringValue(address.Address)
+	d.IPv4SubnetMask = types.StringValue(address.SubnetMask)
+	d.IPv4Gateway = types.StringValue(address.Gateway)
+
+	nameServers := ethernetInterface.NameServers
+	if !ethernetInterface.DHCPv4.DHCPEnabled || !ethernetInterface.DHCPv4.UseDNSServers {
+		nameServers = ethernetInterface.StaticNameServers
+	}
+	dnsServers, d2 := types.ListValueFrom(ctx, types.StringType, idracNameServers(nameServers))
+	diags.Append(d2...)
+	d.DNSServers = dnsServers
+
+	d.VLANEnabled = types.BoolValue(ethernetInterface.VLAN.VLANEnable)
+	d.VLANID = types.Int64Value(int64(ethernetInterface.VLAN.VLANID))
+	return diags
+}
+
+// idracIPv4Address returns the static IPv4 address when DHCP is off, else the address currently in use.
+func idracIPv4Address(ethernetInterface *redfish.EthernetInterface) redfish.IPv4Address {
+	if !ethernetInterface.DHCPv4.DHCPEnabled {
+		for _, address := range ethernetInterface.IPv4StaticAddresses {
+			if address.Address != "" && address.Address != unsetIPv4Address {
+				return address
+			}
+		}
+	}
+	for _, address := range ethernetInterface.IPv4Addresses {
+		if address.Address != "" && address.Address != unsetIPv4Address {
+			return address
+		}
+	}
+	return redfish.IPv4Address{}
+}
+
+// idracNameServers drops the empty slots the iDRAC reports in its name server lists.
+func idracNameServers(nameServers []string) []string {
+	servers := []string{}
+	for _, server := range nameServers {
+		if server = strings.TrimSpace(server); server != "" && server != unsetIPv4Address && server != "::" {
+			servers = append(servers, server)
+		}
+	}
+	return servers
+}
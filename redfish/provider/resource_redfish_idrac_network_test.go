@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Test to set the static DNS servers of the iDRAC and import the resource
+func TestAccRedfishIdracNetwork_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceIdracNetworkConfig(creds, `dns_servers = ["8.8.8.8", "8.8.4.4"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_idrac_network.network", "dns_servers.#", "2"),
+					resource.TestCheckResourceAttr("redfish_idrac_network.network", "dns_servers.0", "8.8.8.8"),
+					resource.TestCheckResourceAttrSet("redfish_idrac_network.network", "ipv4_address"),
+					resource.TestCheckResourceAttrSet("redfish_idrac_network.network", "mac_address"),
+				),
+			},
+			{
+				ResourceName:  "redfish_idrac_network.network",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf(`{"username":"%s","password":"%s","endpoint":"%s","ssl_insecure":true}`, creds.Username, creds.Password, creds.Endpoint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("redfish_idrac_network.network", "interface_id"),
+				),
+			},
+		},
+	})
+}
+
+// Test to set a static address while DHCP is enabled- Negative
+func TestAccRedfishIdracNetwork_DHCPWithStaticAddress_Negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceIdracNetworkConfig(creds, `
+				dhcp_enabled     = true
+				ipv4_address     = "10.0.0.10"
+				ipv4_subnet_mask = "255.255.255.0"`),
+				ExpectError: regexp.MustCompile("ipv4_address can't be set when dhcp_enabled is true"),
+			},
+		},
+	})
+}
+
+func TestIdracNetworkPayload(t *testing.T) {
+	dnsServers, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"8.8.8.8"})
+	plan := &models.IdracNetwork{
+		DHCPEnabled:    types.BoolValue(false),
+		IPv4Address:    types.StringValue("10.0.0.10"),
+		IPv4SubnetMask: types.StringValue("255.255.255.0"),
+		IPv4Gateway:    types.StringUnknown(),
+		DNSServers:     dnsServers,
+		VLANEnabled:    types.BoolUnknown(),
+		VLANID:         types.Int64Value(20),
+	}
+	payload := idracNetworkPayload(context.Background(), plan)
+
+	if dhcp, ok := payload["DHCPv4"].(map[string]interface{}); !ok || dhcp["DHCPEnabled"] != false {
+		t.Errorf("expected DHCP to be disabled, got %v", payload["DHCPv4"])
+	}
+	addresses, ok := payload["IPv4StaticAddresses"].([]map[string]interface{})
+	if !ok || len(addresses) != 1 || addresses[0]["Address"] != "10.0.0.10" {
+		t.Fatalf("unexpected static addresses %v", payload["IPv4StaticAddresses"])
+	}
+	if _, ok := addresses[0]["Gateway"]; ok {
+		t.Errorf("unknown gateway must not be sent")
+	}
+	if vlan := payload["VLAN"].(map[string]interface{}); vlan["VLANId"] != int64(20) || len(vlan) != 1 {
+		t.Errorf("unexpected VLAN %v", vlan)
+	}
+
+	plan = &models.IdracNetwork{
+		DHCPEnabled: types.BoolValue(true),
+		IPv4Address: types.StringNull(),
+		DNSServers:  dnsServers,
+		VLANEnabled: types.BoolNull(),
+		VLANID:      types.Int64Null(),
+	}
+	payload = idracNetworkPayload(context.Background(), plan)
+	if dhcp := payload["DHCPv4"].(map[string]interface{}); dhcp["UseDNSServers"] != false {
+		t.Errorf("static DNS servers with DHCP must stop DHCP provided ones, got %v", dhcp)
+	}
+	if _, ok := payload["VLAN"]; ok {
+		t.Errorf("VLAN must not be sent when it isn't configured")
+	}
+}
+
+func TestIdracIPv4Address(t *testing.T) {
+	ethernetInterface := &redfish.EthernetInterface{
+		IPv4StaticAddresses: []redfish.IPv4Address{{Address: "10.0.0.10", SubnetMask: "255.255.255.0"}},
+		IPv4Addresses:       []redfish.IPv4Address{{Address: "10.0.0.20"}},
+	}
+	if address := idracIPv4Address(ethernetInterface); address.Address != "10.0.0.10" {
+		t.Errorf("expected the static address, got %s", address.Address)
+	}
+	ethernetInterface.DHCPv4.DHCPEnabled = true
+	if address := idracIPv4Address(ethernetInterface); address.Address != "10.0.0.20" {
+		t.Errorf("expected the DHCP address, got %s", address.Address)
+	}
+}
+
+func TestIdracNameServers(t *testing.T) {
+	servers := idracNameServers([]string{"8.8.8.8", "0.0.0.0", "", "::", "8.8.4.4"})
+	if len(servers) != 2 || servers[0] != "8.8.8.8" || servers[1] != "8.8.4.4" {
+		t.Errorf("unexpected name servers %v", servers)
+	}
+}
+
+func testAccRedfishResourceIdracNetworkConfig(testingInfo TestingServerCredentials, network string) string {
+	return fmt.Sprintf(`
+	resource "redfish_idrac_network" "network" {
+		redfish_server {
+			user         = "%s"
+			password     = "%s"
+			endpoint     = "%s"
+			ssl_insecure = true
+		}
+
+		%s
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		network,
+	)
+}
@@ -0,0 +1,447 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &managerNTPResource{}
+	_ resource.ResourceWithImportState = &managerNTPResource{}
+)
+
+// NewManagerNTPResource is a helper function to simplify the provider implementation.
+func NewManagerNTPResource() resource.Resource {
+	return &managerNTPResource{}
+}
+
+// managerNTPResource is the resource implementation.
+type managerNTPResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *managerNTPResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_manager_ntp configured")
+}
+
+// Metadata returns the resource type name.
+func (*managerNTPResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "manager_ntp"
+}
+
+// Schema defines the schema for the resource.
+func (*managerNTPResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to configure the NTP servers of the manager and to enable or" +
+			" disable NTP, through the network protocol settings of the manager. The manager is reset when it asks for it" +
+			" to apply the configuration.",
+		Description: "This Terraform resource is used to configure the NTP servers of the manager and to enable or" +
+			" disable NTP, through the network protocol settings of the manager. The manager is reset when it asks for it" +
+			" to apply the configuration.",
+		Attributes: ManagerNTPSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// ManagerNTPSchema to define the manager NTP schema
+func ManagerNTPSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager NTP resource. It is the URI of the network protocol settings of the manager.",
+			Description:         "ID of the manager NTP resource. It is the URI of the network protocol settings of the manager.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"manager_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the manager to configure. Defaults to the first manager of the server.",
+			Description:         "ID of the manager to configure. Defaults to the first manager of the server.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"ntp_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the manager synchronizes its time with `ntp_servers`.",
+			Description:         "Whether the manager synchronizes its time with ntp_servers.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"ntp_servers": schema.ListAttribute{
+			MarkdownDescription: "IP addresses or host names of the NTP servers, in order of preference." +
+				" The manager has a fixed number of NTP server slots, commonly 3 on iDRAC, the slots beyond the list are cleared.",
+			Description: "IP addresses or host names of the NTP servers, in order of preference." +
+				" The manager has a fixed number of NTP server slots, commonly 3 on iDRAC, the slots beyond the list are cleared.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.List{
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(hostnameOrIPValidator{}),
+			},
+		},
+		"reset_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Time in seconds that the provider waits for the manager to come back, when it must be reset" +
+				" to apply the NTP configuration. Default is `300`.",
+			Description: "Time in seconds that the provider waits for the manager to come back, when it must be reset" +
+				" to apply the NTP configuration. Default is 300.",
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(int64(defaultCheckTimeout)),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(int64(managerRestartGracePeriod)),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *managerNTPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_manager_ntp create: started")
+	var plan models.ManagerNTP
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyManagerNTP(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_manager_ntp create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *managerNTPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_manager_ntp read: started")
+	var state models.ManagerNTP
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(readManagerNTP(ctx, api.Service, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_manager_ntp read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *managerNTPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_manager_ntp update: started")
+	var plan models.ManagerNTP
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyManagerNTP(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_manager_ntp update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// The NTP configuration of the manager is left as is.
+func (*managerNTPResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_manager_ntp delete: started")
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_manager_ntp delete: finished")
+}
+
+// ImportState import state for existing NTP configuration
+func (r *managerNTPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	type creds struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Endpoint     string `json:"endpoint"`
+		SslInsecure  bool   `json:"ssl_insecure"`
+		RedfishAlias string `json:"redfish_alias"`
+		ManagerID    string `json:"manager_id"`
+	}
+
+	var c creds
+	err := json.Unmarshal([]byte(req.ID), &c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
+	}
+
+	server := models.RedfishServer{
+		User:         types.StringValue(c.Username),
+		Password:     types.StringValue(c.Password),
+		Endpoint:     types.StringValue(c.Endpoint),
+		SslInsecure:  types.BoolValue(c.SslInsecure),
+		RedfishAlias: types.StringValue(c.RedfishAlias),
+	}
+	state := models.ManagerNTP{
+		RedfishServer: []models.RedfishServer{server},
+		ManagerID:     types.StringValue(c.ManagerID),
+		ResetTimeout:  types.Int64Value(int64(defaultCheckTimeout)),
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(readManagerNTP(ctx, api.Service, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// applyManagerNTP patches the planned NTP configuration and reads it back.
+func (r *managerNTPResource) applyManagerNTP(ctx context.Context, plan *models.ManagerNTP) diag.Diagnostics {
+	var diags diag.Diagnostics
+	ntpError := "there was an issue when configuring the manager NTP"
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	diags.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	manager, err := selectManager(api.Service, plan.ManagerID.ValueString())
+	if err != nil {
+		diags.AddError(ntpError, err.Error())
+		return diags
+	}
+	networkProtocol, err := getManagerNetworkProtocol(api.Service, manager)
+	if err != nil {
+		diags.AddError(ntpError, err.Error())
+		return diags
+	}
+
+	payload, err := managerNTPPayload(ctx, plan, len(networkProtocol.NTP.NTPServers))
+	if err != nil {
+		diags.AddError(ntpError, err.Error())
+		return diags
+	}
+	resetRequired := false
+	if len(payload) > 0 {
+		response, err := api.Service.GetClient().Patch(networkProtocol.ODataID, payload)
+		if err != nil {
+			diags.AddError(ntpError, err.Error())
+			return diags
+		}
+		body, err := io.ReadAll(response.Body)
+		response.Body.Close() // #nosec G104
+		resetRequired = err == nil && managerResetRequired(body)
+	}
+	plan.ManagerID = types.StringValue(manager.ID)
+
+	if resetRequired {
+		tflog.Info(ctx, "the manager asked for a reset to apply the NTP configuration")
+		err = manager.Reset(redfish.GracefulRestartResetType)
+		if err != nil {
+			diags.AddError("Error resetting the manager", err.Error())
+			return diags
+		}
+		// The session doesn't survive the reset, so a new one is opened on every attempt
+		connect := func() error {
+			api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+			if err != nil {
+				return err
+			}
+			api.Logout()
+			return nil
+		}
+		err = waitForManagerRestart(ctx, connect, time.Duration(defaultCheckInterval)*time.Second,
+			time.Duration(managerRestartGracePeriod)*time.Second, time.Duration(plan.ResetTimeout.ValueInt64())*time.Second)
+		if err != nil {
+			diags.AddError("Error waiting for the manager to restart", err.Error())
+			return diags
+		}
+		api, err = NewConfig(ctx, r.p, &plan.RedfishServer)
+		if err != nil {
+			diags.AddError(ServiceErrorMsg, err.Error())
+			return diags
+		}
+		diags.Append(r.p.tlsDiagnostics()...)
+		defer api.Logout()
+	}
+
+	diags.Append(readManagerNTP(ctx, api.Service, plan)...)
+	return diags
+}
+
+// selectManager returns the manager of the service with the given ID, or its first manager when the ID is empty.
+func selectManager(service *gofish.Service, managerID string) (*redfish.Manager, error) {
+	managers, err := service.Managers()
+	if err != nil {
+		return nil, err
+	}
+	if len(managers) == 0 {
+		return nil, fmt.Errorf("no managers were found on the server")
+	}
+	if managerID == "" {
+		return managers[0], nil
+	}
+	return getManagerFromCollection(managers, managerID)
+}
+
+// managerNetworkProtocol is the part of the network protocol settings of a manager handled by the provider.
+type managerNetworkProtocol struct {
+	ODataID string `json:"@odata.id"`
+	NTP     struct {
+		ProtocolEnabled bool
+		NTPServers      []string
+	}
+}
+
+// getManagerNetworkProtocol returns the network protocol settings of the manager.
+func getManagerNetworkProtocol(service *gofish.Service, manager *redfish.Manager) (*managerNetworkProtocol, error) {
+	var links struct {
+		NetworkProtocol struct {
+			ODataID string `json:"@odata.id"`
+		}
+	}
+	if err := getJSON(service, manager.ODataID, &links); err != nil {
+		return nil, err
+	}
+	if links.NetworkProtocol.ODataID == "" {
+		return nil, fmt.Errorf("manager %s has no network protocol settings", manager.ID)
+	}
+
+	var networkProtocol managerNetworkProtocol
+	if err := getJSON(service, links.NetworkProtocol.ODataID, &networkProtocol); err != nil {
+		return nil, err
+	}
+	if networkProtocol.ODataID == "" {
+		networkProtocol.ODataID = links.NetworkProtocol.ODataID
+	}
+	return &networkProtocol, nil
+}
+
+// getJSON decodes the resource at uri into v.
+func getJSON(service *gofish.Service, uri string, v interface{}) error {
+	response, err := service.GetClient().Get(uri)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close() // #nosec G104
+	return json.NewDecoder(response.Body).Decode(v)
+}
+
+// managerNTPPayload builds the PATCH body of the planned NTP configuration. The servers are padded with empty
+// strings up to the slots of the manager, so that the servers removed from the list are cleared.
+// Attributes left to the manager are unknown in the plan and are not sent.
+func managerNTPPayload(ctx context.Context, plan *models.ManagerNTP, slots int) (map[string]interface{}, error) {
+	ntp := make(map[string]interface{})
+	if !plan.NTPEnabled.IsNull() && !plan.NTPEnabled.IsUnknown() {
+		ntp["ProtocolEnabled"] = plan.NTPEnabled.ValueBool()
+	}
+	if !plan.NTPServers.IsNull() && !plan.NTPServers.IsUnknown() {
+		var servers []string
+		plan.NTPServers.ElementsAs(ctx, &servers, false)
+		if slots > 0 && len(servers) > slots {
+			return nil, fmt.Errorf("the manager has %d NTP server slots, %d servers were given", slots, len(servers))
+		}
+		for len(servers) < slots {
+			servers = append(servers, "")
+		}
+		ntp["NTPServers"] = servers
+	}
+	if len(ntp) == 0 {
+		return nil, nil
+	}
+	return map[string]interface{}{"NTP": ntp}, nil
+}
+
+// readManagerNTP sets the live NTP configuration of the manager into d.
+func readManagerNTP(ctx context.Context, service *gofish.Service, d *models.ManagerNTP) diag.Diagnostics {
+	var diags diag.Diagnostics
+	ntpError := "there was an issue when reading the manager NTP"
+	manager, err := selectManager(service, d.ManagerID.ValueString())
+	if err != nil {
+		diags.AddError(ntpError, err.Error())
+		return diags
+	}
+	networkProtocol, err := getManagerNetworkProtocol(service, manager)
+	if err != nil {
+		diags.AddError(ntpError, err.Error())
+		return diags
+	}
+
+	d.ID = types.StringValue(networkProtocol.ODataID)
+	d.ManagerID = types.StringValue(manager.ID)
+	d.NTPEnabled = types.BoolValue(networkProtocol.NTP.ProtocolEnabled)
+	servers := []string{}
+	for _, server := range networkProtocol.NTP.NTPServers {
+		if server = strings.TrimSpace(server); server != "" {
+			servers = append(servers, server)
+		}
+	}
+	ntpServers, d2 := types.ListValueFrom(ctx, types.StringType, servers)
+	diags.Append(d2...)
+	d.NTPServers = ntpServers
+	return diags
+}
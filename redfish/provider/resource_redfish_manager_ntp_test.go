@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to configure the NTP servers of the manager and import the resource
+func TestAccRedfishManagerNTP_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceManagerNTP(creds, `ntp_enabled = true
+				ntp_servers = ["0.pool.ntp.org", "192.168.0.10"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_manager_ntp.ntp", "ntp_enabled", "true"),
+					resource.TestCheckResourceAttr("redfish_manager_ntp.ntp", "ntp_servers.#", "2"),
+					resource.TestCheckResourceAttr("redfish_manager_ntp.ntp", "ntp_servers.0", "0.pool.ntp.org"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceManagerNTP(creds, `ntp_enabled = false
+				ntp_servers = ["192.168.0.10"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_manager_ntp.ntp", "ntp_enabled", "false"),
+					resource.TestCheckResourceAttr("redfish_manager_ntp.ntp", "ntp_servers.#", "1"),
+				),
+			},
+			{
+				ResourceName:  "redfish_manager_ntp.ntp",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf(`{"username":"%s","password":"%s","endpoint":"%s","ssl_insecure":true}`, creds.Username, creds.Password, creds.Endpoint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_manager_ntp.ntp", "ntp_servers.0", "192.168.0.10"),
+				),
+			},
+		},
+	})
+}
+
+// Test to configure a malformed NTP server- Negative
+func TestAccRedfishManagerNTP_InvalidServer_Negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceManagerNTP(creds, `ntp_servers = ["ntp server.example.com"]`),
+				ExpectError: regexp.MustCompile("Invalid host"),
+			},
+		},
+	})
+}
+
+func TestHostnameOrIPValidator(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{value: "192.168.0.10", valid: true},
+		{value: "fe80::1", valid: true},
+		{value: "ntp.example.com", valid: true},
+		{value: "time-1", valid: true},
+		{value: "192.168.0", valid: false},
+		{value: "ntp server", valid: false},
+		{value: "-ntp.example.com", valid: false},
+		{value: "", valid: false},
+	}
+	for _, tt := range tests {
+		req := validator.StringRequest{ConfigValue: types.StringValue(tt.value)}
+		resp := &validator.StringResponse{}
+		hostnameOrIPValidator{}.ValidateString(context.Background(), req, resp)
+		if resp.Diagnostics.HasError() == tt.valid {
+			t.Errorf("hostnameOrIPValidator(%q) valid = %t, expected %t", tt.value, !resp.Diagnostics.HasError(), tt.valid)
+		}
+	}
+}
+
+func TestManagerNTPPayload(t *testing.T) {
+	ctx := context.Background()
+	servers, _ := types.ListValueFrom(ctx, types.StringType, []string{"ntp1.example.com"})
+	plan := &models.ManagerNTP{
+		NTPEnabled: types.BoolValue(true),
+		NTPServers: servers,
+	}
+	payload, err := managerNTPPayload(ctx, plan, 3)
+	if err != nil {
+		t.Fatalf("managerNTPPayload() error = %v", err)
+	}
+	ntp := payload["NTP"].(map[string]interface{})
+	if ntp["ProtocolEnabled"] != true {
+		t.Errorf("expected NTP to be enabled, got %v", ntp["ProtocolEnabled"])
+	}
+	got := ntp["NTPServers"].([]string)
+	if len(got) != 3 || got[0] != "ntp1.example.com" || got[1] != "" || got[2] != "" {
+		t.Errorf("expected the servers to be padded to the 3 slots, got %q", got)
+	}
+
+	if _, err := managerNTPPayload(ctx, plan, 0); err != nil {
+		t.Errorf("expected no error when the manager reports no slots, got %v", err)
+	}
+	tooMany, _ := types.ListValueFrom(ctx, types.StringType, []string{"a.example.com", "b.example.com"})
+	plan.NTPServers = tooMany
+	if _, err := managerNTPPayload(ctx, plan, 1); err == nil {
+		t.Errorf("expected an error for more servers than slots")
+	}
+
+	unknown := &models.ManagerNTP{
+		NTPEnabled: types.BoolUnknown(),
+		NTPServers: types.ListUnknown(types.StringType),
+	}
+	if payload, _ := managerNTPPayload(ctx, unknown, 3); payload != nil {
+		t.Errorf("expected no payload when nothing is configured, got %v", payload)
+	}
+}
+
+func testAccRedfishResourceManagerNTP(testingInfo TestingServerCredentials, ntp string) string {
+	return fmt.Sprintf(`
+	resource "redfish_manager_ntp" "ntp" {
+		redfish_server {
+			user         = "%s"
+			password     = "%s"
+			endpoint     = "%s"
+			ssl_insecure = true
+		}
+
+		%s
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		ntp,
+	)
+}
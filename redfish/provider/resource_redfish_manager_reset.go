@@ -20,11 +20,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"terraform-provider-redfish/redfish/models"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -42,6 +45,8 @@ var (
 const (
 	defaultCheckInterval int = 5
 	defaultCheckTimeout  int = 300
+	// managerRestartGracePeriod is how long the manager is given to go down once its reset is requested
+	managerRestartGracePeriod int = 60
 )
 
 // NewManagerResetResource is a helper function to simplify the provider implementation.
@@ -83,18 +88,30 @@ func ManagerResetSchema() map[string]schema.Attribute {
 			},
 		},
 		"reset_type": schema.StringAttribute{
-			MarkdownDescription: "The type of the reset operation to be performed. Accepted value: GracefulRestart",
-			Description:         "The type of the reset operation to be performed. Accepted value: GracefulRestart",
+			MarkdownDescription: "The type of the reset operation to be performed. Accepted values: `GracefulRestart`, `ForceRestart`",
+			Description:         "The type of the reset operation to be performed. Accepted values: GracefulRestart, ForceRestart",
 			Required:            true,
 			Validators: []validator.String{
 				stringvalidator.OneOf(
 					string(redfish.GracefulRestartResetType),
+					string(redfish.ForceRestartResetType),
 				),
 			},
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.RequiresReplace(),
 			},
 		},
+		"reset_on": schema.MapAttribute{
+			MarkdownDescription: "Arbitrary values that trigger a new reset of the manager whenever they change," +
+				" e.g. attributes of the resources whose changes require the manager to restart.",
+			Description: "Arbitrary values that trigger a new reset of the manager whenever they change," +
+				" e.g. attributes of the resources whose changes require the manager to restart.",
+			ElementType: types.StringType,
+			Optional:    true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
 	}
 }
 
@@ -126,11 +143,12 @@ func (r *managerResetResource) Create(ctx context.Context, req resource.CreateRe
 	resetType := plan.ResetType.ValueString()
 	managerID := plan.Id.ValueString()
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("Error while getting service", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -140,6 +158,10 @@ func (r *managerResetResource) Create(ctx context.Context, req resource.CreateRe
 		resp.Diagnostics.AddError("Error while retrieving manager from redfish API", err.Error())
 		return
 	}
+	if err := checkManagerResetType(manager, redfish.ResetType(resetType)); err != nil {
+		resp.Diagnostics.AddError("Error resetting manager", err.Error())
+		return
+	}
 	manager.Entity.SetETag("")
 	// Perform manager reset
 	err = manager.Reset(redfish.ResetType(resetType))
@@ -148,27 +170,17 @@ func (r *managerResetResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	api, err = NewConfig(r.p, &plan.RedfishServer)
-	if err != nil {
-		resp.Diagnostics.AddError("Error while getting service", err.Error())
-		return
-	}
-	service = api.Service
-	defer api.Logout()
-
-	activeServer := (plan.RedfishServer)[0]
-	if err := getActiveAliasRedfishServer(r.p, &activeServer); err != nil {
-		resp.Diagnostics.AddError("Error while getting active server", err.Error())
-		return
-	}
-	// Check iDRAC status
-	checker := ServerStatusChecker{
-		Service:  service,
-		Endpoint: activeServer.Endpoint.ValueString(),
-		Interval: defaultCheckInterval,
-		Timeout:  defaultCheckTimeout,
+	// The session doesn't survive the restart, so a new one is opened on every attempt
+	connect := func() error {
+		api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+		if err != nil {
+			return err
+		}
+		api.Logout()
+		return nil
 	}
-	err = checker.Check(ctx)
+	err = waitForManagerRestart(ctx, connect, time.Duration(defaultCheckInterval)*time.Second,
+		time.Duration(managerRestartGracePeriod)*time.Second, time.Duration(defaultCheckTimeout)*time.Second)
 	if err != nil {
 		resp.Diagnostics.AddError("Error while rebooting iDRAC. Operation may take longer duration to complete", err.Error())
 		return
@@ -191,11 +203,12 @@ func (r *managerResetResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("Error while getting service", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 
 	service := api.Service
 	defer api.Logout()
@@ -262,3 +275,48 @@ func getManager(service *gofish.Service, managerID string) (*redfish.Manager, er
 	}
 	return manager, nil
 }
+
+// checkManagerResetType checks the reset type is one the manager supports, when it lists them.
+func checkManagerResetType(manager *redfish.Manager, resetType redfish.ResetType) error {
+	if len(manager.SupportedResetTypes) == 0 {
+		return nil
+	}
+	supported := []string{}
+	for _, supportedType := range manager.SupportedResetTypes {
+		if supportedType == resetType {
+			return nil
+		}
+		supported = append(supported, string(supportedType))
+	}
+	return fmt.Errorf("reset type %s is not supported by manager %s, supported reset types: %s",
+		resetType, manager.ID, strings.Join(supported, ", "))
+}
+
+// waitForManagerRestart waits for the manager to go down once reset, then polls it until connect succeeds again.
+// Connection errors are expected while the manager restarts and are retried until the timeout. The manager is
+// considered restarted if it doesn't go down within the grace period, as a fast restart may go unnoticed.
+func waitForManagerRestart(ctx context.Context, connect func() error, interval, gracePeriod, timeout time.Duration) error {
+	start := time.Now()
+	wentDown := false
+	for {
+		err := connect()
+		if err == nil && (wentDown || time.Since(start) >= gracePeriod) {
+			return nil
+		}
+		if err != nil {
+			wentDown = true
+			tflog.Trace(tflog.SetField(ctx, "error", err.Error()), "Manager unreachable")
+		}
+		if time.Since(start)+interval > timeout {
+			if err != nil {
+				return fmt.Errorf("the manager did not come back within %s: %w", timeout, err)
+			}
+			return nil
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
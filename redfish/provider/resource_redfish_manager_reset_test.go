@@ -18,13 +18,16 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 // Test to create manager reset resource with invalid reset type- Negative
@@ -233,6 +236,97 @@ func TestAccRedfishManagerReset_ReadMockErr(t *testing.T) {
 	}
 }
 
+// Test to reset the manager again when the reset_on values change
+func TestAccRedfishManagerReset_ResetOn(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceManagerResetOnConfig(creds, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_manager_reset.manager_reset", "reset_on.trigger", "first"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceManagerResetOnConfig(creds, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_manager_reset.manager_reset", "reset_on.trigger", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestCheckManagerResetType(t *testing.T) {
+	manager := &redfish.Manager{SupportedResetTypes: []redfish.ResetType{redfish.GracefulRestartResetType}}
+	if err := checkManagerResetType(manager, redfish.GracefulRestartResetType); err != nil {
+		t.Errorf("expected GracefulRestart to be supported, got %s", err)
+	}
+	if err := checkManagerResetType(manager, redfish.ForceRestartResetType); err == nil {
+		t.Error("expected ForceRestart not to be supported")
+	}
+	if err := checkManagerResetType(&redfish.Manager{}, redfish.ForceRestartResetType); err != nil {
+		t.Errorf("expected any reset type when none is listed, got %s", err)
+	}
+}
+
+func TestWaitForManagerRestart(t *testing.T) {
+	connectAfter := func(results ...error) (func() error, *int) {
+		attempts := 0
+		return func() error {
+			attempts++
+			if attempts <= len(results) {
+				return results[attempts-1]
+			}
+			return nil
+		}, &attempts
+	}
+	refused := fmt.Errorf("connect: connection refused")
+
+	connect, attempts := connectAfter(nil, refused, refused)
+	if err := waitForManagerRestart(context.Background(), connect, time.Millisecond, time.Minute, time.Minute); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if *attempts != 4 {
+		t.Errorf("expected to wait for the manager to go down and come back, got %d attempts", *attempts)
+	}
+
+	connect, _ = connectAfter()
+	if err := waitForManagerRestart(context.Background(), connect, time.Millisecond, 10*time.Millisecond, time.Minute); err != nil {
+		t.Errorf("expected a manager not going down to be restarted after the grace period, got %s", err)
+	}
+
+	down := func() error { return refused }
+	if err := waitForManagerRestart(context.Background(), down, time.Millisecond, time.Millisecond, 20*time.Millisecond); err == nil {
+		t.Error("expected an error when the manager doesn't come back")
+	}
+}
+
+func testAccRedfishResourceManagerResetOnConfig(testingInfo TestingServerCredentials, trigger string) string {
+	return fmt.Sprintf(`
+	resource "redfish_manager_reset" "manager_reset" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+
+		id         = "iDRAC.Embedded.1"
+		reset_type = "GracefulRestart"
+		reset_on = {
+		  trigger = "%s"
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		trigger,
+	)
+}
+
 func testAccRedfishResourceManagerResetConfig(testingInfo TestingServerCredentials,
 	managerID string,
 	resetType string,
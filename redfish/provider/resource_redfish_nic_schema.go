@@ -163,9 +163,9 @@ func NICResourceSchema() map[string]schema.Attribute {
 		},
 		"system_id": schema.StringAttribute{
 			MarkdownDescription: "ID of the system resource. If the value for system ID is not provided, " +
-				"the resource picks the first system available from the iDRAC.",
+				"the resource picks the only system available from the iDRAC.",
 			Description: "ID of the system resource. If the value for system ID is not provided, " +
-				"the resource picks the first system available from the iDRAC.",
+				"the resource picks the only system available from the iDRAC.",
 			Computed:   true,
 			Optional:   true,
 			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
@@ -23,6 +23,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -31,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
 	"github.com/stmcginnis/gofish/redfish"
 )
 
@@ -78,11 +81,12 @@ func PowerSchema() map[string]schema.Attribute {
 				"'GracefulRestart','GracefulShutdown','PowerCycle', 'PushPowerButton', 'Nmi'",
 			Description: "Desired power setting. Applicable values are 'On','ForceOn','ForceOff','ForceRestart'," +
 				"'GracefulRestart','GracefulShutdown','PowerCycle', 'PushPowerButton', 'Nmi'",
-			Required: true,
+			Optional: true,
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.RequiresReplaceIfConfigured(),
 			},
 			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("desired_power_state")),
 				stringvalidator.OneOf(
 					string(redfish.OnResetType),
 					string(redfish.ForceOnResetType),
@@ -97,6 +101,29 @@ func PowerSchema() map[string]schema.Attribute {
 			},
 		},
 
+		"desired_power_state": schema.StringAttribute{
+			MarkdownDescription: "Desired power state of the server, applied by the matching reset action. Applicable values are" +
+				" 'On','ForceOff','GracefulShutdown','ForceRestart','GracefulRestart','PowerCycle','Nmi'." +
+				" Unlike `desired_power_action`, it is updated in place and the power state of the server is refreshed," +
+				" so a server found in another power state shows up as drift.",
+			Description: "Desired power state of the server, applied by the matching reset action. Applicable values are" +
+				" 'On','ForceOff','GracefulShutdown','ForceRestart','GracefulRestart','PowerCycle','Nmi'." +
+				" Unlike desired_power_action, it is updated in place and the power state of the server is refreshed," +
+				" so a server found in another power state shows up as drift.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(redfish.OnResetType),
+					string(redfish.ForceOffResetType),
+					string(redfish.GracefulShutdownResetType),
+					string(redfish.ForceRestartResetType),
+					string(redfish.GracefulRestartResetType),
+					string(redfish.PowerCycleResetType),
+					string(redfish.NmiResetType),
+				),
+			},
+		},
+
 		"maximum_wait_time": schema.Int64Attribute{
 			MarkdownDescription: "The maximum amount of time to wait for the server to enter the correct power state before" +
 				"giving up in seconds",
@@ -161,11 +188,12 @@ func (r *powerResource) Create(ctx context.Context, req resource.CreateRequest,
 	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
 	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 	system, err := getSystemResource(service, plan.SystemID.ValueString())
@@ -176,10 +204,22 @@ func (r *powerResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.SystemID = types.StringValue(system.ID)
 	plan.PowerId = types.StringValue(system.SerialNumber + "_power")
 
+	if !plan.DesiredPowerState.IsNull() {
+		resp.Diagnostics.Append(applyDesiredPowerState(ctx, service, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		diags = resp.State.Set(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		tflog.Trace(ctx, "resource_power create: finish")
+		return
+	}
+
 	resetType := plan.DesiredPowerAction.ValueString()
 	pOp := powerOperator{ctx, service, plan.SystemID.ValueString()}
 	powerState, pErr := pOp.PowerOperation(resetType, plan.MaximumWaitTime.ValueInt64(), plan.CheckInterval.ValueInt64())
 	if pErr != nil {
+		resp.Diagnostics.AddError("Error when applying the desired power action", pErr.Error())
 		return
 	}
 	// time to allow changes to get reflected
@@ -208,11 +248,12 @@ func (r *powerResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -223,6 +264,9 @@ func (r *powerResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 	state.SystemID = types.StringValue(system.ID)
 	state.PowerState = types.StringValue(string(system.PowerState))
+	if !state.DesiredPowerState.IsNull() {
+		state.DesiredPowerState = types.StringValue(observedPowerState(state.DesiredPowerState.ValueString(), system.PowerState))
+	}
 
 	tflog.Trace(ctx, "resource_power read: finished reading state")
 	// Save into State
@@ -232,7 +276,7 @@ func (r *powerResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
-func (*powerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+func (r *powerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get state Data
 	tflog.Trace(ctx, "resource_power update: started")
 	var state, plan models.Power
@@ -249,9 +293,35 @@ func (*powerResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	if !plan.DesiredPowerState.IsNull() && plan.DesiredPowerState.ValueString() != state.DesiredPowerState.ValueString() {
+		// Lock the mutex to avoid race conditions with other resources
+		redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
+		defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+
+		api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+		if err != nil {
+			resp.Diagnostics.AddError("service error", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+		defer api.Logout()
+
+		plan.PowerId = state.PowerId
+		plan.SystemID = state.SystemID
+		resp.Diagnostics.Append(applyDesiredPowerState(ctx, api.Service, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		diags = resp.State.Set(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		tflog.Trace(ctx, "resource_power update: finished")
+		return
+	}
+
 	state.MaximumWaitTime = plan.MaximumWaitTime
 	state.CheckInterval = plan.CheckInterval
 	state.RedfishServer = plan.RedfishServer
+	state.DesiredPowerState = plan.DesiredPowerState
 	tflog.Trace(ctx, "resource_power update: finished state update")
 	// Save into State
 	diags = resp.State.Set(ctx, &state)
@@ -273,3 +343,43 @@ func (*powerResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	resp.State.RemoveResource(ctx)
 	tflog.Trace(ctx, "resource_power delete: finished")
 }
+
+// targetPowerState returns the power state the server is in once the reset action has completed.
+func targetPowerState(resetType string) redfish.PowerState {
+	if resetType == string(redfish.ForceOffResetType) || resetType == string(redfish.GracefulShutdownResetType) {
+		return redfish.OffPowerState
+	}
+	return redfish.OnPowerState
+}
+
+// observedPowerState returns the desired power state to keep in state for the observed power state of the server.
+// When the server is not in the power state reached by the desired one, the reset action reaching the observed
+// power state is returned so the difference shows up in the plan.
+func observedPowerState(desired string, observed redfish.PowerState) string {
+	if observed != redfish.OnPowerState && observed != redfish.OffPowerState {
+		// The server is still transitioning
+		return desired
+	}
+	if targetPowerState(desired) == observed {
+		return desired
+	}
+	if observed == redfish.OffPowerState {
+		return string(redfish.ForceOffResetType)
+	}
+	return string(redfish.OnResetType)
+}
+
+// applyDesiredPowerState runs the reset action of desired_power_state, PowerOperation waits for the server to reach
+// the matching power state within maximum_wait_time.
+func applyDesiredPowerState(ctx context.Context, service *gofish.Service, plan *models.Power) diag.Diagnostics {
+	var diags diag.Diagnostics
+	desired := plan.DesiredPowerState.ValueString()
+	pOp := powerOperator{ctx, service, plan.SystemID.ValueString()}
+	powerState, err := pOp.PowerOperation(desired, plan.MaximumWaitTime.ValueInt64(), plan.CheckInterval.ValueInt64())
+	if err != nil {
+		diags.AddError("Error when applying the desired power state", err.Error())
+		return diags
+	}
+	plan.PowerState = types.StringValue(string(powerState))
+	return diags
+}
@@ -24,8 +24,43 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
+func TestResetWaiter(t *testing.T) {
+	tests := []struct {
+		name            string
+		target          redfish.PowerState
+		needsTransition bool
+		states          []redfish.PowerState
+		doneAt          int
+	}{
+		{"restart seen going down", redfish.OnPowerState, true,
+			[]redfish.PowerState{redfish.OnPowerState, redfish.OffPowerState, redfish.PoweringOnPowerState, redfish.OnPowerState}, 3},
+		{"restart not gone down yet", redfish.OnPowerState, true,
+			[]redfish.PowerState{redfish.OnPowerState, redfish.OnPowerState}, -1},
+		{"power on", redfish.OnPowerState, false,
+			[]redfish.PowerState{redfish.PoweringOnPowerState, redfish.OnPowerState}, 1},
+		{"power off", redfish.OffPowerState, false,
+			[]redfish.PowerState{redfish.OnPowerState, redfish.PoweringOffPowerState, redfish.OffPowerState}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			waiter := resetWaiter{target: tt.target, needsTransition: tt.needsTransition}
+			doneAt := -1
+			for i, state := range tt.states {
+				if waiter.done(state) {
+					doneAt = i
+					break
+				}
+			}
+			if doneAt != tt.doneAt {
+				t.Errorf("expected the reset to complete at check %d, got %d", tt.doneAt, doneAt)
+			}
+		})
+	}
+}
+
 // redfish.Power represents a concrete Go type that represents an API resource
 func TestAccRedfishPowerT1(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -101,6 +136,51 @@ func TestAccRedfishPower_Invalid(t *testing.T) {
 	})
 }
 
+func TestAccRedfishPowerDesiredState(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourcePowerStateConfig(creds, "On"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_power.system_power", "power_state", "On"),
+				),
+			},
+			{
+				Config: testAccRedfishResourcePowerStateConfig(creds, "ForceOff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_power.system_power", "desired_power_state", "ForceOff"),
+					resource.TestCheckResourceAttr("redfish_power.system_power", "power_state", "Off"),
+				),
+			},
+			{
+				Config: testAccRedfishResourcePowerStateConfig(creds, "On"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_power.system_power", "power_state", "On"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishPowerDesiredState_Invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourcePowerStateConfig(creds, "PushPowerButton"),
+				ExpectError: regexp.MustCompile("desired_power_state value must be one of"),
+			},
+			{
+				Config:      testAccRedfishResourcePowerBothConfig(creds),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
 func testAccRedfishResourcePowerConfig(testingInfo TestingServerCredentials,
 	desiredPowerAction string,
 	maximumWaitTime int,
@@ -154,3 +234,50 @@ func testAccRedfishResourcePowerConfig1(testingInfo TestingServerCredentials,
 		desiredPowerAction,
 	)
 }
+
+func testAccRedfishResourcePowerStateConfig(testingInfo TestingServerCredentials,
+	desiredPowerState string,
+) string {
+	return fmt.Sprintf(`
+
+		resource "redfish_power" "system_power" {
+
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  system_id = "System.Embedded.1"
+		  desired_power_state = "%s"
+		  maximum_wait_time = 120
+		  check_interval = 10
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		desiredPowerState,
+	)
+}
+
+func testAccRedfishResourcePowerBothConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+
+		resource "redfish_power" "system_power" {
+
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  desired_power_action = "On"
+		  desired_power_state = "On"
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
@@ -0,0 +1,262 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &resetControllerConfigResource{}
+	_ resource.ResourceWithValidateConfig = &resetControllerConfigResource{}
+)
+
+// resetConfigAction is the Dell RAID service action deleting all the volumes of a controller
+const resetConfigAction = "ResetConfig"
+
+// NewResetControllerConfigResource is a helper function to simplify the provider implementation.
+func NewResetControllerConfigResource() resource.Resource {
+	return &resetControllerConfigResource{}
+}
+
+// resetControllerConfigResource is the resource implementation.
+type resetControllerConfigResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *resetControllerConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_reset_controller_config configured")
+}
+
+// Metadata returns the resource type name.
+func (*resetControllerConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "reset_controller_config"
+}
+
+// ResetControllerConfigSchema to design the schema for reset controller config resource.
+func ResetControllerConfigSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the reset controller config resource. It is the URI of the storage controller.",
+			Description:         "ID of the reset controller config resource. It is the URI of the storage controller.",
+			Computed:            true,
+		},
+		"system_id": schema.StringAttribute{
+			MarkdownDescription: "System ID of the system",
+			Description:         "System ID of the system",
+			Computed:            true,
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"storage_controller_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the storage controller whose configuration is reset",
+			Description:         "ID of the storage controller whose configuration is reset",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"i_understand_this_destroys_all_volumes": schema.BoolAttribute{
+			MarkdownDescription: "Confirmation that all the volumes of the controller are deleted. It must be set to `true`.",
+			Description:         "Confirmation that all the volumes of the controller are deleted. It must be set to true.",
+			Required:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"reset_on": schema.MapAttribute{
+			MarkdownDescription: "Arbitrary values that trigger a new reset of the controller configuration whenever they change.",
+			Description:         "Arbitrary values that trigger a new reset of the controller configuration whenever they change.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"job_timeout": schema.Int64Attribute{
+			MarkdownDescription: "The maximum amount of time in seconds to wait for the reset configuration job to be completed",
+			Description:         "The maximum amount of time in seconds to wait for the reset configuration job to be completed",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultStorageVolumeJobTimeout),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (*resetControllerConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to reset the configuration of a storage controller, deleting all of its volumes.",
+		Description:         "This resource is used to reset the configuration of a storage controller, deleting all of its volumes.",
+		Attributes:          ResetControllerConfigSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+// ValidateConfig validates the resource config.
+func (*resetControllerConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.ResetControllerConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.Confirm.IsUnknown() && !config.Confirm.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("i_understand_this_destroys_all_volumes"), "Reset of the controller configuration not confirmed",
+			"i_understand_this_destroys_all_volumes must be set to true, as all the volumes of the controller are deleted")
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *resetControllerConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_reset_controller_config create: started")
+	var plan models.ResetControllerConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(resetControllerConfig(ctx, api.Service, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_reset_controller_config create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (*resetControllerConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_reset_controller_config read: started")
+	var state models.ResetControllerConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resetting the controller configuration is a one-time action, there is nothing to refresh
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_reset_controller_config read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (*resetControllerConfigResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Update should never happen, it will destroy and create in case of update
+	resp.Diagnostics.AddError(
+		"Error updating Reset Controller Config.",
+		"An update plan of Reset Controller Config should never be invoked. This resource is supposed to be replaced on update.",
+	)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (*resetControllerConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_reset_controller_config delete: started")
+	var state models.ResetControllerConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_reset_controller_config delete: finished")
+}
+
+// resetControllerConfig deletes all the volumes of the controller through the Dell RAID service and waits for the job.
+func resetControllerConfig(ctx context.Context, service *gofish.Service, d *models.ResetControllerConfig) (diags diag.Diagnostics) {
+	if !d.Confirm.ValueBool() {
+		diags.AddError("Reset of the controller configuration not confirmed",
+			"i_understand_this_destroys_all_volumes must be set to true, as all the volumes of the controller are deleted")
+		return diags
+	}
+
+	// Lock the controller to avoid race conditions with other resources
+	defer lockStorageController(d.RedfishServer[0].Endpoint.ValueString(), d.StorageControllerID.ValueString(), false)()
+
+	storage, system, err := getStorage(service, d.SystemID.ValueString(), d.StorageControllerID.ValueString())
+	if errors.Is(err, errNoStorageSubsystem) {
+		diags.AddError(noStorageSubsystemErrorMsg, err.Error())
+		return diags
+	}
+	if err != nil {
+		diags.AddError("Error when retreiving the Storage from the Redfish API", err.Error())
+		return diags
+	}
+	d.SystemID = types.StringValue(system.ID)
+	d.ID = types.StringValue(storage.ODataID)
+
+	if !isDellService(service) || !dellRaidServiceSupports(service, system, resetConfigAction) {
+		diags.AddError("Error when resetting the controller configuration",
+			fmt.Sprintf("the service doesn't support the %s action of the Dell RAID service", resetConfigAction))
+		return diags
+	}
+
+	if err := runControllerRaidAction(ctx, service, system, resetConfigAction, storage.ID, d.JobTimeout.ValueInt64()); err != nil {
+		diags.AddError("Error when resetting the controller configuration", err.Error())
+	}
+	return diags
+}
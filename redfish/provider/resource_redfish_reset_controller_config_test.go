@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to reset the configuration of a storage controller
+func TestAccRedfishResetControllerConfig_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceResetControllerConfigConfig(creds, "RAID.Integrated.1-1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("redfish_reset_controller_config.reset", "id"),
+				),
+			},
+		},
+	})
+}
+
+// Test to reset the configuration of a storage controller without confirmation - Negative
+func TestAccRedfishResetControllerConfig_NotConfirmed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceResetControllerConfigConfig(creds, "RAID.Integrated.1-1", false),
+				ExpectError: regexp.MustCompile("Reset of the controller configuration not confirmed"),
+			},
+		},
+	})
+}
+
+// Test to reset the configuration of an invalid storage controller - Negative
+func TestAccRedfishResetControllerConfig_InvalidController(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceResetControllerConfigConfig(creds, "RAID.Invalid", true),
+				ExpectError: regexp.MustCompile("Error when retreiving the Storage from the Redfish API"),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceResetControllerConfigConfig(testingInfo TestingServerCredentials, controllerID string, confirm bool) string {
+	return fmt.Sprintf(`
+	resource "redfish_reset_controller_config" "reset" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+
+		storage_controller_id                  = "%s"
+		i_understand_this_destroys_all_volumes = %t
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		controllerID,
+		confirm,
+	)
+}
@@ -60,6 +60,23 @@ const (
 	intervalJobCheckTime int64 = 10
 )
 
+// scpTargets are the components a Server Configuration Profile can be filtered by.
+var scpTargets = []string{
+	"ALL",
+	"IDRAC",
+	"BIOS",
+	"NIC",
+	"RAID",
+	"FC",
+	"InfiniBand",
+	"SupportAssist",
+	"EventFilters",
+	"System",
+	"LifecycleController",
+	"AHCI",
+	"PCIeSSD",
+}
+
 // NewScpExportResource is a helper function to simplify the provider implementation.
 func NewScpExportResource() resource.Resource {
 	return &ScpExportResource{}
@@ -356,21 +373,7 @@ func ShareParametersExportSchema() map[string]schema.Attribute {
 			),
 			Validators: []validator.List{
 				listvalidator.SizeAtLeast(1),
-				listvalidator.ValueStringsAre(stringvalidator.OneOf([]string{
-					string("ALL"),
-					string("IDRAC"),
-					string("BIOS"),
-					string("NIC"),
-					string("RAID"),
-					string("FC"),
-					string("InfiniBand"),
-					string("SupportAssist"),
-					string("EventFilters"),
-					string("System"),
-					string("LifecycleController"),
-					string("AHCI"),
-					string("PCIeSSD"),
-				}...)),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(scpTargets...)),
 			},
 		},
 		"username": schema.StringAttribute{
@@ -460,11 +463,12 @@ func (r *ScpExportResource) Create(ctx context.Context, req resource.CreateReque
 	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
 	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error - config create", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
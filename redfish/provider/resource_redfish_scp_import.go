@@ -19,7 +19,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"terraform-provider-redfish/common"
@@ -34,9 +36,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -59,6 +63,7 @@ const (
 	maxScpImportTimeout     int64 = 3600
 	defaultSCPPort          int64 = 80
 	defaultIntBase          int   = 10
+	scpImportFailureStatus        = "Failure"
 )
 
 // NewScpImportResource is a helper function to simplify the provider implementation.
@@ -213,6 +218,60 @@ func RedfishScpImportSchema() map[string]schema.Attribute {
 				objectplanmodifier.RequiresReplace(),
 			},
 		},
+		"preview": schema.BoolAttribute{
+			MarkdownDescription: "Preview the import instead of applying it. When set to `true` the profile is validated with " +
+				"`ImportSystemConfigurationPreview`, the server is not rebooted and no attribute is changed. " +
+				"The outcome of each attribute is reported in `results`.",
+			Description: "Preview the import instead of applying it. When set to true the profile is validated with " +
+				"ImportSystemConfigurationPreview, the server is not rebooted and no attribute is changed. " +
+				"The outcome of each attribute is reported in results.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				// state written before preview existed holds null and must not force a re-import
+				boolplanmodifier.RequiresReplaceIf(func(_ context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = !req.StateValue.IsNull()
+				}, "Changing preview requires replacement.", "Changing preview requires replacement."),
+			},
+		},
+		"results": schema.ListNestedAttribute{
+			MarkdownDescription: "Per-attribute results reported by the completed import or preview job.",
+			Description:         "Per-attribute results reported by the completed import or preview job.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Fully qualified name of the attribute.",
+						Description:         "Fully qualified name of the attribute.",
+						Computed:            true,
+					},
+					"old_value": schema.StringAttribute{
+						MarkdownDescription: "Value of the attribute before the import.",
+						Description:         "Value of the attribute before the import.",
+						Computed:            true,
+					},
+					"new_value": schema.StringAttribute{
+						MarkdownDescription: "Value of the attribute requested by the profile.",
+						Description:         "Value of the attribute requested by the profile.",
+						Computed:            true,
+					},
+					"status": schema.StringAttribute{
+						MarkdownDescription: "Status of the attribute, e.g. `Success` or `Failure`.",
+						Description:         "Status of the attribute, e.g. Success or Failure.",
+						Computed:            true,
+					},
+					"message": schema.StringAttribute{
+						MarkdownDescription: "Message reported for the attribute.",
+						Description:         "Message reported for the attribute.",
+						Computed:            true,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -496,19 +555,31 @@ func (r *ScpImportResource) Create(ctx context.Context, req resource.CreateReque
 	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
 	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
-	log, err := scpImportExecutor(ctx, service, plan)
+	results, log, err := scpImportExecutor(ctx, service, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(log, err.Error())
 		return
 	}
+	plan.Results, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: scpImportResultTypes()}, results)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, result := range results {
+		if result.Status.ValueString() == scpImportFailureStatus {
+			resp.Diagnostics.AddWarning("Server Configuration Profile attribute was not applied",
+				fmt.Sprintf("%s: %s", result.Name.ValueString(), result.Message.ValueString()))
+		}
+	}
 
 	tflog.Trace(ctx, "resource_ScpImport create: updating state finished, saving ...")
 	// Save into State
@@ -519,11 +590,18 @@ func (r *ScpImportResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
-func (*ScpImportResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Error updating Import Server Configuration Profile.",
-		"An update plan of Import Server Configuration Profile should never be invoked. This resource is supposed to be replaced on update.",
-	)
+// Every configurable attribute forces replacement, so the only in-place update is
+// filling in attributes that were added after the state was written.
+func (*ScpImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.RedfishScpImport
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Results.IsUnknown() {
+		plan.Results = types.ListNull(types.ObjectType{AttrTypes: scpImportResultTypes()})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -537,40 +615,130 @@ func (*ScpImportResource) Delete(ctx context.Context, _ resource.DeleteRequest,
 }
 
 // scpImportExecutor is a function that imports a server configuration profile (SCP) into a Redfish service.
+// When preview is set the profile is only validated with ImportSystemConfigurationPreview.
 //
 // Parameters:
 // - service: a pointer to a gofish.Service object representing the Redfish service.
-// - scpImportPayload: a models.SCPImport object containing the payload for the SCP import.
-// - jobCheckIntervalTime: an int64 representing the interval time for checking the job status.
-// - jobDefaultTimeout: an int64 representing the default timeout for the job.
+// - plan: the planned state of the resource.
 //
 // Returns:
+// - []models.ScpImportResult: the per-attribute results of the completed job.
 // - string: a message indicating the result of the SCP import.
 // - error: an error object if there was an error during the import process.
-func scpImportExecutor(ctx context.Context, service *gofish.Service, plan models.RedfishScpImport) (string, error) {
+func scpImportExecutor(ctx context.Context, service *gofish.Service, plan models.RedfishScpImport) ([]models.ScpImportResult, string, error) {
 	managers, err := service.Managers()
 	if err != nil {
-		return "error while retrieving managers", err
+		return nil, "error while retrieving managers", err
+	}
+	if len(managers) == 0 {
+		return nil, "error while retrieving managers", fmt.Errorf("no managers were found on the server")
 	}
 	dellManager, err := dell.Manager(managers[0])
 	if err != nil {
-		return "error while retrieving dell manager", err
+		return nil, "error while retrieving dell manager", err
 	}
 	time.Sleep(60 * time.Second)
+
 	importURL := dellManager.Actions.ImportSystemConfigurationTarget
-	response, err := service.GetClient().Post(importURL, constructPayload(ctx, plan, dellManager.FirmwareVersion))
+	var payload interface{} = constructPayload(ctx, plan, dellManager.FirmwareVersion)
+	if plan.Preview.ValueBool() {
+		if dellManager.Actions.ImportSystemConfigurationPreviewTarget == "" {
+			return nil, "error during import preview", fmt.Errorf("the manager does not support ImportSystemConfigurationPreview")
+		}
+		importURL = dellManager.Actions.ImportSystemConfigurationPreviewTarget
+		scpImport := payload.(models.SCPImport)
+		payload = models.SCPImportPreview{
+			ImportBuffer:    scpImport.ImportBuffer,
+			ShareParameters: scpImport.ShareParameters,
+		}
+	}
+	response, err := service.GetClient().Post(importURL, payload)
 	if err != nil {
-		return "error during import", err
+		return nil, "error during import", err
 	}
+	defer response.Body.Close()
 
-	if location, err := response.Location(); err == nil {
-		taskURI := location.EscapedPath()
-		err = common.WaitForDellJobToFinish(service, taskURI, intervalJobCheckTime, defaultJobTimeout)
-		if err != nil {
-			return "error waiting for SCP Export monitor task to be completed", err
+	location, err := response.Location()
+	if err != nil {
+		return nil, "The server configuration profile was successfully imported", nil
+	}
+	taskURI := strings.Replace(location.EscapedPath(), "TaskMonitors", "Tasks", 1)
+	err = common.WaitForTaskToFinishWithContext(ctx, service, taskURI, intervalJobCheckTime, defaultJobTimeout)
+	if err != nil {
+		return nil, "error waiting for SCP Import monitor task to be completed", err
+	}
+
+	taskResponse, err := service.GetClient().Get(taskURI)
+	if err != nil {
+		return nil, "error while retrieving SCP Import task", err
+	}
+	defer taskResponse.Body.Close()
+	body, err := io.ReadAll(taskResponse.Body)
+	if err != nil {
+		return nil, "error while reading SCP Import task", err
+	}
+	results, err := scpImportResults(body)
+	if err != nil {
+		return nil, "error while reading SCP Import task", err
+	}
+	return results, "The server configuration profile was successfully imported", nil
+}
+
+// scpImportTask is the part of a completed SCP import task that carries the job outcome.
+type scpImportTask struct {
+	Messages []struct {
+		Message string `json:"Message"`
+		Oem     struct {
+			Dell struct {
+				Name     string `json:"Name"`
+				OldValue string `json:"OldValue"`
+				NewValue string `json:"NewValue"`
+				Status   string `json:"Status"`
+			} `json:"Dell"`
+		} `json:"Oem"`
+	} `json:"Messages"`
+	Oem struct {
+		Dell common.OEMJob `json:"Dell"`
+	} `json:"Oem"`
+}
+
+// scpImportResults extracts the per-attribute results from a completed SCP import task.
+// It returns an error when the job itself failed.
+func scpImportResults(body []byte) ([]models.ScpImportResult, error) {
+	var task scpImportTask
+	if err := json.Unmarshal(body, &task); err != nil {
+		return nil, err
+	}
+	if task.Oem.Dell.JobState == "Failed" {
+		return nil, fmt.Errorf("job failed with message: %s", task.Oem.Dell.Message)
+	}
+
+	results := make([]models.ScpImportResult, 0, len(task.Messages))
+	for _, message := range task.Messages {
+		dellMessage := message.Oem.Dell
+		if dellMessage.Name == "" {
+			continue
 		}
+		results = append(results, models.ScpImportResult{
+			Name:     types.StringValue(dellMessage.Name),
+			OldValue: types.StringValue(dellMessage.OldValue),
+			NewValue: types.StringValue(dellMessage.NewValue),
+			Status:   types.StringValue(dellMessage.Status),
+			Message:  types.StringValue(message.Message),
+		})
+	}
+	return results, nil
+}
+
+// scpImportResultTypes returns the attribute types of an SCP import result.
+func scpImportResultTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":      types.StringType,
+		"old_value": types.StringType,
+		"new_value": types.StringType,
+		"status":    types.StringType,
+		"message":   types.StringType,
 	}
-	return "The server configuration profile was successfully imported", nil
 }
 
 // constructPayload constructs a SCPImport payload from a RedfishScpImport plan.
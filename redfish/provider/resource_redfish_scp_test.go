@@ -174,3 +174,55 @@ func TestAccRedfishSCPInvalid(t *testing.T) {
 		},
 	})
 }
+
+func TestAccRedfishSCPImportPreview(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				%s
+				%s
+				`,
+					createSCPConfig("export", "config_1", getSP("LOCAL", nil), ""),
+					createSCPConfig("import", "preview", getSP("LOCAL", nil), importBuffer+"\npreview = true\n"+dependsOnExport("config_1"))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_idrac_server_configuration_profile_import.preview", "preview", "true"),
+					resource.TestCheckResourceAttrSet("redfish_idrac_server_configuration_profile_import.preview", "results.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestScpImportResults(t *testing.T) {
+	body := []byte(`{
+		"TaskState": "Completed",
+		"Messages": [
+			{"Message": "Successfully imported and applied Server Configuration Profile.", "MessageId": "SYS053"},
+			{"Message": "The attribute was applied.", "Oem": {"Dell": {"Name": "EventFilters.1#Alert.1", "OldValue": "Disabled", "NewValue": "Enabled", "Status": "Success"}}},
+			{"Message": "The attribute is read-only.", "Oem": {"Dell": {"Name": "iDRAC.1#Info.1#Version", "OldValue": "1", "NewValue": "2", "Status": "Failure"}}}
+		],
+		"Oem": {"Dell": {"JobState": "Completed", "Message": "Successfully imported and applied Server Configuration Profile."}}
+	}`)
+
+	results, err := scpImportResults(body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if results[0].Name.ValueString() != "EventFilters.1#Alert.1" || results[0].NewValue.ValueString() != "Enabled" {
+		t.Errorf("unexpected first result: %+v", results[0])
+	}
+	if results[1].Status.ValueString() != scpImportFailureStatus || results[1].Message.ValueString() != "The attribute is read-only." {
+		t.Errorf("unexpected second result: %+v", results[1])
+	}
+
+	_, err = scpImportResults([]byte(`{"Oem": {"Dell": {"JobState": "Failed", "Message": "Unable to import"}}}`))
+	if err == nil || !strings.Contains(err.Error(), "Unable to import") {
+		t.Errorf("expected failed job error, got %v", err)
+	}
+}
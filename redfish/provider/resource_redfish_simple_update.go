@@ -38,6 +38,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -153,6 +154,26 @@ func simpleUpdateSchema() map[string]schema.Attribute {
 			Default:     int64default.StaticInt64(int64(defaultSimpleUpdateResetTimeout)),
 			Description: "Time in seconds that the provider waits for the server to be reset before timing out.",
 		},
+		"apply_time": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(string(redfishcommon.ImmediateApplyTime)),
+			MarkdownDescription: "When the firmware is installed. Accepted values: `Immediate`, `OnReset`." +
+				" Immediate: the server is reset with `reset_type` and the provider waits for the update job to complete." +
+				" OnReset: the update job is only scheduled and is installed on the next reset of the server.",
+			Description: "When the firmware is installed. Accepted values: Immediate, OnReset." +
+				" Immediate: the server is reset with reset_type and the provider waits for the update job to complete." +
+				" OnReset: the update job is only scheduled and is installed on the next reset of the server.",
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					string(redfishcommon.ImmediateApplyTime),
+					string(redfishcommon.OnResetApplyTime),
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
 		"simple_update_job_timeout": schema.Int64Attribute{
 			Optional:    true,
 			Computed:    true,
@@ -168,7 +189,7 @@ func simpleUpdateSchema() map[string]schema.Attribute {
 		},
 		"version": schema.StringAttribute{
 			Computed:    true,
-			Description: "Software version from the firmware package uploaded",
+			Description: "Software version from the firmware package uploaded. Refreshed from the firmware inventory on read.",
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
@@ -228,11 +249,12 @@ func (r *simpleUpdateResource) Create(ctx context.Context, req resource.CreateRe
 	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
 	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 	system, err := getSystemResource(service, plan.SystemID.ValueString())
@@ -267,11 +289,12 @@ func (r *simpleUpdateResource) Read(ctx context.Context, req resource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 	dia, newState := readRedfishSimpleUpdate(service, state)
@@ -303,7 +326,7 @@ func readRedfishSimpleUpdate(service *gofish.Service, d models.SimpleUpdateRes)
 	var diags diag.Diagnostics
 
 	// Try to get software inventory
-	_, err := redfish.GetSoftwareInventory(service.GetClient(), d.Id.ValueString())
+	swInventory, err := redfish.GetSoftwareInventory(service.GetClient(), d.Id.ValueString())
 	if err != nil {
 		var redfishErr *redfishcommon.Error
 		if !errors.As(err, &redfishErr) {
@@ -312,6 +335,15 @@ func readRedfishSimpleUpdate(service *gofish.Service, d models.SimpleUpdateRes)
 			// the firmware package previously applied has changed, trigger update
 			d.Image = types.StringNull()
 		}
+		return diags, d
+	}
+
+	// A job scheduled with apply_time OnReset is tracked by its task until the server is reset,
+	// so only report the installed version once the inventory entry is available
+	if swInventory.SoftwareID != "" {
+		d.Id = types.StringValue(swInventory.ODataID)
+		d.SoftwareId = types.StringValue(swInventory.SoftwareID)
+		d.Version = types.StringValue(swInventory.Version)
 	}
 
 	return diags, d
@@ -501,6 +533,11 @@ func (u *simpleUpdater) uploadLocalFirmware(d models.SimpleUpdateRes) (*redfish.
 	if err != nil {
 		return nil, fmt.Errorf("error running job %w", err)
 	}
+	if d.ApplyTime.ValueString() == string(redfishcommon.OnResetApplyTime) {
+		// The package stays available in the inventory until the server is reset
+		tflog.Info(u.ctx, "resource_simple_update : Job is scheduled to run on next reset")
+		return packageInformation, nil
+	}
 	tflog.Debug(u.ctx, "resource_simple_update : Job finished successfully")
 	// Get updated FW inventory
 	// sleep time to allow the inventory service to get started
@@ -557,6 +594,12 @@ func (u *simpleUpdater) uploadLocalFirmwareSeventeenGeneration(d models.SimpleUp
 	if err != nil {
 		return d, fmt.Errorf("there was an issue when waiting for the job to complete - %w", err)
 	}
+	if d.ApplyTime.ValueString() == string(redfishcommon.OnResetApplyTime) {
+		tflog.Info(u.ctx, "resource_simple_update : Job is scheduled to run on next reset")
+		d.SoftwareId = types.StringNull()
+		d.Version = types.StringNull()
+		return d, nil
+	}
 
 	job, err := redfish.GetTask(service.GetClient(), jobID)
 	if len(job.Messages) > 0 {
@@ -659,6 +702,12 @@ func (u *simpleUpdater) pullUpdate(d models.SimpleUpdateRes) (models.SimpleUpdat
 		// Delete uploaded package - TBD
 		return d, fmt.Errorf("there was an issue when waiting for the job to complete - %w", err)
 	}
+	if d.ApplyTime.ValueString() == string(redfishcommon.OnResetApplyTime) {
+		tflog.Info(u.ctx, "resource_simple_update : Job is scheduled to run on next reset")
+		d.SoftwareId = types.StringNull()
+		d.Version = types.StringNull()
+		return d, nil
+	}
 
 	job, err := redfish.GetTask(service.GetClient(), jobID)
 	if len(job.Messages) > 0 {
@@ -695,6 +744,10 @@ func (u *simpleUpdater) updateJobStatus(d models.SimpleUpdateRes) error {
 		resetTimeout,
 		simpleUpdateJobTimeout))
 
+	if d.ApplyTime.ValueString() == string(redfishcommon.OnResetApplyTime) {
+		return checkJobScheduled(u.service, jobID)
+	}
+
 	// Reboot the server
 	tflog.Debug(u.ctx, "Rebooting the server")
 	pOp := powerOperator{u.ctx, u.service, d.SystemID.ValueString()}
@@ -715,3 +768,16 @@ func (u *simpleUpdater) updateJobStatus(d models.SimpleUpdateRes) error {
 
 	return nil
 }
+
+// checkJobScheduled makes sure a job left to run on the next reset has not already failed
+func checkJobScheduled(service *gofish.Service, jobID string) error {
+	job, err := redfish.GetTask(service.GetClient(), strings.Replace(jobID, "TaskMonitors", "Tasks", 1))
+	if err != nil {
+		return fmt.Errorf("there was an issue when retrieving the scheduled job - %w", err)
+	}
+	switch job.TaskState {
+	case redfish.KilledTaskState, redfish.ExceptionTaskState, redfish.CancelledTaskState:
+		return fmt.Errorf(common.JobErrorWithState, job.TaskState)
+	}
+	return nil
+}
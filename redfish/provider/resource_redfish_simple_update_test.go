@@ -94,6 +94,42 @@ func TestAccRedfishSimpleUpdate_InvalidProto(t *testing.T) {
 	})
 }
 
+// Test to schedule Simple update on next reset - Positive
+func TestAccRedfishSimpleUpdate_OnReset(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceUpdateApplyTimeConfig(
+					creds,
+					os.Getenv("TF_TESTING_FIRMWARE_IMAGE_HTTP"),
+					"OnReset"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_simple_update.update", "apply_time", "OnReset"),
+				),
+			},
+		},
+	})
+}
+
+// Test to update with invalid apply time - Negative
+func TestAccRedfishSimpleUpdate_InvalidApplyTime(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceUpdateApplyTimeConfig(
+					creds,
+					os.Getenv("TF_TESTING_FIRMWARE_IMAGE_HTTP"),
+					"AtMaintenanceWindowStart"),
+				ExpectError: regexp.MustCompile("Attribute apply_time value must be one of"),
+			},
+		},
+	})
+}
+
 func testAccRedfishResourceUpdateConfig(testingInfo TestingServerCredentials,
 	transferProtocol string,
 	imagePath string,
@@ -121,3 +157,30 @@ func testAccRedfishResourceUpdateConfig(testingInfo TestingServerCredentials,
 		imagePath,
 	)
 }
+
+func testAccRedfishResourceUpdateApplyTimeConfig(testingInfo TestingServerCredentials,
+	imagePath string,
+	applyTime string,
+) string {
+	return fmt.Sprintf(`
+		resource "redfish_simple_update" "update" {
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  system_id = "System.Embedded.1"
+		  transfer_protocol     = "HTTP"
+		  target_firmware_image = "%s"
+		  reset_type  = "ForceRestart"
+		  apply_time  = "%s"
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		imagePath,
+		applyTime,
+	)
+}
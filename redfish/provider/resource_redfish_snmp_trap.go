@@ -0,0 +1,546 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	snmpTrapFormatAttribute = "SNMP.1.TrapFormat"
+	snmpAlertPortAttribute  = "SNMP.1.AlertPort"
+	snmpCommunityAttribute  = "SNMP.1.AgentCommunity"
+	snmpTrapFormatV3        = "SNMPv3"
+)
+
+// snmpAlertDestinationRegex matches the destination attributes of the SNMP alert slots, e.g. SNMPAlert.1.Destination
+var snmpAlertDestinationRegex = regexp.MustCompile(`^SNMPAlert\.(\d+)\.Destination$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &snmpTrapResource{}
+	_ resource.ResourceWithImportState    = &snmpTrapResource{}
+	_ resource.ResourceWithValidateConfig = &snmpTrapResource{}
+)
+
+// NewSNMPTrapResource is a helper function to simplify the provider implementation.
+func NewSNMPTrapResource() resource.Resource {
+	return &snmpTrapResource{}
+}
+
+// snmpTrapResource is the resource implementation.
+type snmpTrapResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *snmpTrapResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_snmp_trap configured")
+}
+
+// Metadata returns the resource type name.
+func (*snmpTrapResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "snmp_trap"
+}
+
+// Schema defines the schema for the resource.
+func (*snmpTrapResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to manage an SNMP alert destination of the iDRAC, through its" +
+			" `SNMPAlert` and `SNMP` attributes. Every destination holds one of the fixed destination slots of the iDRAC.",
+		Description: "This Terraform resource is used to manage an SNMP alert destination of the iDRAC, through its" +
+			" SNMPAlert and SNMP attributes. Every destination holds one of the fixed destination slots of the iDRAC.",
+		Attributes: SNMPTrapSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// SNMPTrapSchema to define the SNMP trap schema
+func SNMPTrapSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the SNMP trap resource, e.g. `SNMPAlert.1`.",
+			Description:         "ID of the SNMP trap resource, e.g. SNMPAlert.1.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"slot": schema.Int64Attribute{
+			MarkdownDescription: "Destination slot of the iDRAC, i.e. the index of its `SNMPAlert.<slot>` attributes, commonly" +
+				" `1` to `8`. When unset, the first free slot is used.",
+			Description: "Destination slot of the iDRAC, i.e. the index of its SNMPAlert.<slot> attributes, commonly" +
+				" 1 to 8. When unset, the first free slot is used.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+				int64planmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"destination": schema.StringAttribute{
+			MarkdownDescription: "IP address or host name receiving the SNMP traps.",
+			Description:         "IP address or host name receiving the SNMP traps.",
+			Required:            true,
+			Validators: []validator.String{
+				hostnameOrIPValidator{},
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the traps are sent to the destination. Default is `true`.",
+			Description:         "Whether the traps are sent to the destination. Default is true.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"snmp_v3_user": schema.StringAttribute{
+			MarkdownDescription: "iDRAC user the SNMPv3 traps are sent as. The user must be enabled for SNMPv3." +
+				" Only used when `protocol_version` is `SNMPv3`.",
+			Description: "iDRAC user the SNMPv3 traps are sent as. The user must be enabled for SNMPv3." +
+				" Only used when protocol_version is SNMPv3.",
+			Optional: true,
+			Computed: true,
+		},
+		"protocol_version": schema.StringAttribute{
+			MarkdownDescription: "SNMP version of the traps. Accepted values: `SNMPv1`, `SNMPv2`, `SNMPv3`." +
+				" It is the `SNMP.1.TrapFormat` attribute, shared by all the destinations of the iDRAC.",
+			Description: "SNMP version of the traps. Accepted values: SNMPv1, SNMPv2, SNMPv3." +
+				" It is the SNMP.1.TrapFormat attribute, shared by all the destinations of the iDRAC.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("SNMPv1", "SNMPv2", snmpTrapFormatV3),
+			},
+		},
+		"port": schema.Int64Attribute{
+			MarkdownDescription: "UDP port the traps are sent to." +
+				" It is the `SNMP.1.AlertPort` attribute, shared by all the destinations of the iDRAC.",
+			Description: "UDP port the traps are sent to." +
+				" It is the SNMP.1.AlertPort attribute, shared by all the destinations of the iDRAC.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+		},
+		"community": schema.StringAttribute{
+			MarkdownDescription: "Community string of the SNMPv1 and SNMPv2 traps." +
+				" It is the `SNMP.1.AgentCommunity` attribute, shared by all the destinations of the iDRAC.",
+			Description: "Community string of the SNMPv1 and SNMPv2 traps." +
+				" It is the SNMP.1.AgentCommunity attribute, shared by all the destinations of the iDRAC.",
+			Optional:  true,
+			Computed:  true,
+			Sensitive: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that snmp_v3_user is only set for SNMPv3 traps, and is set for them.
+func (*snmpTrapResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var protocolVersion, snmpV3User types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("protocol_version"), &protocolVersion)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("snmp_v3_user"), &snmpV3User)...)
+	if resp.Diagnostics.HasError() || protocolVersion.IsNull() || protocolVersion.IsUnknown() || snmpV3User.IsUnknown() {
+		return
+	}
+	if protocolVersion.ValueString() == snmpTrapFormatV3 && snmpV3User.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("snmp_v3_user"), "Missing snmp_v3_user",
+			"snmp_v3_user must be set when protocol_version is SNMPv3")
+	}
+	if protocolVersion.ValueString() != snmpTrapFormatV3 && !snmpV3User.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("snmp_v3_user"), "Invalid snmp_v3_user",
+			fmt.Sprintf("snmp_v3_user can't be set when protocol_version is %s", protocolVersion.ValueString()))
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *snmpTrapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_snmp_trap create: started")
+	var plan models.SNMPTrap
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applySNMPTrap(ctx, &plan, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_snmp_trap create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *snmpTrapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_snmp_trap read: started")
+	var state models.SNMPTrap
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	found, diags := readSNMPTrap(api.Service, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		tflog.Info(ctx, fmt.Sprintf("SNMP alert destination %s was cleared, removing the resource from the state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_snmp_trap read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *snmpTrapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_snmp_trap update: started")
+	var plan models.SNMPTrap
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applySNMPTrap(ctx, &plan, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_snmp_trap update: finished")
+}
+
+// Delete clears the destination slot and removes the Terraform state on success.
+// The settings shared by all the destinations are left as is.
+func (r *snmpTrapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_snmp_trap delete: started")
+	var state models.SNMPTrap
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	redfishMutexKV.Lock(state.RedfishServer[0].Endpoint.ValueString())
+	defer redfishMutexKV.Unlock(state.RedfishServer[0].Endpoint.ValueString())
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	prefix := snmpAlertPrefix(state.Slot.ValueInt64())
+	attributes := map[string]string{
+		prefix + "Destination": "",
+		prefix + "State":       "Disabled",
+	}
+	if state.SNMPv3User.ValueString() != "" {
+		attributes[prefix+"SNMPv3Username"] = ""
+	}
+	resp.Diagnostics.Append(patchSNMPTrapAttributes(ctx, api.Service, attributes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_snmp_trap delete: finished")
+}
+
+// ImportState import state for an existing SNMP alert destination
+func (r *snmpTrapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	type creds struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Endpoint     string `json:"endpoint"`
+		SslInsecure  bool   `json:"ssl_insecure"`
+		RedfishAlias string `json:"redfish_alias"`
+		Slot         int64  `json:"slot"`
+	}
+
+	var c creds
+	err := json.Unmarshal([]byte(req.ID), &c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
+	}
+	if c.Slot < 1 {
+		resp.Diagnostics.AddError("Error while importing the SNMP alert destination", "slot must be set to the destination slot to import")
+		return
+	}
+
+	server := models.RedfishServer{
+		User:         types.StringValue(c.Username),
+		Password:     types.StringValue(c.Password),
+		Endpoint:     types.StringValue(c.Endpoint),
+		SslInsecure:  types.BoolValue(c.SslInsecure),
+		RedfishAlias: types.StringValue(c.RedfishAlias),
+	}
+	state := models.SNMPTrap{
+		RedfishServer: []models.RedfishServer{server},
+		Slot:          types.Int64Value(c.Slot),
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	found, diags := readSNMPTrap(api.Service, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error while importing the SNMP alert destination",
+			fmt.Sprintf("SNMP alert slot %d has no destination", c.Slot))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// applySNMPTrap patches the planned destination into its slot and reads it back. On creation, the first free
+// slot is picked when none is configured, and a slot already holding another destination is rejected.
+func (r *snmpTrapResource) applySNMPTrap(ctx context.Context, plan *models.SNMPTrap, create bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	snmpError := "there was an issue when configuring the SNMP alert destination"
+
+	// Resources picking free slots on the same iDRAC must not race
+	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
+	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	diags.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	if create {
+		registry, err := getManagerAttributeRegistry(api.Service)
+		if err != nil {
+			diags.AddError(snmpError, err.Error())
+			return diags
+		}
+		idracAttributes, err := getDellIdracAttributes(api.Service)
+		if err != nil {
+			diags.AddError(snmpError, err.Error())
+			return diags
+		}
+		slot, err := snmpAlertSlot(snmpAlertSlots(registry), idracAttributes.Attributes, plan.Slot)
+		if err != nil {
+			diags.AddAttributeError(path.Root("slot"), snmpError, err.Error())
+			return diags
+		}
+		plan.Slot = types.Int64Value(slot)
+	}
+
+	diags.Append(patchSNMPTrapAttributes(ctx, api.Service, snmpTrapAttributes(plan))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	found, d := readSNMPTrap(api.Service, plan)
+	diags.Append(d...)
+	if !diags.HasError() && !found {
+		diags.AddError(snmpError, fmt.Sprintf("SNMP alert slot %d has no destination once configured", plan.Slot.ValueInt64()))
+	}
+	return diags
+}
+
+// patchSNMPTrapAttributes patches the iDRAC attributes through the attribute registry checks.
+func patchSNMPTrapAttributes(ctx context.Context, service *gofish.Service, attributes map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	attributesMap, d := types.MapValueFrom(ctx, types.StringType, attributes)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	_, d = patchRedfishDellIdracAttributes(ctx, service, &models.DellIdracAttributes{Attributes: attributesMap})
+	diags.Append(d...)
+	return diags
+}
+
+// snmpAlertPrefix returns the prefix of the attributes of an SNMP alert slot, e.g. SNMPAlert.1.
+func snmpAlertPrefix(slot int64) string {
+	return fmt.Sprintf("SNMPAlert.%d.", slot)
+}
+
+// snmpAlertSlots returns the sorted SNMP alert slots listed by the attribute registry.
+func snmpAlertSlots(registry *dell.ManagerAttributeRegistry) []int64 {
+	slots := []int64{}
+	for _, attribute := range registry.Attributes {
+		match := snmpAlertDestinationRegex.FindStringSubmatch(attribute.AttributeName)
+		if match == nil {
+			continue
+		}
+		slot, err := strconv.ParseInt(match[1], 10, 64)
+		if err == nil {
+			slots = append(slots, slot)
+		}
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+	return slots
+}
+
+// isFreeSNMPAlertDestination reports whether the destination of an SNMP alert slot is unset.
+func isFreeSNMPAlertDestination(destination string) bool {
+	return destination == "" || destination == "0.0.0.0" || destination == "::"
+}
+
+// snmpAlertSlot returns the configured slot, checking it exists and is free, or the first free slot
+// when none is configured.
+func snmpAlertSlot(slots []int64, attributes map[string]interface{}, configured types.Int64) (int64, error) {
+	if len(slots) == 0 {
+		return 0, fmt.Errorf("the iDRAC has no SNMP alert destinations")
+	}
+	destination := func(slot int64) string {
+		value, _ := attributes[snmpAlertPrefix(slot)+"Destination"].(string)
+		return value
+	}
+
+	if configured.IsNull() || configured.IsUnknown() {
+		for _, slot := range slots {
+			if isFreeSNMPAlertDestination(destination(slot)) {
+				return slot, nil
+			}
+		}
+		return 0, fmt.Errorf("all the %d SNMP alert slots of the iDRAC hold a destination", len(slots))
+	}
+
+	slot := configured.ValueInt64()
+	for _, s := range slots {
+		if s != slot {
+			continue
+		}
+		if current := destination(slot); !isFreeSNMPAlertDestination(current) {
+			return 0, fmt.Errorf("SNMP alert slot %d already holds destination %s, import it to manage it", slot, current)
+		}
+		return slot, nil
+	}
+	return 0, fmt.Errorf("SNMP alert slot %d doesn't exist, the iDRAC has %d slots", slot, len(slots))
+}
+
+// snmpTrapAttributes returns the iDRAC attributes to set for the planned destination.
+// Settings left to the iDRAC are unknown in the plan and are not returned.
+func snmpTrapAttributes(plan *models.SNMPTrap) map[string]string {
+	prefix := snmpAlertPrefix(plan.Slot.ValueInt64())
+	state := "Disabled"
+	if plan.Enabled.ValueBool() {
+		state = "Enabled"
+	}
+	attributes := map[string]string{
+		prefix + "Destination": plan.Destination.ValueString(),
+		prefix + "State":       state,
+	}
+	if !plan.SNMPv3User.IsNull() && !plan.SNMPv3User.IsUnknown() {
+		attributes[prefix+"SNMPv3Username"] = plan.SNMPv3User.ValueString()
+	}
+	if !plan.ProtocolVersion.IsNull() && !plan.ProtocolVersion.IsUnknown() {
+		attributes[snmpTrapFormatAttribute] = plan.ProtocolVersion.ValueString()
+	}
+	if !plan.Port.IsNull() && !plan.Port.IsUnknown() {
+		attributes[snmpAlertPortAttribute] = strconv.FormatInt(plan.Port.ValueInt64(), 10)
+	}
+	if !plan.Community.IsNull() && !plan.Community.IsUnknown() {
+		attributes[snmpCommunityAttribute] = plan.Community.ValueString()
+	}
+	return attributes
+}
+
+// readSNMPTrap sets the live settings of the destination slot into d. It reports false when the slot holds
+// no destination.
+func readSNMPTrap(service *gofish.Service, d *models.SNMPTrap) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	idracAttributes, err := getDellIdracAttributes(service)
+	if err != nil {
+		diags.AddError("there was an issue when reading the SNMP alert destination", err.Error())
+		return false, diags
+	}
+
+	stringAttribute := func(name string) string {
+		value, _ := idracAttributes.Attributes[name].(string)
+		return value
+	}
+	prefix := snmpAlertPrefix(d.Slot.ValueInt64())
+	destination := stringAttribute(prefix + "Destination")
+	if isFreeSNMPAlertDestination(destination) {
+		return false, diags
+	}
+
+	d.ID = types.StringValue(fmt.Sprintf("SNMPAlert.%d", d.Slot.ValueInt64()))
+	d.Destination = types.StringValue(destination)
+	d.Enabled = types.BoolValue(stringAttribute(prefix+"State") == "Enabled")
+	d.SNMPv3User = types.StringValue(stringAttribute(prefix + "SNMPv3Username"))
+	d.ProtocolVersion = types.StringValue(stringAttribute(snmpTrapFormatAttribute))
+	d.Community = types.StringValue(stringAttribute(snmpCommunityAttribute))
+	// JSON numbers are decoded as float64
+	d.Port = types.Int64Null()
+	if port, ok := idracAttributes.Attributes[snmpAlertPortAttribute].(float64); ok {
+		d.Port = types.Int64Value(int64(port))
+	}
+	return true, diags
+}
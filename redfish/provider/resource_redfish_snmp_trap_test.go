@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Test to create, update and import an SNMP alert destination
+func TestAccRedfishSNMPTrap_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceSNMPTrap(creds, `destination = "192.168.0.20"
+				protocol_version = "SNMPv2"
+				port = 162`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_snmp_trap.trap", "destination", "192.168.0.20"),
+					resource.TestCheckResourceAttr("redfish_snmp_trap.trap", "enabled", "true"),
+					resource.TestCheckResourceAttr("redfish_snmp_trap.trap", "protocol_version", "SNMPv2"),
+					resource.TestCheckResourceAttrSet("redfish_snmp_trap.trap", "slot"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceSNMPTrap(creds, `destination = "snmp.example.com"
+				enabled = false`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_snmp_trap.trap", "destination", "snmp.example.com"),
+					resource.TestCheckResourceAttr("redfish_snmp_trap.trap", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "redfish_snmp_trap.trap",
+				ImportState:       true,
+				ImportStateIdFunc: testAccSNMPTrapImportID,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_snmp_trap.trap", "destination", "snmp.example.com"),
+				),
+			},
+		},
+	})
+}
+
+// Test to send SNMPv3 traps without a user- Negative
+func TestAccRedfishSNMPTrap_MissingUser_Negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceSNMPTrap(creds, `destination = "192.168.0.20"
+				protocol_version = "SNMPv3"`),
+				ExpectError: regexp.MustCompile("Missing snmp_v3_user"),
+			},
+		},
+	})
+}
+
+func TestSNMPAlertSlots(t *testing.T) {
+	registry := &dell.ManagerAttributeRegistry{
+		Attributes: []dell.ManagerAttribute{
+			{AttributeName: "SNMPAlert.2.Destination"},
+			{AttributeName: "SNMPAlert.1.State"},
+			{AttributeName: "SNMPAlert.1.Destination"},
+			{AttributeName: "SNMP.1.AlertPort"},
+		},
+	}
+	slots := snmpAlertSlots(registry)
+	if len(slots) != 2 || slots[0] != 1 || slots[1] != 2 {
+		t.Errorf("snmpAlertSlots() = %v, expected [1 2]", slots)
+	}
+}
+
+func TestSNMPAlertSlot(t *testing.T) {
+	slots := []int64{1, 2, 3}
+	attributes := map[string]interface{}{
+		"SNMPAlert.1.Destination": "192.168.0.20",
+		"SNMPAlert.2.Destination": "",
+		"SNMPAlert.3.Destination": "0.0.0.0",
+	}
+	if slot, err := snmpAlertSlot(slots, attributes, types.Int64Null()); err != nil || slot != 2 {
+		t.Errorf("expected the first free slot 2, got %d, %v", slot, err)
+	}
+	if slot, err := snmpAlertSlot(slots, attributes, types.Int64Value(3)); err != nil || slot != 3 {
+		t.Errorf("expected the configured slot 3, got %d, %v", slot, err)
+	}
+	if _, err := snmpAlertSlot(slots, attributes, types.Int64Value(1)); err == nil {
+		t.Errorf("expected an error for a slot holding a destination")
+	}
+	if _, err := snmpAlertSlot(slots, attributes, types.Int64Value(9)); err == nil {
+		t.Errorf("expected an error for a slot the iDRAC doesn't have")
+	}
+	if _, err := snmpAlertSlot(slots[:1], attributes, types.Int64Unknown()); err == nil {
+		t.Errorf("expected an error when all the slots hold a destination")
+	}
+}
+
+func TestSNMPTrapAttributes(t *testing.T) {
+	plan := &models.SNMPTrap{
+		Slot:            types.Int64Value(2),
+		Destination:     types.StringValue("192.168.0.20"),
+		Enabled:         types.BoolValue(true),
+		SNMPv3User:      types.StringUnknown(),
+		ProtocolVersion: types.StringValue("SNMPv2"),
+		Port:            types.Int64Value(1162),
+		Community:       types.StringNull(),
+	}
+	want := map[string]string{
+		"SNMPAlert.2.Destination": "192.168.0.20",
+		"SNMPAlert.2.State":       "Enabled",
+		snmpTrapFormatAttribute:   "SNMPv2",
+		snmpAlertPortAttribute:    "1162",
+	}
+	got := snmpTrapAttributes(plan)
+	if len(got) != len(want) {
+		t.Fatalf("snmpTrapAttributes() = %v, expected %v", got, want)
+	}
+	for name, value := range want {
+		if got[name] != value {
+			t.Errorf("snmpTrapAttributes()[%s] = %q, expected %q", name, got[name], value)
+		}
+	}
+}
+
+func testAccSNMPTrapImportID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["redfish_snmp_trap.trap"]
+	if !ok {
+		return "", fmt.Errorf("redfish_snmp_trap.trap not found in state")
+	}
+	return fmt.Sprintf(`{"username":"%s","password":"%s","endpoint":"%s","ssl_insecure":true,"slot":%s}`,
+		creds.Username, creds.Password, creds.Endpoint, rs.Primary.Attributes["slot"]), nil
+}
+
+func testAccRedfishResourceSNMPTrap(testingInfo TestingServerCredentials, trap string) string {
+	return fmt.Sprintf(`
+	resource "redfish_snmp_trap" "trap" {
+		redfish_server {
+			user         = "%s"
+			password     = "%s"
+			endpoint     = "%s"
+			ssl_insecure = true
+		}
+
+		%s
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		trap,
+	)
+}
@@ -87,11 +87,12 @@ func (r *RedfishStorageControllerResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -127,11 +128,12 @@ func (r *RedfishStorageControllerResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -166,11 +168,12 @@ func (r *RedfishStorageControllerResource) Update(ctx context.Context, req resou
 		return
 	}
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("service error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -329,9 +332,8 @@ func updateRedfishStorageController(ctx context.Context, service *gofish.Service
 		jobWait = false
 	}
 
-	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+	// Lock the server and the controller, so that the volume operations on the controller wait for its changes
+	defer lockStorageController(plan.RedfishServer[0].Endpoint.ValueString(), plan.StorageID.ValueString(), true)()
 
 	// OnReset case
 	if applyTime == string(redfishcommon.OnResetApplyTime) {
@@ -348,7 +350,7 @@ func updateRedfishStorageController(ctx context.Context, service *gofish.Service
 	isAnyOtherStorageControllerAttributeChanged := storageControllerAttributesChanged(ctx, plan, state, false)
 	isAnySecurityAttributeChanged := securityAttributesChanged(ctx, plan, state)
 
-	var jobURL string
+	var jobURL, controllerModeVal string
 	if isControllerModeAttributeChanged {
 		if isGenerationSeventeenAndAbove {
 			diags.AddError("In 17G and above, controller mode is a read-only property that depends upon the controller personality and hence cannot be updated.",
@@ -362,7 +364,7 @@ func updateRedfishStorageController(ctx context.Context, service *gofish.Service
 			return diags
 		}
 
-		_, controllerModeVal := getStorageControllerAttributeInfo(ctx, plan, "ControllerMode")
+		_, controllerModeVal = getStorageControllerAttributeInfo(ctx, plan, "ControllerMode")
 		isEnhancedAutoImportForeignConfigurationModeUnknown, enhancedAutoImportForeignConfigurationModeVal := getStorageControllerAttributeInfo(ctx, plan, "EnhancedAutoImportForeignConfigurationMode")
 
 		if (controllerModeVal == "HBA") && !isEnhancedAutoImportForeignConfigurationModeUnknown && (enhancedAutoImportForeignConfigurationModeVal == "Enabled") {
@@ -428,9 +430,12 @@ func updateRedfishStorageController(ctx context.Context, service *gofish.Service
 		}
 	}
 
-	if isControllerModeAttributeChanged {
+	if isControllerModeAttributeChanged && jobWait {
 		// controller mode changes take additional time to reflect.
-		time.Sleep(240 * time.Second)
+		if err := waitForControllerMode(ctx, service, plan, controllerModeVal); err != nil {
+			diags.AddError("Error while changing the controller mode", err.Error())
+			return diags
+		}
 	}
 
 	time.Sleep(60 * time.Second)
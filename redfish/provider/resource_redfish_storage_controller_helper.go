@@ -19,9 +19,12 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"terraform-provider-redfish/gofish/dell"
 	"terraform-provider-redfish/redfish/models"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -317,6 +320,10 @@ func dellStorageControllerAttributesChanged(ctx context.Context, plan, state *mo
 		planAttributes.ReconstructRatePercent.ValueInt64() != stateAttributes.ReconstructRatePercent.ValueInt64() {
 		return true
 	}
+	if !planAttributes.SpindownIdleTimeSeconds.IsNull() && !planAttributes.SpindownIdleTimeSeconds.IsUnknown() &&
+		planAttributes.SpindownIdleTimeSeconds.ValueInt64() != stateAttributes.SpindownIdleTimeSeconds.ValueInt64() {
+		return true
+	}
 
 	return false
 }
@@ -430,6 +437,13 @@ func updateStorageControllerAttributes(ctx context.Context, service *gofish.Serv
 		return "", diags
 	}
 
+	reported, err := reportedDellStorageControllerProperties(storageController)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("the Dell properties of controller %s could not be read, no attribute is dropped: %s",
+			storageController.ID, err.Error()))
+	}
+	diags.Append(dropUnsupportedControllerAttributes(storageController.ID, reported, patchBody)...)
+
 	url := storageController.ODataID + "/Settings"
 
 	resp, err := service.GetClient().Patch(url, patchBody)
@@ -531,6 +545,66 @@ func getDellPatchBody(ctx context.Context, plan, state *models.OEMAttributes) (m
 	return dellInfo, diags
 }
 
+// reportedDellStorageControllerProperties returns the raw properties of the DellStorageController OEM section
+// of the controller, keyed by property name.
+func reportedDellStorageControllerProperties(storageController *redfish.StorageController) (map[string]json.RawMessage, error) {
+	rawDataBytes, err := dell.GetRawDataBytes(storageController)
+	if err != nil {
+		return nil, err
+	}
+	node, err := dell.GetNodeFromRawDataBytes(rawDataBytes, "Oem.Dell.DellStorageController")
+	if err != nil {
+		return nil, err
+	}
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(node, &properties); err != nil {
+		return nil, err
+	}
+	return properties, nil
+}
+
+// isReportedProperty reports whether the controller exposes the property with a non null value.
+func isReportedProperty(properties map[string]json.RawMessage, name string) bool {
+	value, ok := properties[name]
+	return ok && string(value) != "null"
+}
+
+// dropUnsupportedControllerAttributes removes from the patch body the Dell attributes the controller does not expose,
+// i.e. LoadBalanceMode on controllers that are not multipath capable and SpindownIdleTimeSeconds on controllers
+// without power saving support. Support is decided from the properties the controller reports, when they could
+// be read, an empty or zero value is a valid setting of a supporting controller.
+func dropUnsupportedControllerAttributes(controllerID string, reported map[string]json.RawMessage, patchBody map[string]interface{}) (diags diag.Diagnostics) {
+	if reported == nil {
+		return diags
+	}
+	oem, ok := patchBody["Oem"].(map[string]interface{})
+	if !ok {
+		return diags
+	}
+	dellInfo, ok := oem["Dell"].(map[string]interface{})
+	if !ok {
+		return diags
+	}
+	dellStorageControllerInfo, ok := dellInfo["DellStorageController"].(map[string]interface{})
+	if !ok {
+		return diags
+	}
+
+	if loadBalanceMode, ok := dellStorageControllerInfo["LoadBalanceMode"]; ok && !isReportedProperty(reported, "LoadBalanceMode") {
+		delete(dellStorageControllerInfo, "LoadBalanceMode")
+		diags.AddWarning("`load_balance_mode` is not supported by this controller",
+			fmt.Sprintf("The controller %s does not report a load balance mode, so `load_balance_mode` = %v was not applied.",
+				controllerID, loadBalanceMode))
+	}
+	if spindownIdleTime, ok := dellStorageControllerInfo["SpindownIdleTimeSeconds"]; ok && !isReportedProperty(reported, "SpindownIdleTimeSeconds") {
+		delete(dellStorageControllerInfo, "SpindownIdleTimeSeconds")
+		diags.AddWarning("`spindown_idle_time_seconds` is not supported by this controller",
+			fmt.Sprintf("The controller %s does not report a spin down idle time, so `spindown_idle_time_seconds` = %v was not applied.",
+				controllerID, spindownIdleTime))
+	}
+	return diags
+}
+
 // nolint: gocyclo, gocognit, revive
 func getDellStorageControllerPatchBody(ctx context.Context, plan, state *models.DellAttributes) (map[string]interface{}, diag.Diagnostics) {
 	objectAsOptions := basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}
@@ -596,6 +670,12 @@ func getDellStorageControllerPatchBody(ctx context.Context, plan, state *models.
 		dellStorageControllerInfo["ReconstructRatePercent"] = planAttributes.ReconstructRatePercent.ValueInt64()
 	}
 
+	// power saving
+	if !planAttributes.SpindownIdleTimeSeconds.IsNull() && !planAttributes.SpindownIdleTimeSeconds.IsUnknown() &&
+		planAttributes.SpindownIdleTimeSeconds.ValueInt64() != stateAttributes.SpindownIdleTimeSeconds.ValueInt64() {
+		dellStorageControllerInfo["SpindownIdleTimeSeconds"] = planAttributes.SpindownIdleTimeSeconds.ValueInt64()
+	}
+
 	return dellStorageControllerInfo, diags
 }
 
@@ -816,3 +896,36 @@ func updateSecurityAttributes(ctx context.Context, service *gofish.Service, plan
 
 	return location.EscapedPath(), diags
 }
+
+// waitForControllerMode polls the controller until its current mode is the requested one. Errors are
+// retried until the timeout, as the controller may not be reachable right after the mode change.
+func waitForControllerMode(ctx context.Context, service *gofish.Service, plan *models.StorageControllerResource, mode string) error {
+	interval := time.Duration(intervalStorageControllerJobCheckTime) * time.Second
+	deadline := time.Now().Add(time.Duration(controllerModeTimeout) * time.Second)
+	currentMode := ""
+	for {
+		_, storageController, err := getStorageControllerInstance(service, plan.SystemID.ValueString(),
+			plan.StorageID.ValueString(), plan.ControllerID.ValueString())
+		if err == nil {
+			var storageControllerExtended *dell.StorageControllerExtended
+			storageControllerExtended, err = dell.StorageController(storageController)
+			if err == nil {
+				currentMode = storageControllerExtended.Oem.Dell.DellStorageController.CurrentControllerMode
+				if currentMode == mode {
+					return nil
+				}
+			}
+		}
+		if err != nil {
+			tflog.Trace(tflog.SetField(ctx, "error", err.Error()), "Storage controller unreachable")
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return fmt.Errorf("the controller mode is still %q instead of %q after %d seconds", currentMode, mode, controllerModeTimeout)
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
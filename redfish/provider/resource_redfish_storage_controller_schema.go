@@ -18,6 +18,7 @@ limitations under the License.
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -31,6 +32,8 @@ const (
 	defaultStorageControllerJobTimeout    int64 = 1200
 	defaultStorageControllerResetTimeout  int64 = 120
 	intervalStorageControllerJobCheckTime int64 = 10
+	// controllerModeTimeout is how long a controller mode change is given to take effect once its job completed
+	controllerModeTimeout int64 = 240
 )
 
 // StorageControllerResourceSchema defines the schema for the Storage Controller resource
@@ -146,9 +149,9 @@ func StorageControllerResourceSchema() map[string]schema.Attribute {
 		},
 		"system_id": schema.StringAttribute{
 			MarkdownDescription: "ID of the system resource. If the value for system ID is not provided, " +
-				"the resource picks the first system available from the iDRAC.",
+				"the resource picks the only system available from the iDRAC.",
 			Description: "ID of the system resource. If the value for system ID is not provided, " +
-				"the resource picks the first system available from the iDRAC.",
+				"the resource picks the only system available from the iDRAC.",
 			Computed:   true,
 			Optional:   true,
 			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
@@ -394,10 +397,12 @@ func DellStorageControllerResourceSchema() map[string]schema.Attribute {
 			)},
 		},
 		"load_balance_mode": schema.StringAttribute{
-			MarkdownDescription: "Load Balance Mode. Accepted values: `Automatic`, `Disabled`.",
-			Description:         "Load Balance Mode. Accepted values: `Automatic`, `Disabled`.",
-			Optional:            true,
-			Computed:            true,
+			MarkdownDescription: "Load Balance Mode. Accepted values: `Automatic`, `Disabled`. " +
+				"Only applicable to multipath capable controllers, on other controllers it is skipped with a warning.",
+			Description: "Load Balance Mode. Accepted values: `Automatic`, `Disabled`. " +
+				"Only applicable to multipath capable controllers, on other controllers it is skipped with a warning.",
+			Optional: true,
+			Computed: true,
 			Validators: []validator.String{stringvalidator.OneOf(
 				"Automatic",
 				"Disabled",
@@ -448,5 +453,18 @@ func DellStorageControllerResourceSchema() map[string]schema.Attribute {
 			Optional:            true,
 			Computed:            true,
 		},
+		"spindown_idle_time_seconds": schema.Int64Attribute{
+			MarkdownDescription: "Idle time in seconds after which unconfigured and hot spare drives are spun down to save power. " +
+				"This is a controller-wide setting that applies to all the drives of the controller. " +
+				"On controllers without power saving support it is skipped with a warning.",
+			Description: "Idle time in seconds after which unconfigured and hot spare drives are spun down to save power. " +
+				"This is a controller-wide setting that applies to all the drives of the controller. " +
+				"On controllers without power saving support it is skipped with a warning.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
 	}
 }
@@ -178,6 +178,7 @@ func getDellStorageControllerAttributesObjectValue(storageControllerExtended *de
 		"patrol_read_mode":                                types.StringValue(storageControllerExtended.Oem.Dell.DellStorageController.PatrolReadMode),
 		"background_initialization_rate_percent":          types.Int64Value(storageControllerExtended.Oem.Dell.DellStorageController.BackgroundInitializationRatePercent),
 		"reconstruct_rate_percent":                        types.Int64Value(storageControllerExtended.Oem.Dell.DellStorageController.ReconstructRatePercent),
+		"spindown_idle_time_seconds":                      types.Int64Value(storageControllerExtended.Oem.Dell.DellStorageController.SpindownIdleTimeSeconds),
 	}
 
 	if isPlan {
@@ -231,6 +232,9 @@ func getDellStorageControllerAttributesObjectValue(storageControllerExtended *de
 					if !oldDellStorageControllerAttributes.ReconstructRatePercent.IsNull() && !oldDellStorageControllerAttributes.ReconstructRatePercent.IsUnknown() {
 						dellStorageControllerAttributesItemMap["reconstruct_rate_percent"] = oldDellStorageControllerAttributes.ReconstructRatePercent
 					}
+					if !oldDellStorageControllerAttributes.SpindownIdleTimeSeconds.IsNull() && !oldDellStorageControllerAttributes.SpindownIdleTimeSeconds.IsUnknown() {
+						dellStorageControllerAttributesItemMap["spindown_idle_time_seconds"] = oldDellStorageControllerAttributes.SpindownIdleTimeSeconds
+					}
 				}
 			}
 		}
@@ -294,5 +298,6 @@ func getDellStorageControllerAttributesModelType() map[string]attr.Type {
 		"patrol_read_mode":                                types.StringType,
 		"background_initialization_rate_percent":          types.Int64Type,
 		"reconstruct_rate_percent":                        types.Int64Type,
+		"spindown_idle_time_seconds":                      types.Int64Type,
 	}
 }
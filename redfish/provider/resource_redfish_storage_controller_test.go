@@ -18,6 +18,7 @@ limitations under the License.
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"regexp"
@@ -723,6 +724,49 @@ func TestAccRedfishStorageControllerAttributes17GUpdateControllerMode(t *testing
 	})
 }
 
+func TestDropUnsupportedControllerAttributes(t *testing.T) {
+	tests := []struct {
+		name      string
+		attribute string
+		value     interface{}
+		reported  string
+		wantKept  bool
+	}{
+		{name: "multipath capable", attribute: "LoadBalanceMode", value: "Disabled", reported: `{"LoadBalanceMode": "Automatic"}`, wantKept: true},
+		{name: "multipath capable with an empty mode", attribute: "LoadBalanceMode", value: "Disabled", reported: `{"LoadBalanceMode": ""}`, wantKept: true},
+		{name: "not multipath capable", attribute: "LoadBalanceMode", value: "Disabled", reported: `{"ControllerMode": "RAID"}`, wantKept: false},
+		{name: "null load balance mode", attribute: "LoadBalanceMode", value: "Disabled", reported: `{"LoadBalanceMode": null}`, wantKept: false},
+		{name: "power saving with spin down disabled", attribute: "SpindownIdleTimeSeconds", value: int64(1800), reported: `{"SpindownIdleTimeSeconds": 0}`, wantKept: true},
+		{name: "power saving enabled", attribute: "SpindownIdleTimeSeconds", value: int64(1800), reported: `{"SpindownIdleTimeSeconds": 3600}`, wantKept: true},
+		{name: "no power saving support", attribute: "SpindownIdleTimeSeconds", value: int64(1800), reported: `{"ControllerMode": "RAID"}`, wantKept: false},
+		{name: "properties not read", attribute: "LoadBalanceMode", value: "Disabled", reported: "", wantKept: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reported map[string]json.RawMessage
+			if tt.reported != "" {
+				if err := json.Unmarshal([]byte(tt.reported), &reported); err != nil {
+					t.Fatal(err)
+				}
+			}
+			dellStorageController := map[string]interface{}{tt.attribute: tt.value}
+			patchBody := map[string]interface{}{
+				"Oem": map[string]interface{}{
+					"Dell": map[string]interface{}{"DellStorageController": dellStorageController},
+				},
+			}
+			diags := dropUnsupportedControllerAttributes("RAID.Integrated.1-1", reported, patchBody)
+			_, kept := dellStorageController[tt.attribute]
+			if kept != tt.wantKept {
+				t.Errorf("%s kept = %t, expected %t", tt.attribute, kept, tt.wantKept)
+			}
+			if (diags.WarningsCount() > 0) == tt.wantKept {
+				t.Errorf("got %d warnings, expected a warning only when the attribute is dropped", diags.WarningsCount())
+			}
+		})
+	}
+}
+
 func testAccRedfishResourceStorageControllerBasicConfig(testingInfo testingStorageControllerInputs) string {
 	return fmt.Sprintf(`
 	resource "redfish_storage_controller" "test" {
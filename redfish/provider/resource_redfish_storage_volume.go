@@ -24,10 +24,13 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"strings"
 	"terraform-provider-redfish/common"
+	"terraform-provider-redfish/gofish/dell"
 	"terraform-provider-redfish/redfish/models"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -37,7 +40,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -51,9 +58,14 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &RedfishStorageVolumeResource{}
+	_ resource.Resource                   = &RedfishStorageVolumeResource{}
+	_ resource.ResourceWithModifyPlan     = &RedfishStorageVolumeResource{}
+	_ resource.ResourceWithValidateConfig = &RedfishStorageVolumeResource{}
 )
 
+// volumeTypeMap maps the deprecated volume_type values to raid_type.
+// Redfish has no volume type for dual parity, RAID6 and RAID60 volumes are reported as
+// StripedWithParity and SpannedStripesWithParity like RAID5 and RAID50, so they can only be requested through raid_type.
 var volumeTypeMap = map[string]string{
 	string(redfish.NonRedundantVolumeType):             "RAID0",
 	string(redfish.MirroredVolumeType):                 "RAID1",
@@ -62,12 +74,48 @@ var volumeTypeMap = map[string]string{
 	string(redfish.SpannedStripesWithParityVolumeType): "RAID50",
 }
 
+// errNoStorageSubsystem is returned when the system does not report any storage, e.g. diskless or NVMe direct nodes.
+var errNoStorageSubsystem = errors.New("the system reports no RAID capable storage subsystem")
+
+// raidRedundantDriveCount is the number of drives worth of redundancy (mirror or parity) per span for each RAID level.
+// Redfish has no volume property to tune it, so it is fixed by the RAID level.
+var raidRedundantDriveCount = map[string]int64{
+	"RAID0":  0,
+	"RAID1":  1,
+	"RAID5":  1,
+	"RAID6":  2,
+	"RAID10": 1,
+	"RAID50": 1,
+	"RAID60": 2,
+}
+
 const (
-	defaultStorageVolumeResetTimeout  int64 = 120
-	defaultStorageVolumeJobTimeout    int64 = 1200
-	intervalStorageVolumeJobCheckTime int64 = 10
-	maxCapacityBytes                  int64 = 1000000000
-	maxVolumeNameLength               int   = 15
+	defaultStorageVolumeResetTimeout     int64 = 120
+	defaultStorageVolumeJobTimeout       int64 = 1200
+	defaultStorageVolumeJobCheckInterval int64 = 10
+	defaultStorageVolumePreRebootDelay   int64 = 30
+	defaultStorageVolumeLCReadyTimeout   int64 = 300
+	minStripSizeBytes                    int64 = 64 * 1024
+	maxStripSizeBytes                    int64 = 1024 * 1024
+	minCapacityBytes                           = minStripSizeBytes // a volume holds at least one strip
+	maxVolumeNameLength                  int   = 15
+	protectionInformationNone                  = "None"
+	initializationNone                         = "None"
+	initializationFast                         = "Fast"
+	initializationFull                         = "Full"
+	protectionInformationT10DIF                = "T10DIF"
+	t10PICapable                               = "Capable"
+	dellCachecadeVolume                        = "Cachecade"
+	noStorageSubsystemErrorMsg                 = "No storage subsystem found"
+	volumeJobInterruptedMsg                    = "Interrupted while waiting for the volume job, it will be resumed on the next refresh"
+	volumeJobTimeoutMsg                        = "Timed out waiting for the volume job, it will be resumed on the next refresh"
+	lifecycleControllerNotReadyMsg             = "Lifecycle Controller is not ready"
+	pendingControllerJobsMsg                   = "Error while checking the pending jobs of the controller"
+	missingControllerKeyMsg                    = "Error while checking the security key of the controller"
+	mixedDrivesMsg                             = "Drives of the volume are not homogeneous"
+	volumeInitializationMsg                    = "The volume is still initializing"
+	volumeJobScheduledMsg                      = "The volume job is scheduled in the maintenance window"
+	adoptionConflictMsg                        = "A volume of the same name conflicts with the configuration"
 )
 
 // NewRedfishStorageVolumeResource is a helper function to simplify the provider implementation.
@@ -97,19 +145,158 @@ func (*RedfishStorageVolumeResource) Metadata(_ context.Context, req resource.Me
 func VolumeSchema() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"capacity_bytes": schema.Int64Attribute{
-			MarkdownDescription: "Capacity Bytes",
-			Description:         "Capacity Bytes",
-			Optional:            true,
+			MarkdownDescription: "Capacity Bytes. Must be at least 65536 bytes, the smallest stripe size of the controllers," +
+				" and at most the usable capacity of the drives for the RAID level. When unset or `0`, no capacity is sent and the controller" +
+				" uses the full capacity of the drives, which is read back into the state.",
+			Description: "Capacity Bytes. Must be at least 65536 bytes, the smallest stripe size of the controllers," +
+				" and at most the usable capacity of the drives for the RAID level. When unset or 0, no capacity is sent and the controller" +
+				" uses the full capacity of the drives, which is read back into the state.",
+			Optional: true,
+			Computed: true,
 			Validators: []validator.Int64{
-				int64validator.AtLeast(maxCapacityBytes),
+				int64validator.Any(
+					int64validator.OneOf(0),
+					int64validator.AtLeast(minCapacityBytes),
+				),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
 			},
 		},
 		"encrypted": schema.BoolAttribute{
-			MarkdownDescription: "Encrypt the virtual disk, default is false. This flag is only supported on firmware levels 6 and above",
-			Description:         "Encrypt the virtual disk, default is false. This flag is only supported on firmware levels 6 and above",
-			Optional:            true,
+			MarkdownDescription: "Encrypt the virtual disk, default is false. This flag is only supported on firmware levels 6 and above." +
+				" The controller must have a security key, see the `redfish_controller_key` resource.",
+			Description: "Encrypt the virtual disk, default is false. This flag is only supported on firmware levels 6 and above." +
+				" The controller must have a security key, see the redfish_controller_key resource.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"is_cache": schema.BoolAttribute{
+			MarkdownDescription: "Create the volume as an SSD caching (CacheCade) volume of the controller, default is false." +
+				" All the drives must be SSDs. Only supported on Dell controllers. Changing this forces a new volume.",
+			Description: "Create the volume as an SSD caching (CacheCade) volume of the controller, default is false." +
+				" All the drives must be SSDs. Only supported on Dell controllers. Changing this forces a new volume.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"encryption_types": schema.ListAttribute{
+			MarkdownDescription: "Types of encryption of the volume, only sent when `encrypted` is set. Accepted values:" +
+				" `NativeDriveEncryption`, `ControllerAssisted`, `SoftwareAssisted`. The types must be supported by the controller." +
+				" Default is `[\"NativeDriveEncryption\"]`.",
+			Description: "Types of encryption of the volume, only sent when encrypted is set. Accepted values:" +
+				" NativeDriveEncryption, ControllerAssisted, SoftwareAssisted. The types must be supported by the controller." +
+				" Default is [\"NativeDriveEncryption\"].",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Default: listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(string(redfish.NativeDriveEncryptionEncryptionTypes)),
+			})),
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(
+					string(redfish.NativeDriveEncryptionEncryptionTypes),
+					string(redfish.ControllerAssistedEncryptionTypes),
+					string(redfish.SoftwareAssistedEncryptionTypes),
+				)),
+			},
+		},
+		"secure_erase_on_destroy": schema.BoolAttribute{
+			MarkdownDescription: "Secure erase the member drives of the volume after it is destroyed, default is false." +
+				" Every member drive must support secure erase. This operation is irreversible and all the data on the drives is lost." +
+				" When an erase fails, the drives are listed in `pending_erase_drive_ids` and the erase is retried on the next destroy.",
+			Description: "Secure erase the member drives of the volume after it is destroyed, default is false." +
+				" Every member drive must support secure erase. This operation is irreversible and all the data on the drives is lost." +
+				" When an erase fails, the drives are listed in pending_erase_drive_ids and the erase is retried on the next destroy.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"cryptographic_erase_on_destroy": schema.BoolAttribute{
+			MarkdownDescription: "Cryptographically erase the member drives of the volume after it is destroyed, default is false." +
+				" Every member drive must be a self-encrypting drive. This operation is irreversible and all the data on the drives is lost." +
+				" When an erase fails, the drives are listed in `pending_erase_drive_ids` and the erase is retried on the next destroy." +
+				" Conflicts with `secure_erase_on_destroy`.",
+			Description: "Cryptographically erase the member drives of the volume after it is destroyed, default is false." +
+				" Every member drive must be a self-encrypting drive. This operation is irreversible and all the data on the drives is lost." +
+				" When an erase fails, the drives are listed in pending_erase_drive_ids and the erase is retried on the next destroy." +
+				" Conflicts with secure_erase_on_destroy.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+			Validators: []validator.Bool{
+				boolvalidator.ConflictsWith(path.MatchRoot("secure_erase_on_destroy")),
+			},
+		},
+		"delete_volume_on_destroy": schema.BoolAttribute{
+			MarkdownDescription: "Delete the volume when the resource is destroyed, default is true. When false, destroying the" +
+				" resource only removes it from the state and the volume, along with its data, is left on the controller." +
+				" Conflicts with `secure_erase_on_destroy` and `cryptographic_erase_on_destroy`.",
+			Description: "Delete the volume when the resource is destroyed, default is true. When false, destroying the" +
+				" resource only removes it from the state and the volume, along with its data, is left on the controller." +
+				" Conflicts with secure_erase_on_destroy and cryptographic_erase_on_destroy.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(true),
+		},
+		"pending_erase_drive_ids": schema.ListAttribute{
+			MarkdownDescription: "`@odata.id` of the member drives that still hold the data of the destroyed volume because their" +
+				" secure or cryptographic erase failed. The volume is already deleted, the erase is retried on the next destroy.",
+			Description: "@odata.id of the member drives that still hold the data of the destroyed volume because their" +
+				" secure or cryptographic erase failed. The volume is already deleted, the erase is retried on the next destroy.",
+			ElementType: types.StringType,
+			Computed:    true,
+			Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+		},
+		"health": schema.StringAttribute{
+			MarkdownDescription: "Health of the volume, e.g. `OK`, `Warning` or `Critical`",
+			Description:         "Health of the volume, e.g. OK, Warning or Critical",
+			Computed:            true,
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: "Operational state of the volume, e.g. `Enabled`, `Updating` or `UnavailableOffline`",
+			Description:         "Operational state of the volume, e.g. Enabled, Updating or UnavailableOffline",
+			Computed:            true,
+		},
+		"operation_progress": schema.StringAttribute{
+			MarkdownDescription: "Operations running on the volume with their completion percentage, e.g. `Rebuilding: 45%`. Empty when none are running.",
+			Description:         "Operations running on the volume with their completion percentage, e.g. Rebuilding: 45%. Empty when none are running.",
+			Computed:            true,
+		},
+		"initialization_progress": schema.Int64Attribute{
+			MarkdownDescription: "Completion percentage of the initialization of the volume, e.g. the background initialization" +
+				" following its creation. It is 100 when the volume isn't initializing, and 0 while the job creating it is running.",
+			Description: "Completion percentage of the initialization of the volume, e.g. the background initialization" +
+				" following its creation. It is 100 when the volume isn't initializing, and 0 while the job creating it is running.",
+			Computed: true,
+		},
+		"wait_for_initialization": schema.BoolAttribute{
+			MarkdownDescription: "Wait for the initialization of the volume to complete before the create finishes, at most" +
+				" `volume_job_timeout` seconds. Default is false, the create finishes once the volume exists and" +
+				" `initialization_progress` tells when it is initialized.",
+			Description: "Wait for the initialization of the volume to complete before the create finishes, at most" +
+				" volume_job_timeout seconds. Default is false, the create finishes once the volume exists and" +
+				" initialization_progress tells when it is initialized.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"etag": schema.StringAttribute{
+			MarkdownDescription: "ETag of the volume when it was last read. It is sent as `If-Match` when the volume is updated," +
+				" so that changes made outside of Terraform are not overwritten.",
+			Description: "ETag of the volume when it was last read. It is sent as If-Match when the volume is updated," +
+				" so that changes made outside of Terraform are not overwritten.",
+			Computed: true,
+		},
+		"controller_cache_size_mb": schema.Int64Attribute{
+			MarkdownDescription: "Total cache memory size of the storage controller in MiB",
+			Description:         "Total cache memory size of the storage controller in MiB",
 			Computed:            true,
-			Default:             booldefault.StaticBool(false),
 		},
 		"disk_cache_policy": schema.StringAttribute{
 			MarkdownDescription: "Disk Cache Policy",
@@ -125,11 +312,15 @@ func VolumeSchema() map[string]schema.Attribute {
 			},
 		},
 		"raid_type": schema.StringAttribute{
-			MarkdownDescription: "Raid Type, Defaults to RAID0",
-			Description:         "Raid Type, Defaults to RAID0.",
-			Optional:            true,
-			Computed:            true,
-			Default:             stringdefault.StaticString("RAID0"),
+			MarkdownDescription: "Raid Type, Defaults to RAID0. Changing it on an existing volume migrates the RAID level online" +
+				" when the controller supports it: `RAID0` to `RAID1`, `RAID5` or `RAID6`, `RAID1` to `RAID0`, `RAID5`, `RAID6` or `RAID10`," +
+				" `RAID5` to `RAID0` or `RAID6`, and `RAID6` to `RAID0` or `RAID5`. Other changes force a new volume.",
+			Description: "Raid Type, Defaults to RAID0. Changing it on an existing volume migrates the RAID level online" +
+				" when the controller supports it: RAID0 to RAID1, RAID5 or RAID6, RAID1 to RAID0, RAID5, RAID6 or RAID10," +
+				" RAID5 to RAID0 or RAID6, and RAID6 to RAID0 or RAID5. Other changes force a new volume.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString("RAID0"),
 			Validators: []validator.String{
 				stringvalidator.OneOf([]string{
 					"RAID0",
@@ -141,14 +332,51 @@ func VolumeSchema() map[string]schema.Attribute {
 					"RAID60",
 				}...),
 			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIf(requiresRaidLevelReplace,
+					"Changing the RAID level forces a new volume unless it can be migrated online.",
+					"Changing the RAID level forces a new volume unless it can be migrated online.",
+				),
+			},
 		},
 		"drives": schema.ListAttribute{
-			MarkdownDescription: "Drives",
-			Description:         "Drives",
-			Required:            true,
-			ElementType:         types.StringType,
+			MarkdownDescription: "Names of the drives. At least one of `drives` or `drive_ids` must be set." +
+				" Adding drives to an existing volume triggers an online capacity expansion when the controller supports it," +
+				" which can take hours. Drives can't be removed from an existing volume.",
+			Description: "Names of the drives. At least one of drives or drive_ids must be set." +
+				" Adding drives to an existing volume triggers an online capacity expansion when the controller supports it," +
+				" which can take hours. Drives can't be removed from an existing volume.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.AtLeastOneOf(path.MatchRoot("drive_ids")),
+			},
+		},
+		"drive_ids": schema.ListAttribute{
+			MarkdownDescription: "IDs of the drives, either the `Id` or the `@odata.id` of the drives." +
+				" At least one of `drives` or `drive_ids` must be set.",
+			Description: "IDs of the drives, either the Id or the @odata.id of the drives." +
+				" At least one of drives or drive_ids must be set.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+		"dedicated_hot_spares": schema.ListAttribute{
+			MarkdownDescription: "Names or IDs of the drives to assign as dedicated hot spares of the volume once it is created." +
+				" The spares can't be members of the volume and must be at least as large as its drives. Changing this forces a new volume.",
+			Description: "Names or IDs of the drives to assign as dedicated hot spares of the volume once it is created." +
+				" The spares can't be members of the volume and must be at least as large as its drives. Changing this forces a new volume.",
+			Optional:    true,
+			ElementType: types.StringType,
 			Validators: []validator.List{
 				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
 			},
 		},
 		"id": schema.StringAttribute{
@@ -156,10 +384,27 @@ func VolumeSchema() map[string]schema.Attribute {
 			Description:         "ID of the storage volume resource",
 			Computed:            true,
 		},
+		"job_id": schema.StringAttribute{
+			MarkdownDescription: "URI of the job that created the volume. If an apply is interrupted while waiting for the job," +
+				" the job keeps running on the controller and the next refresh attaches to it instead of submitting a new one.",
+			Description: "URI of the job that created the volume. If an apply is interrupted while waiting for the job," +
+				" the job keeps running on the controller and the next refresh attaches to it instead of submitting a new one.",
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
 		"optimum_io_size_bytes": schema.Int64Attribute{
-			MarkdownDescription: "Optimum Io Size Bytes",
-			Description:         "Optimum Io Size Bytes",
-			Optional:            true,
+			MarkdownDescription: "Optimum IO size of the volume in bytes, i.e. its strip size. Must be a power of two within the" +
+				" strip sizes supported by the controller, commonly `65536` (64KB), `131072` (128KB), `262144` (256KB)," +
+				" `524288` (512KB) or `1048576` (1MB).",
+			Description: "Optimum IO size of the volume in bytes, i.e. its strip size. Must be a power of two within the" +
+				" strip sizes supported by the controller, commonly 65536 (64KB), 131072 (128KB), 262144 (256KB)," +
+				" 524288 (512KB) or 1048576 (1MB).",
+			Optional: true,
+			Validators: []validator.Int64{
+				powerOfTwoValidator{},
+			},
 		},
 		"read_cache_policy": schema.StringAttribute{
 			MarkdownDescription: "Read Cache Policy",
@@ -175,6 +420,13 @@ func VolumeSchema() map[string]schema.Attribute {
 				}...),
 			},
 		},
+		"redundant_drive_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of redundant (mirror or parity) drives per span of the volume, e.g. `2` for `RAID6` and `RAID60`. " +
+				"The controllers fix it by RAID level, so it follows `raid_type` and can't be configured.",
+			Description: "Number of redundant (mirror or parity) drives per span of the volume, e.g. 2 for RAID6 and RAID60. " +
+				"The controllers fix it by RAID level, so it follows raid_type and can't be configured.",
+			Computed: true,
+		},
 		"reset_timeout": schema.Int64Attribute{
 			MarkdownDescription: "Reset Timeout",
 			Description:         "Reset Timeout",
@@ -193,27 +445,204 @@ func VolumeSchema() map[string]schema.Attribute {
 					string(redfish.ForceRestartResetType),
 					string(redfish.GracefulRestartResetType),
 					string(redfish.PowerCycleResetType),
+					string(redfish.NmiResetType),
+					string(redfish.PushPowerButtonResetType),
 				}...),
 			},
 		},
 		"settings_apply_time": schema.StringAttribute{
-			MarkdownDescription: "Settings Apply Time",
-			Description:         "Settings Apply Time",
-			Optional:            true,
-			Computed:            true,
-			Default:             stringdefault.StaticString(string(redfishcommon.ImmediateApplyTime)),
+			MarkdownDescription: "Settings Apply Time. `AtMaintenanceWindowStart` and `InMaintenanceWindowOnReset` schedule the" +
+				" volume job in `maintenance_window` instead of waiting for it, the job is tracked on the next refreshes." +
+				" The controller must advertise the chosen value. Volumes are always deleted immediately in these modes.",
+			Description: "Settings Apply Time. AtMaintenanceWindowStart and InMaintenanceWindowOnReset schedule the" +
+				" volume job in maintenance_window instead of waiting for it, the job is tracked on the next refreshes." +
+				" The controller must advertise the chosen value. Volumes are always deleted immediately in these modes.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(string(redfishcommon.ImmediateApplyTime)),
 			Validators: []validator.String{
 				stringvalidator.OneOf([]string{
 					string(redfishcommon.ImmediateApplyTime),
 					string(redfishcommon.OnResetApplyTime),
+					string(redfishcommon.AtMaintenanceWindowStartApplyTime),
+					string(redfishcommon.InMaintenanceWindowOnResetApplyTime),
 				}...),
 			},
 		},
+		"maintenance_window": schema.SingleNestedAttribute{
+			MarkdownDescription: "Maintenance window the volume jobs are scheduled in. " +
+				"This is required when `settings_apply_time` is `AtMaintenanceWindowStart` or `InMaintenanceWindowOnReset`.",
+			Description: "Maintenance window the volume jobs are scheduled in. " +
+				"This is required when settings_apply_time is AtMaintenanceWindowStart or InMaintenanceWindowOnReset.",
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"start_time": schema.StringAttribute{
+					MarkdownDescription: "The start time of the maintenance window. The format is YYYY-MM-DDThh:mm:ss<offset>, " +
+						"<offset> being the offset from UTC of the iDRAC timezone, e.g. `+05:30` for IST.",
+					Description: "The start time of the maintenance window. The format is YYYY-MM-DDThh:mm:ss<offset>, " +
+						"<offset> being the offset from UTC of the iDRAC timezone, e.g. +05:30 for IST.",
+					Required:   true,
+					Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+				},
+				"duration": schema.Int64Attribute{
+					MarkdownDescription: "The duration in seconds of the maintenance window.",
+					Description:         "The duration in seconds of the maintenance window.",
+					Required:            true,
+					Validators:          []validator.Int64{int64validator.AtLeast(1)},
+				},
+			},
+		},
 		"storage_controller_id": schema.StringAttribute{
 			MarkdownDescription: "Storage Controller ID",
 			Description:         "Storage Controller ID",
 			Required:            true,
 		},
+		"span_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of spans of a `RAID10`, `RAID50` or `RAID60` volume. " +
+				"Must be set with `span_length`, and `span_count` * `span_length` must be the number of drives. Changing this forces a new volume.",
+			Description: "Number of spans of a RAID10, RAID50 or RAID60 volume. " +
+				"Must be set with span_length, and span_count * span_length must be the number of drives. Changing this forces a new volume.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(2),
+				int64validator.AlsoRequires(path.MatchRoot("span_length")),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"span_length": schema.Int64Attribute{
+			MarkdownDescription: "Number of drives per span of a `RAID10`, `RAID50` or `RAID60` volume. " +
+				"Must be set with `span_count`. Changing this forces a new volume.",
+			Description: "Number of drives per span of a RAID10, RAID50 or RAID60 volume. " +
+				"Must be set with span_count. Changing this forces a new volume.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(2),
+				int64validator.AlsoRequires(path.MatchRoot("span_count")),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"initialization": schema.StringAttribute{
+			MarkdownDescription: "Initialization of the volume after its creation. Accepted values: `None` (no initialization), " +
+				"`Fast` (background initialization), `Full` (foreground initialization). " +
+				"When unset, the controller's default is used. Changing this forces a new volume.",
+			Description: "Initialization of the volume after its creation. Accepted values: None (no initialization), " +
+				"Fast (background initialization), Full (foreground initialization). " +
+				"When unset, the controller's default is used. Changing this forces a new volume.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					initializationNone,
+					initializationFast,
+					initializationFull,
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"protection_information": schema.StringAttribute{
+			MarkdownDescription: "T10 Protection Information (DIF) of the volume. Accepted values: `None`, `T10DIF`. " +
+				"`T10DIF` requires a controller and member drives that are T10 PI capable. Changing this forces a new volume.",
+			Description: "T10 Protection Information (DIF) of the volume. Accepted values: None, T10DIF. " +
+				"T10DIF requires a controller and member drives that are T10 PI capable. Changing this forces a new volume.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(protectionInformationNone),
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					protectionInformationNone,
+					protectionInformationT10DIF,
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"allow_mixed_drives": schema.BoolAttribute{
+			MarkdownDescription: "Allow drives of distinct media types, e.g. SSD and HDD, or protocols, e.g. SAS and SATA, in the volume." +
+				" Most controllers reject such volumes, so by default they are reported before any job is submitted. Default is false.",
+			Description: "Allow drives of distinct media types, e.g. SSD and HDD, or protocols, e.g. SAS and SATA, in the volume." +
+				" Most controllers reject such volumes, so by default they are reported before any job is submitted. Default is false.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"adopt_existing": schema.BoolAttribute{
+			MarkdownDescription: "Adopt the volume of the controller named `volume_name`, e.g. created out of band or by an" +
+				" interrupted apply, instead of creating a new one. Its `raid_type`, drives, `encrypted` and, when set," +
+				" `capacity_bytes` must match the configuration, otherwise the create fails. `dedicated_hot_spares` are not" +
+				" assigned to an adopted volume. Default is false.",
+			Description: "Adopt the volume of the controller named volume_name, e.g. created out of band or by an" +
+				" interrupted apply, instead of creating a new one. Its raid_type, drives, encrypted and, when set," +
+				" capacity_bytes must match the configuration, otherwise the create fails. dedicated_hot_spares are not" +
+				" assigned to an adopted volume. Default is false.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"cancel_pending_jobs": schema.BoolAttribute{
+			MarkdownDescription: "Delete the configuration jobs still pending on the controller, e.g. left by a previous" +
+				" `OnReset` operation whose server was never reset, before creating or updating the volume. When false, the" +
+				" operation fails while such jobs exist. Default is false.",
+			Description: "Delete the configuration jobs still pending on the controller, e.g. left by a previous" +
+				" OnReset operation whose server was never reset, before creating or updating the volume. When false, the" +
+				" operation fails while such jobs exist. Default is false.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"job_check_interval": schema.Int64Attribute{
+			MarkdownDescription: "Interval in seconds between two status checks of the volume job and of the server reset." +
+				" Must be less than `volume_job_timeout`.",
+			Description: "Interval in seconds between two status checks of the volume job and of the server reset." +
+				" Must be less than volume_job_timeout.",
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(defaultStorageVolumeJobCheckInterval),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"job_check_max_interval": schema.Int64Attribute{
+			MarkdownDescription: "Maximum interval in seconds between two status checks of the volume jobs. When set, the interval" +
+				" doubles after every check, starting from `job_check_interval` up to this value, so that long jobs are polled" +
+				" less often. When unset, the jobs are checked every `job_check_interval`. Must be at least `job_check_interval`.",
+			Description: "Maximum interval in seconds between two status checks of the volume jobs. When set, the interval" +
+				" doubles after every check, starting from job_check_interval up to this value, so that long jobs are polled" +
+				" less often. When unset, the jobs are checked every job_check_interval. Must be at least job_check_interval.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"lc_ready_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Time in seconds to wait for the Lifecycle Controller of Dell servers to be ready before" +
+				" submitting the volume jobs, it is polled every `job_check_interval`. Jobs submitted while it is busy are rejected.",
+			Description: "Time in seconds to wait for the Lifecycle Controller of Dell servers to be ready before" +
+				" submitting the volume jobs, it is polled every job_check_interval. Jobs submitted while it is busy are rejected.",
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(defaultStorageVolumeLCReadyTimeout),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"pre_reboot_delay": schema.Int64Attribute{
+			MarkdownDescription: "Delay in seconds to let the job creating, updating or deleting the volume register before rebooting" +
+				" the server when `settings_apply_time` is `OnReset`. Ignored for `Immediate`.",
+			Description: "Delay in seconds to let the job creating, updating or deleting the volume register before rebooting" +
+				" the server when settings_apply_time is OnReset. Ignored for Immediate.",
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(defaultStorageVolumePreRebootDelay),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
 		"volume_job_timeout": schema.Int64Attribute{
 			MarkdownDescription: "Volume Job Timeout",
 			Description:         "Volume Job Timeout",
@@ -235,10 +664,12 @@ func VolumeSchema() map[string]schema.Attribute {
 			},
 		},
 		"volume_type": schema.StringAttribute{
-			MarkdownDescription: "Volume Type",
-			Description:         "Volume Type",
-			Optional:            true,
-			DeprecationMessage:  "Volume Type is deprecated and will be removed in a future release. Please use raid_type instead.",
+			MarkdownDescription: "Volume Type. RAID6 and RAID60 have no volume type and can only be requested through `raid_type`." +
+				" When `raid_type` is also set, both must select the same RAID level.",
+			Description: "Volume Type. RAID6 and RAID60 have no volume type and can only be requested through raid_type." +
+				" When raid_type is also set, both must select the same RAID level.",
+			Optional:           true,
+			DeprecationMessage: "Volume Type is deprecated and will be removed in a future release. Please use raid_type instead.",
 			Validators: []validator.String{
 				stringvalidator.OneOf([]string{
 					string(redfish.NonRedundantVolumeType),
@@ -287,6 +718,119 @@ func (*RedfishStorageVolumeResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+// ValidateConfig checks that maintenance_window is set for the maintenance window apply times, that the
+// deprecated volume_type doesn't conflict with raid_type, that the drives of a volume kept on destroy aren't
+// erased, that the job is polled at least once before volume_job_timeout is reached and that
+// job_check_max_interval doesn't go below job_check_interval.
+func (*RedfishStorageVolumeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var settingsApplyTime types.String
+	var maintenanceWindow types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("settings_apply_time"), &settingsApplyTime)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("maintenance_window"), &maintenanceWindow)...)
+	if isMaintenanceWindowApplyTime(settingsApplyTime.ValueString()) && maintenanceWindow.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("maintenance_window"), "Missing maintenance_window",
+			fmt.Sprintf("maintenance_window must be set when settings_apply_time is %s", settingsApplyTime.ValueString()))
+	}
+
+	var volumeType, raidType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("volume_type"), &volumeType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("raid_type"), &raidType)...)
+	if err := checkVolumeTypeConsistency(volumeType, raidType); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("raid_type"), "Inconsistent volume_type and raid_type", err.Error())
+	}
+
+	var deleteVolumeOnDestroy, secureErase, cryptoErase types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("delete_volume_on_destroy"), &deleteVolumeOnDestroy)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secure_erase_on_destroy"), &secureErase)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cryptographic_erase_on_destroy"), &cryptoErase)...)
+	if !deleteVolumeOnDestroy.IsNull() && !deleteVolumeOnDestroy.IsUnknown() && !deleteVolumeOnDestroy.ValueBool() &&
+		(secureErase.ValueBool() || cryptoErase.ValueBool()) {
+		resp.Diagnostics.AddAttributeError(path.Root("delete_volume_on_destroy"), "Invalid delete_volume_on_destroy",
+			"the drives of a volume kept on destroy can't be erased, unset secure_erase_on_destroy and cryptographic_erase_on_destroy")
+	}
+
+	var jobCheckInterval, jobCheckMaxInterval, volumeJobTimeout types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("job_check_interval"), &jobCheckInterval)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("job_check_max_interval"), &jobCheckMaxInterval)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("volume_job_timeout"), &volumeJobTimeout)...)
+	if resp.Diagnostics.HasError() || jobCheckInterval.IsUnknown() {
+		return
+	}
+
+	interval := defaultStorageVolumeJobCheckInterval
+	if !jobCheckInterval.IsNull() {
+		interval = jobCheckInterval.ValueInt64()
+	}
+	if !jobCheckMaxInterval.IsNull() && !jobCheckMaxInterval.IsUnknown() && jobCheckMaxInterval.ValueInt64() < interval {
+		resp.Diagnostics.AddAttributeError(path.Root("job_check_max_interval"), "Invalid job_check_max_interval",
+			fmt.Sprintf("job_check_max_interval (%d) must be at least job_check_interval (%d)", jobCheckMaxInterval.ValueInt64(), interval))
+	}
+
+	if jobCheckInterval.IsNull() || volumeJobTimeout.IsUnknown() {
+		return
+	}
+
+	timeout := defaultStorageVolumeJobTimeout
+	if !volumeJobTimeout.IsNull() {
+		timeout = volumeJobTimeout.ValueInt64()
+	}
+	if jobCheckInterval.ValueInt64() >= timeout {
+		resp.Diagnostics.AddAttributeError(path.Root("job_check_interval"), "Invalid job_check_interval",
+			fmt.Sprintf("job_check_interval (%d) must be less than volume_job_timeout (%d)", jobCheckInterval.ValueInt64(), timeout))
+	}
+}
+
+// ModifyPlan translates the deprecated volume_type into raid_type when raid_type is not configured
+// and resolves the redundant_drive_count of the planned RAID level.
+// A capacity_bytes of 0 keeps the capacity the controller gave to the existing volume.
+// A volume whose drives are still to be erased is already deleted, so it is replaced to retry the erase.
+func (*RedfishStorageVolumeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var pendingErase types.List
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("pending_erase_drive_ids"), &pendingErase)...)
+		if len(pendingErase.Elements()) > 0 {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("pending_erase_drive_ids"))
+		}
+
+		var capacityBytes, stateCapacityBytes types.Int64
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("capacity_bytes"), &capacityBytes)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("capacity_bytes"), &stateCapacityBytes)...)
+		if !capacityBytes.IsNull() && !capacityBytes.IsUnknown() && capacityBytes.ValueInt64() == 0 && !stateCapacityBytes.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("capacity_bytes"), stateCapacityBytes)...)
+		}
+	}
+
+	var volumeType, raidType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("volume_type"), &volumeType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("raid_type"), &raidType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !volumeType.IsNull() && !volumeType.IsUnknown() && raidType.IsNull() {
+		if mapped, ok := volumeTypeMap[volumeType.ValueString()]; ok {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("raid_type"), mapped)...)
+		}
+	}
+
+	var plannedRaidType types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("raid_type"), &plannedRaidType)...)
+	if resp.Diagnostics.HasError() || plannedRaidType.IsUnknown() {
+		return
+	}
+
+	redundantDriveCount := types.Int64Null()
+	if count, ok := raidRedundantDriveCount[plannedRaidType.ValueString()]; ok {
+		redundantDriveCount = types.Int64Value(count)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("redundant_drive_count"), redundantDriveCount)...)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *RedfishStorageVolumeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "resource_RedfishStorageVolume create : Started")
@@ -297,23 +841,39 @@ func (r *RedfishStorageVolumeResource) Create(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(volumeTypeDeprecationWarning(plan.VolumeType, plan.RaidType)...)
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
-	diags = createRedfishStorageVolume(ctx, service, &plan)
+	volume, diags := createRedfishStorageVolume(ctx, service, &plan)
 	resp.Diagnostics.Append(diags...)
+	if !diags.HasError() && plan.WaitForInitialization.ValueBool() && !isVolumeJobPending(&plan) {
+		initialized, initDiags := waitForVolumeInitialization(ctx, service, plan.ID.ValueString(), getJobCheckInterval(&plan),
+			plan.VolumeJobTimeout.ValueInt64())
+		resp.Diagnostics.Append(initDiags...)
+		if initialized != nil {
+			volume = initialized
+		}
+	}
 
 	tflog.Trace(ctx, "resource_RedfishStorageVolume create: updating state finished, saving ...")
 	// Save into State
 	if diags.HasError() {
+		// The volume whose job timed out is kept in the state, along with its job ID
+		if hasVolumeJobTimedOut(diags) {
+			refreshVolumeStatus(service, &plan, nil)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		}
 		return
 	}
+	refreshVolumeStatus(service, &plan, volume)
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	tflog.Trace(ctx, "resource_RedfishStorageVolume create: finish")
@@ -329,14 +889,37 @@ func (r *RedfishStorageVolumeResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
+	// The volume is gone but its drives still hold its data, keep it in the state to retry the erase on destroy
+	if len(state.PendingEraseDriveIDs.Elements()) > 0 {
+		resp.Diagnostics.AddWarning("Volume drives are not erased",
+			fmt.Sprintf("The volume %s was deleted but the erase of drives %s failed, it is retried on the next destroy.",
+				state.ID.ValueString(), state.PendingEraseDriveIDs.String()))
+		return
+	}
+
+	// Attach to the job of an interrupted create instead of reading a volume that doesn't exist yet
+	if isVolumeJobPending(&state) {
+		diags, failed := attachVolumeJob(ctx, service, &state)
+		resp.Diagnostics.Append(diags...)
+		if failed {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if diags.HasError() || isVolumeJobPending(&state) {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
 	diags, cleanup := readRedfishStorageVolume(service, &state)
 	if cleanup {
 		resp.State.RemoveResource(ctx)
@@ -377,11 +960,14 @@ func (r *RedfishStorageVolumeResource) Update(ctx context.Context, req resource.
 			"Cannot disable encryption, once a disk is encrypted it cannot be transformed back into an non-encrypted state.")
 		return
 	}
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	resp.Diagnostics.Append(volumeTypeDeprecationWarning(plan.VolumeType, plan.RaidType)...)
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -393,6 +979,7 @@ func (r *RedfishStorageVolumeResource) Update(ctx context.Context, req resource.
 	if diags.HasError() {
 		return
 	}
+	refreshVolumeStatus(service, &plan, nil)
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	tflog.Trace(ctx, "resource_RedfishStorageVolume update: finished")
@@ -408,17 +995,43 @@ func (r *RedfishStorageVolumeResource) Delete(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	// The volume is left on the controller, only the resource is removed from the state
+	if !state.DeleteVolumeOnDestroy.IsNull() && !state.DeleteVolumeOnDestroy.ValueBool() {
+		tflog.Warn(ctx, "resource_RedfishStorageVolume delete: delete_volume_on_destroy is false, keeping the volume")
+		resp.Diagnostics.AddWarning("The volume is not deleted",
+			fmt.Sprintf("delete_volume_on_destroy is false, the volume %s is kept on the controller and only removed from the state.",
+				state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
+	if isVolumeJobPending(&state) {
+		diags, failed := attachVolumeJob(ctx, service, &state)
+		resp.Diagnostics.Append(diags...)
+		if failed {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if diags.HasError() || isVolumeJobPending(&state) {
+			resp.Diagnostics.AddError("Error when deleting volume", "the job creating the volume is still running")
+			return
+		}
+	}
+
 	diags = deleteRedfishStorageVolume(ctx, service, &state)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
+		// Keep the drives left to erase, so that the next destroy retries the erase
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 		return
 	}
 	resp.State.RemoveResource(ctx)
@@ -426,21 +1039,24 @@ func (r *RedfishStorageVolumeResource) Delete(ctx context.Context, req resource.
 }
 
 // ImportState import state for existing volume
-func (*RedfishStorageVolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *RedfishStorageVolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	type creds struct {
-		Username     string `json:"username"`
-		Password     string `json:"password"`
-		Endpoint     string `json:"endpoint"`
-		SslInsecure  bool   `json:"ssl_insecure"`
-		Id           string `json:"id"`
-		SystemID     string `json:"system_id"`
-		RedfishAlias string `json:"redfish_alias"`
+		Username            string `json:"username"`
+		Password            string `json:"password"`
+		Endpoint            string `json:"endpoint"`
+		SslInsecure         bool   `json:"ssl_insecure"`
+		Id                  string `json:"id"`
+		SystemID            string `json:"system_id"`
+		RedfishAlias        string `json:"redfish_alias"`
+		StorageControllerID string `json:"storage_controller_id"`
+		VolumeName          string `json:"volume_name"`
 	}
 
 	var c creds
 	err := json.Unmarshal([]byte(req.ID), &c)
 	if err != nil {
 		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
 	}
 
 	server := models.RedfishServer{
@@ -451,33 +1067,106 @@ func (*RedfishStorageVolumeResource) ImportState(ctx context.Context, req resour
 		RedfishAlias: types.StringValue(c.RedfishAlias),
 	}
 
+	// With redfish_alias, the connection details are resolved from the provider's `redfish_servers`
+	// so that credentials don't have to be part of the import ID
+	if c.RedfishAlias != "" {
+		aliasServer := server
+		if err := getActiveAliasRedfishServer(r.p, &aliasServer); err != nil {
+			resp.Diagnostics.AddError("Error while resolving redfish_alias", err.Error())
+			return
+		}
+		if c.Username == "" && c.Password == "" && c.Endpoint == "" {
+			server.User = types.StringNull()
+			server.Password = types.StringNull()
+			server.Endpoint = types.StringNull()
+			server.SslInsecure = types.BoolNull()
+		}
+	} else if c.Endpoint == "" {
+		resp.Diagnostics.AddError("Error while importing volume",
+			"either redfish_alias or endpoint must be provided")
+		return
+	}
+
+	// The volume can be given by its controller and name instead of its odata id
+	if c.Id == "" {
+		if c.StorageControllerID == "" || c.VolumeName == "" {
+			resp.Diagnostics.AddError("Error while importing volume",
+				"either id or both storage_controller_id and volume_name must be provided")
+			return
+		}
+		c.Id, err = r.getVolumeIDByName(ctx, server, c.SystemID, c.StorageControllerID, c.VolumeName)
+		if err != nil {
+			resp.Diagnostics.AddError("Error while resolving the volume to import", err.Error())
+			return
+		}
+	}
+	if c.StorageControllerID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("storage_controller_id"), c.StorageControllerID)...)
+	}
+
 	idAttrPath := path.Root("id")
 	redfishServer := path.Root("redfish_server")
 	resetTimeout := path.Root("reset_timeout")
 	resetType := path.Root("reset_type")
 	volumeJobTimeout := path.Root("volume_job_timeout")
+	jobCheckInterval := path.Root("job_check_interval")
 	settingsApplyTime := path.Root("settings_apply_time")
 	systemID := path.Root("system_id")
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, resetTimeout, defaultStorageVolumeResetTimeout)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, resetType, string(redfish.ForceRestartResetType))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, volumeJobTimeout, defaultStorageVolumeJobTimeout)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, jobCheckInterval, defaultStorageVolumeJobCheckInterval)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pre_reboot_delay"), defaultStorageVolumePreRebootDelay)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("lc_ready_timeout"), defaultStorageVolumeLCReadyTimeout)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cancel_pending_jobs"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_mixed_drives"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("is_cache"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_volume_on_destroy"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_initialization"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("encryption_types"),
+		[]string{string(redfish.NativeDriveEncryptionEncryptionTypes)})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pending_erase_drive_ids"), []string{})...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, settingsApplyTime, string(redfishcommon.ImmediateApplyTime))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, idAttrPath, c.Id)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, redfishServer, []models.RedfishServer{server})...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, systemID, c.SystemID)...)
 }
 
-// nolint: revive
-func createRedfishStorageVolume(ctx context.Context, service *gofish.Service, d *models.RedfishStorageVolume) diag.Diagnostics {
-	var diags diag.Diagnostics
-	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(d.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(d.RedfishServer[0].Endpoint.ValueString())
+// getVolumeIDByName returns the odata id of the volume with the given name on the storage controller.
+func (r *RedfishStorageVolumeResource) getVolumeIDByName(ctx context.Context, server models.RedfishServer, systemID, storageID, volumeName string) (string, error) {
+	api, err := NewConfig(ctx, r.p, &[]models.RedfishServer{server})
+	if err != nil {
+		return "", err
+	}
+	defer api.Logout()
+
+	storage, _, err := getStorage(api.Service, systemID, storageID)
+	if err != nil {
+		return "", err
+	}
+	volumes, err := storage.Volumes()
+	if err != nil {
+		return "", fmt.Errorf("error when retrieving volumes: %w", err)
+	}
+	return getVolumeID(volumes, volumeName)
+}
 
-	isGenerationSeventeenAndAbove, err := isServerGenerationSeventeenAndAbove(service)
+// nolint: revive
+// createRedfishStorageVolume creates the volume and returns it when it was already fetched while
+// waiting for the job, so that the state can be populated without looking it up again.
+func createRedfishStorageVolume(ctx context.Context, service *gofish.Service,
+	d *models.RedfishStorageVolume,
+) (volume *redfish.Volume, diags diag.Diagnostics) {
+	// Lock the controller to avoid race conditions with other resources
+	defer lockStorageController(d.RedfishServer[0].Endpoint.ValueString(), d.StorageControllerID.ValueString(),
+		d.SettingsApplyTime.ValueString() == string(redfishcommon.OnResetApplyTime))()
+
+	// The vendor specific parts of the payload, like the Dell OEM block, depend on the service
+	payloadBuilder, err := newVolumePayloadBuilder(service)
 	if err != nil {
 		diags.AddError("Error retrieving the server generation", err.Error())
-		return diags
+		return nil, diags
 	}
 
 	storageID := d.StorageControllerID.ValueString()
@@ -497,56 +1186,204 @@ func createRedfishStorageVolume(ctx context.Context, service *gofish.Service, d
 	applyTime := d.SettingsApplyTime.ValueString()
 	encrypted := d.Encrypted.ValueBool()
 	volumeJobTimeout := int64(d.VolumeJobTimeout.ValueInt64())
+	jobCheckInterval := getJobCheckInterval(d)
 
-	var driveNames []string
+	var driveNames, driveIDs, encryptionTypes []string
 	diags.Append(d.Drives.ElementsAs(ctx, &driveNames, true)...)
+	diags.Append(d.DriveIDs.ElementsAs(ctx, &driveIDs, true)...)
+	diags.Append(d.EncryptionTypes.ElementsAs(ctx, &encryptionTypes, true)...)
 
 	// Get storage
 	storage, system, err := getStorage(service, d.SystemID.ValueString(), storageID)
+	if errors.Is(err, errNoStorageSubsystem) {
+		diags.AddError(noStorageSubsystemErrorMsg, err.Error())
+		return nil, diags
+	}
 	if err != nil {
 		diags.AddError("Error when retreiving the Storage from the Redfish API", err.Error())
-		return diags
+		return nil, diags
 	}
 
 	d.SystemID = types.StringValue(system.ID)
+	d.ControllerCacheSizeMB = types.Int64Value(getControllerCacheSizeMiB(storage))
+
+	// Older firmware report and expect the legacy cache policy names, so translate the canonical values
+	existingVolumes, err := storage.Volumes()
+	if err != nil {
+		diags.AddError("Error when listing the volumes of the storage", err.Error())
+		return nil, diags
+	}
+	legacyCachePolicyNames := usesLegacyCachePolicyNames(existingVolumes)
+	readCachePolicy = firmwareCachePolicy(readCachePolicy, legacyCachePolicyNames, legacyReadCachePolicyNames)
+	writeCachePolicy = firmwareCachePolicy(writeCachePolicy, legacyCachePolicyNames, legacyWriteCachePolicyNames)
+
+	// Volumes can't be created while the controller is in HBA mode
+	if err := checkControllerRaidMode(storage); err != nil {
+		diags.AddError("Error while checking the controller mode", err.Error())
+		return nil, diags
+	}
 
 	// Check if settings_apply_time is doable on this controller
 	err = checkSettingsApplyTime(storage, applyTime)
 	if err != nil {
 		diags.AddError("Error while checking support for settings_apply_time", err.Error())
-		return diags
+		return nil, diags
+	}
+
+	// Check the system supports the reset before any job is scheduled
+	if applyTime == string(redfishcommon.OnResetApplyTime) {
+		if err := checkResetTypeSupported(system, d.ResetType.ValueString()); err != nil {
+			diags.AddError("Error while checking support for reset_type", err.Error())
+			return nil, diags
+		}
 	}
 
 	// Get drives
 	allStorageDrives, err := storage.Drives()
 	if err != nil {
 		diags.AddError("Error when getting the drives attached to controller", err.Error())
-		return diags
+		return nil, diags
 	}
-	drives, err := getDrives(allStorageDrives, driveNames)
+	drives, err := getDrives(allStorageDrives, driveNames, driveIDs)
 	if err != nil {
 		diags.AddError("Error when getting the drives", err.Error())
-		return diags
+		return nil, diags
+	}
+
+	if err := validateDriveCountForRaid(raidType, len(drives)); err != nil {
+		diags.AddError("Invalid number of drives for the RAID level", err.Error())
+		return nil, diags
+	}
+
+	// A volume of the same name is adopted as long as it matches the configuration
+	if d.AdoptExisting.ValueBool() {
+		if existing, err := getVolumeByName(existingVolumes, volumeName); err == nil {
+			existingDrives, err := getVolumeDrives(service, existing)
+			if err != nil {
+				diags.AddError("Error when retrieving the drives of the existing volume", err.Error())
+				return nil, diags
+			}
+			if conflicts := getAdoptionConflicts(existing, existingDrives, raidType, drives, int64(capacityBytes), encrypted); len(conflicts) > 0 {
+				diags.AddError(adoptionConflictMsg, fmt.Sprintf("The volume %s doesn't match the configuration: %s",
+					existing.ODataID, strings.Join(conflicts, "; ")))
+				return nil, diags
+			}
+			d.JobID = types.StringValue("")
+			d.ID = types.StringValue(existing.ODataID)
+			diags.AddWarning("Existing volume adopted",
+				fmt.Sprintf("The volume %s named %s already exists and matches the configuration, it is adopted instead of created.",
+					existing.ODataID, volumeName))
+			return existing, diags
+		}
+	}
+
+	if d.IsCache.ValueBool() {
+		if err := checkCacheDrives(drives); err != nil {
+			diags.AddError("Invalid drives for a cache volume", err.Error())
+			return nil, diags
+		}
+	}
+	if !d.AllowMixedDrives.ValueBool() {
+		if err := checkMixedDrives(drives); err != nil {
+			diags.AddError(mixedDrivesMsg, err.Error())
+			return nil, diags
+		}
+	}
+
+	if encrypted && isDellService(service) {
+		if err := checkControllerKey(storage); err != nil {
+			diags.AddError(missingControllerKeyMsg, err.Error())
+			return nil, diags
+		}
+	}
+
+	if optimumIOSizeBytes > 0 || encrypted {
+		capabilities, err := getVolumeCapabilities(service, storage)
+		if err != nil {
+			diags.AddError("Error when retrieving the volume capabilities of the controller", err.Error())
+			return nil, diags
+		}
+		if optimumIOSizeBytes > 0 {
+			if err := checkStripSize(int64(optimumIOSizeBytes), capabilities); err != nil {
+				diags.AddError("Invalid optimum_io_size_bytes", err.Error())
+				return nil, diags
+			}
+		}
+		if encrypted {
+			if err := checkEncryptionTypes(encryptionTypes, capabilities); err != nil {
+				diags.AddError("Invalid encryption_types", err.Error())
+				return nil, diags
+			}
+		}
+	}
+
+	spanCount, spanLength := d.SpanCount.ValueInt64(), d.SpanLength.ValueInt64()
+	if err := validateSpans(raidType, spanCount, spanLength, len(drives)); err != nil {
+		diags.AddError("Invalid span configuration", err.Error())
+		return nil, diags
+	}
+
+	maxCapacityBytes := usableCapacityBytes(raidType, drives, spanCount)
+	if int64(capacityBytes) > maxCapacityBytes {
+		diags.AddError("Invalid capacity_bytes",
+			fmt.Sprintf("capacity_bytes %d exceeds the usable capacity of the drives for %s, the maximum is %d bytes",
+				capacityBytes, raidType, maxCapacityBytes))
+		return nil, diags
+	}
+
+	var spareNames []string
+	diags.Append(d.DedicatedHotSpares.ElementsAs(ctx, &spareNames, true)...)
+	spareNames, spareIDs := splitDriveNamesAndIDs(allStorageDrives, spareNames)
+	spares, err := getDrives(allStorageDrives, spareNames, spareIDs)
+	if err != nil {
+		diags.AddError("Error when getting the dedicated hot spares", err.Error())
+		return nil, diags
+	}
+	if err := validateDedicatedHotSpares(spares, drives); err != nil {
+		diags.AddError("Invalid dedicated hot spares", err.Error())
+		return nil, diags
+	}
+
+	protectionInformation := d.ProtectionInformation.ValueString()
+	oem, err := payloadBuilder.oem(volumeOemSettings{
+		DiskCachePolicy: diskCachePolicy,
+		T10PI:           protectionInformation == protectionInformationT10DIF,
+		SpanCount:       spanCount,
+		SpanLength:      spanLength,
+		Cache:           d.IsCache.ValueBool(),
+	})
+	if err != nil {
+		diags.AddError("Invalid volume settings for this controller", err.Error())
+		return nil, diags
+	}
+	if protectionInformation == protectionInformationT10DIF {
+		if err := checkProtectionInformationSupport(storage, drives); err != nil {
+			diags.AddError("Error while checking support for protection_information", err.Error())
+			return nil, diags
+		}
 	}
 
 	newVolume := map[string]interface{}{
-		"DisplayName":        volumeName,
-		"Name":               volumeName,
-		"ReadCachePolicy":    readCachePolicy,
-		"WriteCachePolicy":   writeCachePolicy,
-		"CapacityBytes":      capacityBytes,
-		"OptimumIOSizeBytes": optimumIOSizeBytes,
-		"RAIDType":           raidType,
-		"Encrypted":          encrypted,
-		"Oem": map[string]map[string]map[string]interface{}{
-			"Dell": {
-				"DellVolume": {
-					"DiskCachePolicy": diskCachePolicy,
-				},
-			},
-		},
+		"DisplayName":                 volumeName,
+		"Name":                        volumeName,
+		"ReadCachePolicy":             readCachePolicy,
+		"WriteCachePolicy":            writeCachePolicy,
+		"OptimumIOSizeBytes":          optimumIOSizeBytes,
+		"RAIDType":                    raidType,
 		"@Redfish.OperationApplyTime": applyTime,
 	}
+	if isMaintenanceWindowApplyTime(applyTime) {
+		if d.MaintenanceWindow == nil {
+			diags.AddError("Error while checking support for settings_apply_time",
+				fmt.Sprintf("maintenance_window must be set when settings_apply_time is %s", applyTime))
+			return nil, diags
+		}
+		newVolume["@Redfish.MaintenanceWindow"] = maintenanceWindowPayload(d.MaintenanceWindow)
+	}
+	if oem != nil {
+		newVolume["Oem"] = oem
+	}
+	setVolumeEncryptionAndCapacity(newVolume, encrypted, encryptionTypes, capacityBytes, isBOSSController(storage))
 
 	var listDrives []map[string]string
 	for _, drive := range drives {
@@ -555,18 +1392,44 @@ func createRedfishStorageVolume(ctx context.Context, service *gofish.Service, d
 		listDrives = append(listDrives, storageDrive)
 	}
 
-	// For 17G, have Drives as part of Links
-	if isGenerationSeventeenAndAbove {
-		newVolume["Links"] = map[string]interface{}{"Drives": listDrives}
-	} else {
-		newVolume["Drives"] = listDrives
+	if initialization := d.Initialization.ValueString(); initialization != "" {
+		newVolume["InitializeMethod"] = initializationMethods[initialization]
+	}
+
+	payloadBuilder.setDrives(newVolume, listDrives)
+
+	if err := checkPendingControllerJobs(service, storageID, d.CancelPendingJobs.ValueBool()); err != nil {
+		diags.AddError(pendingControllerJobsMsg, err.Error())
+		return nil, diags
+	}
+	if err := waitForLifecycleControllerReady(ctx, service, jobCheckInterval, getLCReadyTimeout(d)); err != nil {
+		diags.AddError(lifecycleControllerNotReadyMsg, err.Error())
+		return nil, diags
 	}
 
 	// Create volume job
-	jobID, err := createVolume(service, storage.ODataID, newVolume)
+	jobID, volumeID, err := createVolume(service, storage.ODataID, newVolume)
 	if err != nil {
 		diags.AddError("Error when creating the virtual disk on disk controller", err.Error())
-		return diags
+		return nil, diags
+	}
+	// Some implementations create the volume right away instead of scheduling a job
+	if volumeID != "" {
+		d.JobID = types.StringValue("")
+		d.ID = types.StringValue(volumeID)
+		diags.Append(assignDedicatedHotSpares(ctx, service, system, spares, d)...)
+		return nil, diags
+	}
+	// Until the volume is found, its ID is the one of the job creating it
+	d.JobID = types.StringValue(jobID)
+	d.ID = types.StringValue(jobID)
+
+	// The job runs in the maintenance window, it is attached on the refreshes that follow it
+	if isMaintenanceWindowApplyTime(applyTime) {
+		diags.AddWarning(volumeJobScheduledMsg,
+			fmt.Sprintf("The job %s creating the volume starts at %s, the volume is resolved on the first refresh after it completes.",
+				jobID, d.MaintenanceWindow.StartTime.ValueString()))
+		return nil, diags
 	}
 
 	// Immediate or OnReset scenarios
@@ -575,47 +1438,151 @@ func createRedfishStorageVolume(ctx context.Context, service *gofish.Service, d
 		resetType := d.ResetType.ValueString()
 		resetTimeout := d.ResetTimeout.ValueInt64()
 
+		// Let the create job register before the reboot
+		if err := waitPreRebootDelay(ctx, d); err != nil {
+			diags.AddError(RedfishJobErrorMsg, err.Error())
+			return nil, diags
+		}
+
 		// Reboot the server
 		pOp := powerOperator{ctx, service, d.SystemID.ValueString()}
-		_, err := pOp.PowerOperation(resetType, resetTimeout, intervalStorageVolumeJobCheckTime)
+		_, err := pOp.PowerOperation(resetType, resetTimeout, jobCheckInterval)
 		if err != nil {
 			diags.AddError(RedfishJobErrorMsg, err.Error())
-			return diags
+			return nil, diags
 		}
 	}
 
 	// Wait for the job to finish
-	err = common.WaitForTaskToFinish(service, jobID, intervalStorageVolumeJobCheckTime, volumeJobTimeout)
+	err = common.WaitForTaskToFinishWithBackoff(ctx, service, jobID, jobCheckInterval, getJobCheckMaxInterval(d), volumeJobTimeout)
+	if isContextDone(err) {
+		diags.AddWarning(volumeJobInterruptedMsg, fmt.Sprintf("job %s: %s", jobID, err.Error()))
+		return nil, diags
+	}
+	if errors.Is(err, common.ErrJobTimeout) {
+		// The job may still create the volume, keep track of it rather than leaving an orphaned array behind
+		if volumes, err := storage.Volumes(); err == nil {
+			if volume, err := getVolumeByName(volumes, volumeName); err == nil {
+				d.ID = types.StringValue(volume.ODataID)
+			}
+		}
+		diags.AddWarning(volumeJobTimeoutMsg,
+			fmt.Sprintf("The job %s creating the volume is still running, the volume %s is kept in the state.", jobID, d.ID.ValueString()))
+		diags.AddError(RedfishJobErrorMsg,
+			fmt.Sprintf("%s. Increase volume_job_timeout, untaint the volume and re-apply to resume tracking the job %s.", err.Error(), jobID))
+		return nil, diags
+	}
 	if err != nil {
 		diags.AddError(RedfishJobErrorMsg, err.Error())
-		return diags
+		return nil, diags
 	}
-	time.Sleep(60 * time.Second)
 
-	// Get storage volumes
-	volumes, err := storage.Volumes()
-	if err != nil {
-		diags.AddError("there was an issue when retrieving volumes", err.Error())
-		return diags
+	// The volume may not be listed right away once its job is completed
+	volume, err = waitForVolume(ctx, storage, volumeName, jobCheckInterval, volumeJobTimeout)
+	if isContextDone(err) {
+		diags.AddWarning(volumeJobInterruptedMsg, fmt.Sprintf("job %s: %s", jobID, err.Error()))
+		return nil, diags
 	}
-	volumeID, err := getVolumeID(volumes, volumeName)
 	if err != nil {
 		diags.AddError("Error. The volume ID with given volume name was not found", err.Error())
-		return diags
+		return nil, diags
 	}
 
-	d.ID = types.StringValue(volumeID)
-	return diags
+	d.ID = types.StringValue(volume.ODataID)
+	diags.Append(assignDedicatedHotSpares(ctx, service, system, spares, d)...)
+	return volume, diags
 }
 
-func readRedfishStorageVolume(service *gofish.Service, d *models.RedfishStorageVolume) (diags diag.Diagnostics, cleanup bool) {
-	// Check if the volume exists
-	volume, err := redfish.GetVolume(service.GetClient(), d.ID.ValueString())
-	if err != nil {
-		var redfishErr *redfishcommon.Error
-		if !errors.As(err, &redfishErr) {
-			diags.AddError("There was an error with the API", err.Error())
-			return diags, false
+// isVolumeJobPending reports whether the volume is still being created by a job an interrupted apply left behind.
+func isVolumeJobPending(d *models.RedfishStorageVolume) bool {
+	return d.JobID.ValueString() != "" && d.ID.ValueString() == d.JobID.ValueString()
+}
+
+// hasVolumeJobTimedOut reports whether the create diagnostics include the timeout of the job creating the volume.
+func hasVolumeJobTimedOut(diags diag.Diagnostics) bool {
+	for _, d := range diags.Warnings() {
+		if d.Summary() == volumeJobTimeoutMsg {
+			return true
+		}
+	}
+	return false
+}
+
+// isContextDone reports whether the error is due to the Terraform context being cancelled or timing out.
+func isContextDone(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// attachVolumeJob waits for the pending job creating the volume and resolves the volume ID once it is done.
+// The returned boolean is true when the job failed and the volume will never exist.
+func attachVolumeJob(ctx context.Context, service *gofish.Service, d *models.RedfishStorageVolume) (diags diag.Diagnostics, failed bool) {
+	jobID := d.JobID.ValueString()
+	jobCheckInterval := getJobCheckInterval(d)
+	volumeJobTimeout := d.VolumeJobTimeout.ValueInt64()
+
+	// A job scheduled in the maintenance window may not start for hours, it isn't waited for until it is done
+	if isMaintenanceWindowApplyTime(d.SettingsApplyTime.ValueString()) {
+		task, err := getTask(service, jobID)
+		if err != nil {
+			diags.AddError("Error when retrieving the volume job", err.Error())
+			return diags, false
+		}
+		if !isTaskDone(task) {
+			return diags, false
+		}
+	}
+
+	err := common.WaitForTaskToFinishWithBackoff(ctx, service, jobID, jobCheckInterval, getJobCheckMaxInterval(d), volumeJobTimeout)
+	if isContextDone(err) {
+		diags.AddWarning(volumeJobInterruptedMsg, fmt.Sprintf("job %s: %s", jobID, err.Error()))
+		return diags, false
+	}
+	if err != nil {
+		diags.AddWarning(fmt.Sprintf("The job %s creating the volume did not succeed", jobID), err.Error())
+		return diags, true
+	}
+
+	storage, system, err := getStorage(service, d.SystemID.ValueString(), d.StorageControllerID.ValueString())
+	if err != nil {
+		diags.AddError("Error when retreiving the Storage from the Redfish API", err.Error())
+		return diags, false
+	}
+	volume, err := waitForVolume(ctx, storage, d.VolumeName.ValueString(), jobCheckInterval, volumeJobTimeout)
+	if isContextDone(err) {
+		diags.AddWarning(volumeJobInterruptedMsg, fmt.Sprintf("job %s: %s", jobID, err.Error()))
+		return diags, false
+	}
+	if err != nil {
+		diags.AddError("Error. The volume ID with given volume name was not found", err.Error())
+		return diags, false
+	}
+	d.ID = types.StringValue(volume.ODataID)
+
+	var spareNames []string
+	diags.Append(d.DedicatedHotSpares.ElementsAs(ctx, &spareNames, true)...)
+	allStorageDrives, err := storage.Drives()
+	if err != nil {
+		diags.AddError("Error when getting the drives attached to controller", err.Error())
+		return diags, false
+	}
+	spareNames, spareIDs := splitDriveNamesAndIDs(allStorageDrives, spareNames)
+	spares, err := getDrives(allStorageDrives, spareNames, spareIDs)
+	if err != nil {
+		diags.AddError("Error when getting the dedicated hot spares", err.Error())
+		return diags, false
+	}
+	diags.Append(assignDedicatedHotSpares(ctx, service, system, spares, d)...)
+	return diags, false
+}
+
+func readRedfishStorageVolume(service *gofish.Service, d *models.RedfishStorageVolume) (diags diag.Diagnostics, cleanup bool) {
+	// Check if the volume exists
+	volume, err := redfish.GetVolume(service.GetClient(), d.ID.ValueString())
+	if err != nil {
+		var redfishErr *redfishcommon.Error
+		if !errors.As(err, &redfishErr) {
+			diags.AddError("There was an error with the API", err.Error())
+			return diags, false
 		}
 		if redfishErr.HTTPReturnedStatusCode == http.StatusNotFound {
 			diags.AddError("Volume doesn't exist", "")
@@ -626,19 +1593,84 @@ func readRedfishStorageVolume(service *gofish.Service, d *models.RedfishStorageV
 	}
 
 	d.CapacityBytes = types.Int64Value(int64(volume.CapacityBytes))
+	setVolumeStatus(d, volume)
+	if d.JobID.IsNull() || d.JobID.IsUnknown() {
+		d.JobID = types.StringValue("")
+	}
+	if d.SecureEraseOnDestroy.IsNull() || d.SecureEraseOnDestroy.IsUnknown() {
+		d.SecureEraseOnDestroy = types.BoolValue(false)
+	}
+	if d.CryptoEraseOnDestroy.IsNull() || d.CryptoEraseOnDestroy.IsUnknown() {
+		d.CryptoEraseOnDestroy = types.BoolValue(false)
+	}
+	if d.DeleteVolumeOnDestroy.IsNull() || d.DeleteVolumeOnDestroy.IsUnknown() {
+		d.DeleteVolumeOnDestroy = types.BoolValue(true)
+	}
+	if d.PendingEraseDriveIDs.IsNull() || d.PendingEraseDriveIDs.IsUnknown() {
+		d.PendingEraseDriveIDs = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+	if d.IsCache.IsNull() || d.IsCache.IsUnknown() {
+		d.IsCache = types.BoolValue(false)
+	}
+	d.Encrypted = types.BoolValue(volume.Encrypted)
+	if volume.RAIDType != "" {
+		d.RaidType = types.StringValue(string(volume.RAIDType))
+	}
+	d.RedundantDriveCount = types.Int64Null()
+	if count, ok := raidRedundantDriveCount[string(volume.RAIDType)]; ok {
+		d.RedundantDriveCount = types.Int64Value(count)
+	}
 	d.ID = types.StringValue(volume.ODataID)
+	d.ETag = types.StringValue(getVolumeETag(service, volume.ODataID))
 	d.OptimumIoSizeBytes = types.Int64Value(int64(volume.OptimumIOSizeBytes))
-	d.ReadCachePolicy = types.StringValue(string(volume.ReadCachePolicy))
+	d.ReadCachePolicy = types.StringValue(normalizeReadCachePolicy(string(volume.ReadCachePolicy)))
 	d.VolumeName = types.StringValue(volume.Name)
 	d.VolumeType = types.StringValue(string(volume.VolumeType))
-	d.WriteCachePolicy = types.StringValue(string(volume.WriteCachePolicy))
+	d.WriteCachePolicy = types.StringValue(normalizeWriteCachePolicy(string(volume.WriteCachePolicy)))
 
-	drives, _ := volume.Drives()
+	// Read back the drives in the form they were configured, names being the default on import
+	drives, _ := getVolumeDrives(service, volume)
 	drivesList := []attr.Value{}
+	driveIDsList := []attr.Value{}
 	for _, drive := range drives {
 		drivesList = append(drivesList, types.StringValue(drive.Name))
+		driveIDsList = append(driveIDsList, types.StringValue(getDriveAsConfigured(drive, d.DriveIDs, drive.ID)))
+	}
+	if !d.DriveIDs.IsNull() {
+		d.DriveIDs, _ = types.ListValue(types.StringType, driveIDsList)
+	}
+	if !d.Drives.IsNull() || d.DriveIDs.IsNull() {
+		d.Drives, _ = types.ListValue(types.StringType, drivesList)
+	}
+
+	// Only the spares managed by Terraform are read back, so that an imported volume keeps them unset
+	if !d.DedicatedHotSpares.IsNull() {
+		spares, _ := volume.DedicatedSpareDrives()
+		sparesList := []attr.Value{}
+		for _, spare := range spares {
+			sparesList = append(sparesList, types.StringValue(getDriveAsConfigured(spare, d.DedicatedHotSpares, spare.Name)))
+		}
+		d.DedicatedHotSpares, _ = types.ListValue(types.StringType, sparesList)
+	}
+
+	d.ControllerCacheSizeMB = types.Int64Value(0)
+	if storage, err := getVolumeStorage(service, volume.ODataID); err == nil {
+		d.ControllerCacheSizeMB = types.Int64Value(getControllerCacheSizeMiB(storage))
+	}
+
+	// Controllers that don't return the Dell OEM block keep the configured values
+	if volumeExtended, err := dell.Volume(volume); err == nil {
+		d.ProtectionInformation = types.StringValue(protectionInformationNone)
+		if volumeExtended.Oem.Dell.DellVolume.T10PIStatus == "Enabled" {
+			d.ProtectionInformation = types.StringValue(protectionInformationT10DIF)
+		}
+		if diskCachePolicy := volumeExtended.Oem.Dell.DellVolume.DiskCachePolicy; diskCachePolicy != "" {
+			d.DiskCachePolicy = types.StringValue(diskCachePolicy)
+		}
+		if cachecade := volumeExtended.Oem.Dell.DellVolume.Cachecade; cachecade != "" {
+			d.IsCache = types.BoolValue(cachecade == dellCachecadeVolume)
+		}
 	}
-	d.Drives, _ = types.ListValue(types.StringType, drivesList)
 
 	/*
 		- If it has jobID, if finished, get the volumeID
@@ -653,9 +1685,9 @@ func updateRedfishStorageVolume(ctx context.Context, service *gofish.Service,
 ) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(d.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(d.RedfishServer[0].Endpoint.ValueString())
+	// Lock the controller to avoid race conditions with other resources
+	defer lockStorageController(d.RedfishServer[0].Endpoint.ValueString(), d.StorageControllerID.ValueString(),
+		d.SettingsApplyTime.ValueString() == string(redfishcommon.OnResetApplyTime))()
 
 	// Get user config
 	storageID := d.StorageControllerID.ValueString()
@@ -666,19 +1698,37 @@ func updateRedfishStorageVolume(ctx context.Context, service *gofish.Service,
 	applyTime := d.SettingsApplyTime.ValueString()
 	encrypted := d.Encrypted.ValueBool()
 
-	var driveNames []string
+	var driveNames, encryptionTypes []string
 	diags.Append(d.Drives.ElementsAs(ctx, &driveNames, true)...)
+	diags.Append(d.EncryptionTypes.ElementsAs(ctx, &encryptionTypes, true)...)
 
-	volumeJobTimeout := d.ResetTimeout.ValueInt64()
+	volumeJobTimeout := d.VolumeJobTimeout.ValueInt64()
+	jobCheckInterval := getJobCheckInterval(d)
 
 	// Get storage
 	storage, system, err := getStorage(service, d.SystemID.ValueString(), storageID)
+	if errors.Is(err, errNoStorageSubsystem) {
+		diags.AddError(noStorageSubsystemErrorMsg, err.Error())
+		return diags
+	}
 	if err != nil {
 		diags.AddError("Error when retreiving storage details from the Redfish API", err.Error())
 		return diags
 	}
 
 	d.SystemID = types.StringValue(system.ID)
+	d.ControllerCacheSizeMB = types.Int64Value(getControllerCacheSizeMiB(storage))
+
+	// Older firmware report and expect the legacy cache policy names, so translate the canonical values
+	existingVolumes, err := storage.Volumes()
+	if err != nil {
+		diags.AddError("Error when listing the volumes of the storage", err.Error())
+		return diags
+	}
+	legacyCachePolicyNames := usesLegacyCachePolicyNames(existingVolumes)
+	readCachePolicy = firmwareCachePolicy(readCachePolicy, legacyCachePolicyNames, legacyReadCachePolicyNames)
+	writeCachePolicy = firmwareCachePolicy(writeCachePolicy, legacyCachePolicyNames, legacyWriteCachePolicyNames)
+
 	// Check if settings_apply_time is doable on this controller
 	err = checkSettingsApplyTime(storage, applyTime)
 	if err != nil {
@@ -686,41 +1736,155 @@ func updateRedfishStorageVolume(ctx context.Context, service *gofish.Service,
 		return diags
 	}
 
-	payload := map[string]interface{}{
+	// Check the system supports the reset before any job is scheduled
+	if applyTime == string(redfishcommon.OnResetApplyTime) {
+		if err := checkResetTypeSupported(system, d.ResetType.ValueString()); err != nil {
+			diags.AddError("Error while checking support for reset_type", err.Error())
+			return diags
+		}
+	}
+
+	if err := checkPendingControllerJobs(service, storageID, d.CancelPendingJobs.ValueBool()); err != nil {
+		diags.AddError(pendingControllerJobsMsg, err.Error())
+		return diags
+	}
+	if err := waitForLifecycleControllerReady(ctx, service, jobCheckInterval, getLCReadyTimeout(d)); err != nil {
+		diags.AddError(lifecycleControllerNotReadyMsg, err.Error())
+		return diags
+	}
+
+	// Drives added to the volume and RAID level changes are handled by an online reconfiguration
+	// (capacity expansion or RAID level migration) once the settings are applied
+	reconfigureDrives, migrateTo, reconfigureDiags := getVolumeReconfiguration(ctx, service, system, storage, d, state)
+	diags.Append(reconfigureDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	payloadBuilder, err := newVolumePayloadBuilder(service)
+	if err != nil {
+		diags.AddError("Error retrieving the server generation", err.Error())
+		return diags
+	}
+	oem, err := payloadBuilder.oem(volumeOemSettings{DiskCachePolicy: diskCachePolicy})
+	if err != nil {
+		diags.AddError("Invalid volume settings for this controller", err.Error())
+		return diags
+	}
+
+	desired := map[string]interface{}{
 		"ReadCachePolicy":  readCachePolicy,
 		"WriteCachePolicy": writeCachePolicy,
 		"DisplayName":      volumeName,
 		"Encrypted":        encrypted,
-		// This can be hard coded since the other values are deprecated, this is the only supported value
-		"EncryptionTypes": []string{"NativeDriveEncryption"},
-		"Oem": map[string]map[string]map[string]interface{}{
-			"Dell": {
-				"DellVolume": {
-					"DiskCachePolicy": diskCachePolicy,
-				},
-			},
-		},
-		"Name": volumeName,
-		"@Redfish.SettingsApplyTime": map[string]interface{}{
+		"Name":             volumeName,
+	}
+	if oem != nil {
+		desired["Oem"] = oem
+	}
+	if encrypted {
+		if isDellService(service) {
+			if err := checkControllerKey(storage); err != nil {
+				diags.AddError(missingControllerKeyMsg, err.Error())
+				return diags
+			}
+		}
+		capabilities, err := getVolumeCapabilities(service, storage)
+		if err != nil {
+			diags.AddError("Error when retrieving the volume capabilities of the controller", err.Error())
+			return diags
+		}
+		if err := checkEncryptionTypes(encryptionTypes, capabilities); err != nil {
+			diags.AddError("Invalid encryption_types", err.Error())
+			return diags
+		}
+		desired["EncryptionTypes"] = encryptionTypes
+	}
+
+	// Only the settings that differ from the volume are patched, so that settings not managed
+	// by this resource, including the other OEM attributes, are left as they are
+	current, err := getVolumeDocument(service, state.ID.ValueString())
+	if err != nil {
+		diags.AddError("Error when retrieving the current volume settings", err.Error())
+		return diags
+	}
+	payload, err := volumeSettingsPatch(current, desired)
+	if err != nil {
+		diags.AddError("Error when computing the volume settings to update", err.Error())
+		return diags
+	}
+	if len(payload) > 0 {
+		settingsApplyTime := map[string]interface{}{
 			"ApplyTime": applyTime,
-		},
+		}
+		if isMaintenanceWindowApplyTime(applyTime) {
+			if d.MaintenanceWindow == nil {
+				diags.AddError("Error while checking support for settings_apply_time",
+					fmt.Sprintf("maintenance_window must be set when settings_apply_time is %s", applyTime))
+				return diags
+			}
+			for key, value := range maintenanceWindowPayload(d.MaintenanceWindow) {
+				settingsApplyTime[key] = value
+			}
+		}
+		payload["@Redfish.SettingsApplyTime"] = settingsApplyTime
+		diags.Append(applyVolumeSettings(ctx, service, d, state, payload, jobCheckInterval, volumeJobTimeout)...)
+		if diags.HasError() {
+			return diags
+		}
 	}
 
+	// The volume keeps its @odata.id when it is renamed, so it is tracked from state rather than by name
+	d.ID = state.ID
+
+	if len(reconfigureDrives) > 0 {
+		diags.Append(reconfigureVolume(ctx, service, system, d, reconfigureDrives, migrateTo)...)
+	}
+	return diags
+}
+
+// applyVolumeSettings patches the volume settings and waits for the resulting job, resetting the server first
+// when the settings are applied on reset.
+func applyVolumeSettings(ctx context.Context, service *gofish.Service, d, state *models.RedfishStorageVolume,
+	payload map[string]interface{}, jobCheckInterval, volumeJobTimeout int64,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	applyTime := d.SettingsApplyTime.ValueString()
+
 	// Update volume job
-	jobID, err := updateVolume(service, state.ID.ValueString(), payload)
+	// The ETag read at refresh time guards against changes made since then by other tools
+	jobID, err := updateVolume(service, state.ID.ValueString(), payload, state.ETag.ValueString())
+	if errors.Is(err, errVolumeModifiedExternally) {
+		diags.AddError("Volume was modified externally", err.Error())
+		return diags
+	}
 	if err != nil {
 		diags.AddError("Error when updating the virtual disk on disk controller", err.Error())
 		return diags
 	}
 
+	// The settings are applied in the maintenance window, the next refreshes report them once applied
+	if isMaintenanceWindowApplyTime(applyTime) {
+		diags.AddWarning(volumeJobScheduledMsg,
+			fmt.Sprintf("The job %s updating the volume starts at %s.", jobID, d.MaintenanceWindow.StartTime.ValueString()))
+		return diags
+	}
+
 	// Immediate or OnReset scenarios
 	if applyTime == string(redfishcommon.OnResetApplyTime) { // OnReset case
 		resetType := d.ResetType.ValueString()
 		resetTimeout := d.ResetTimeout.ValueInt64()
 
+		// The update job is registered as a pending configuration job like the create and delete ones,
+		// so it gets the same delay, otherwise the reboot may start before the job is picked up
+		if err := waitPreRebootDelay(ctx, d); err != nil {
+			diags.AddError(RedfishJobErrorMsg, err.Error())
+			return diags
+		}
+
 		// Reboot the server
 		pOp := powerOperator{ctx, service, d.SystemID.ValueString()}
-		_, err := pOp.PowerOperation(resetType, resetTimeout, intervalStorageVolumeJobCheckTime)
+		_, err := pOp.PowerOperation(resetType, resetTimeout, jobCheckInterval)
 		if err != nil {
 			diags.AddError(RedfishJobErrorMsg, err.Error())
 			return diags
@@ -728,39 +1892,73 @@ func updateRedfishStorageVolume(ctx context.Context, service *gofish.Service,
 	}
 
 	// Wait for the job to finish
-	err = common.WaitForTaskToFinish(service, jobID, intervalStorageVolumeJobCheckTime, volumeJobTimeout)
+	err = common.WaitForTaskToFinishWithBackoff(ctx, service, jobID, jobCheckInterval, getJobCheckMaxInterval(d), volumeJobTimeout)
 	if err != nil {
 		diags.AddError(RedfishJobErrorMsg, err.Error())
-		return diags
-	}
-	time.Sleep(60 * time.Second)
-
-	// Get storage volumes
-	volumes, err := storage.Volumes()
-	if err != nil {
-		diags.AddError("Issue when retrieving volumes", err.Error())
-		return diags
 	}
-	volumeID, err := getVolumeID(volumes, volumeName)
-	if err != nil {
-		diags.AddError("The volume ID with given volume name was not found", err.Error())
-		return diags
-	}
-
-	d.ID = types.StringValue(volumeID)
 	return diags
 }
 
 func deleteRedfishStorageVolume(ctx context.Context, service *gofish.Service, d *models.RedfishStorageVolume) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	// Lock the mutex to avoid race conditions with other resources
-	redfishMutexKV.Lock(d.RedfishServer[0].Endpoint.ValueString())
-	defer redfishMutexKV.Unlock(d.RedfishServer[0].Endpoint.ValueString())
+	// Lock the controller to avoid race conditions with other resources
+	defer lockStorageController(d.RedfishServer[0].Endpoint.ValueString(), d.StorageControllerID.ValueString(),
+		d.SettingsApplyTime.ValueString() == string(redfishcommon.OnResetApplyTime))()
 
 	// Get vars from schema
 	applyTime := d.SettingsApplyTime.ValueString()
 	volumeJobTimeout := d.VolumeJobTimeout.ValueInt64()
+	jobCheckInterval := getJobCheckInterval(d)
+
+	sanitizationType := redfish.DataSanitizationType("")
+	if d.CryptoEraseOnDestroy.ValueBool() {
+		sanitizationType = redfish.CryptographicEraseDataSanitizationType
+	}
+
+	if err := waitForLifecycleControllerReady(ctx, service, jobCheckInterval, getLCReadyTimeout(d)); err != nil {
+		diags.AddError(lifecycleControllerNotReadyMsg, err.Error())
+		return diags
+	}
+
+	// The volume was deleted by a previous destroy whose erase failed, only the erase is left
+	if len(d.PendingEraseDriveIDs.Elements()) > 0 {
+		var eraseDriveIDs []string
+		diags.Append(d.PendingEraseDriveIDs.ElementsAs(ctx, &eraseDriveIDs, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		eraseDrives := make([]*redfish.Drive, 0, len(eraseDriveIDs))
+		for _, driveID := range eraseDriveIDs {
+			drive, err := redfish.GetDrive(service.GetClient(), driveID)
+			if err != nil {
+				diags.AddError("Error when retrieving the drives to secure erase", err.Error())
+				return diags
+			}
+			eraseDrives = append(eraseDrives, drive)
+		}
+		diags.Append(eraseVolumeDrives(ctx, service, d, eraseDrives, sanitizationType)...)
+		return diags
+	}
+
+	// The member drives are gathered before the volume is gone
+	var eraseDrives []*redfish.Drive
+	if d.SecureEraseOnDestroy.ValueBool() || d.CryptoEraseOnDestroy.ValueBool() {
+		var err error
+		eraseDrives, err = getVolumeMemberDrives(service, d.ID.ValueString())
+		if err != nil {
+			diags.AddError("Error when retrieving the drives to secure erase", err.Error())
+			return diags
+		}
+		check := checkDrivesSecureErase
+		if d.CryptoEraseOnDestroy.ValueBool() {
+			check = checkDrivesCryptographicErase
+		}
+		if err = check(eraseDrives); err != nil {
+			diags.AddError("Error when secure erasing the volume drives", err.Error())
+			return diags
+		}
+	}
 
 	jobID, err := deleteVolume(service, d.ID.ValueString())
 	if err != nil {
@@ -773,12 +1971,15 @@ func deleteRedfishStorageVolume(ctx context.Context, service *gofish.Service, d
 		resetType := d.ResetType.ValueString()
 		resetTimeout := d.ResetTimeout.ValueInt64()
 
-		// sleep here to aviod the reboot action has impact on the volume delete job
-		time.Sleep(30 * time.Second)
+		// Let the delete job register before the reboot, otherwise the reboot may cancel it
+		if err := waitPreRebootDelay(ctx, d); err != nil {
+			diags.AddError(RedfishJobErrorMsg, err.Error())
+			return diags
+		}
 
 		// Reboot the server
 		pOp := powerOperator{ctx, service, d.SystemID.ValueString()}
-		_, err := pOp.PowerOperation(resetType, resetTimeout, intervalStorageVolumeJobCheckTime)
+		_, err := pOp.PowerOperation(resetType, resetTimeout, jobCheckInterval)
 		if err != nil {
 			diags.AddError(RedfishJobErrorMsg, err.Error())
 			return diags
@@ -786,15 +1987,84 @@ func deleteRedfishStorageVolume(ctx context.Context, service *gofish.Service, d
 	}
 
 	// WAIT FOR VOLUME TO DELETE
-	err = common.WaitForTaskToFinish(service, jobID, intervalStorageVolumeJobCheckTime, volumeJobTimeout)
+	err = common.WaitForTaskToFinishWithBackoff(ctx, service, jobID, jobCheckInterval, getJobCheckMaxInterval(d), volumeJobTimeout)
 	if err != nil {
-		diags.AddError("Timeout reached when waiting for job to finish", err.Error())
+		diags.AddError(RedfishJobErrorMsg, err.Error())
 		return diags
 	}
 
+	diags.Append(eraseVolumeDrives(ctx, service, d, eraseDrives, sanitizationType)...)
 	return diags
 }
 
+// eraseVolumeDrives erases the drives one after the other. On failure, the drives left to erase are recorded
+// in pending_erase_drive_ids, so that the next destroy retries the erase instead of leaving the data on disk.
+func eraseVolumeDrives(ctx context.Context, service *gofish.Service, d *models.RedfishStorageVolume,
+	drives []*redfish.Drive, sanitizationType redfish.DataSanitizationType,
+) (diags diag.Diagnostics) {
+	for i, drive := range drives {
+		jobID, err := secureEraseDrive(service, drive, sanitizationType)
+		if err == nil {
+			err = common.WaitForTaskToFinishWithBackoff(ctx, service, jobID, getJobCheckInterval(d), getJobCheckMaxInterval(d),
+				d.VolumeJobTimeout.ValueInt64())
+		}
+		if err != nil {
+			pending := []attr.Value{}
+			for _, left := range drives[i:] {
+				pending = append(pending, types.StringValue(left.ODataID))
+			}
+			d.PendingEraseDriveIDs = types.ListValueMust(types.StringType, pending)
+			diags.AddError("Error when secure erasing the volume drives",
+				fmt.Sprintf("the volume is deleted but drive %s was not erased, the erase is retried on the next destroy: %s",
+					drive.ID, err.Error()))
+			return diags
+		}
+	}
+	d.PendingEraseDriveIDs = types.ListValueMust(types.StringType, []attr.Value{})
+	return diags
+}
+
+// requiresRaidLevelReplace replaces the volume when its RAID level changes and can't be migrated online.
+// An unset raid_type is planned from the deprecated volume_type, like ModifyPlan does.
+func requiresRaidLevelReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	planned := req.PlanValue.ValueString()
+	if req.ConfigValue.IsNull() {
+		var volumeType types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("volume_type"), &volumeType)...)
+		if mapped, ok := volumeTypeMap[volumeType.ValueString()]; ok {
+			planned = mapped
+		}
+	}
+	from := req.StateValue.ValueString()
+	resp.RequiresReplace = planned != from && !isRaidLevelMigration(from, planned)
+}
+
+// volumeTypeDeprecationWarning returns a warning describing which raid_type is applied when the deprecated volume_type is set.
+func volumeTypeDeprecationWarning(volumeType, raidType types.String) (diags diag.Diagnostics) {
+	if volumeType.IsNull() || volumeType.IsUnknown() || volumeType.ValueString() == "" {
+		return diags
+	}
+	// ValidateConfig rejects a raid_type that differs from the one of volume_type
+	mapped := volumeTypeMap[volumeType.ValueString()]
+	diags.AddWarning("volume_type is deprecated",
+		fmt.Sprintf("volume_type %q is translated to raid_type %q. Please set raid_type = %q and remove volume_type.",
+			volumeType.ValueString(), mapped, mapped))
+	return diags
+}
+
+// checkVolumeTypeConsistency fails when both the deprecated volume_type and raid_type are configured with distinct RAID levels.
+func checkVolumeTypeConsistency(volumeType, raidType types.String) error {
+	if volumeType.IsNull() || volumeType.IsUnknown() || raidType.IsNull() || raidType.IsUnknown() {
+		return nil
+	}
+	mapped, ok := volumeTypeMap[volumeType.ValueString()]
+	if !ok || mapped == raidType.ValueString() {
+		return nil
+	}
+	return fmt.Errorf("volume_type %q is raid_type %q, which conflicts with raid_type %q. Please remove the deprecated volume_type",
+		volumeType.ValueString(), mapped, raidType.ValueString())
+}
+
 func getStorageController(storageControllers []*redfish.Storage, diskControllerID string) (*redfish.Storage, error) {
 	for _, storage := range storageControllers {
 		if storage.Entity.ID == diskControllerID {
@@ -821,21 +2091,129 @@ func deleteVolume(service *gofish.Service, volumeURI string) (jobID string, err
 	return jobID, nil
 }
 
-func getDrives(drives []*redfish.Drive, driveNames []string) ([]*redfish.Drive, error) {
+// getDrives resolves the requested drive names and IDs (or odata IDs) against the controller drives.
+func getDrives(drives []*redfish.Drive, driveNames []string, driveIDs []string) ([]*redfish.Drive, error) {
+	byName := make(map[string]*redfish.Drive, len(drives))
+	byID := make(map[string]*redfish.Drive, 2*len(drives))
+	for _, drive := range drives {
+		byName[drive.Name] = drive
+		byID[drive.ID] = drive
+		if drive.ODataID != "" {
+			byID[drive.ODataID] = drive
+		}
+	}
+
 	drivesToReturn := []*redfish.Drive{}
-	for _, v := range drives {
-		for _, w := range driveNames {
-			if v.Name == w {
-				drivesToReturn = append(drivesToReturn, v)
+	added := make(map[*redfish.Drive]bool, len(driveNames)+len(driveIDs))
+	missing := []string{}
+	resolve := func(index map[string]*redfish.Drive, keys []string) {
+		for _, key := range keys {
+			drive, ok := index[key]
+			if !ok {
+				missing = append(missing, key)
+				continue
+			}
+			if !added[drive] {
+				added[drive] = true
+				drivesToReturn = append(drivesToReturn, drive)
 			}
 		}
 	}
-	if len(driveNames) != len(drivesToReturn) {
-		return nil, fmt.Errorf("any of the drives you inserted doesn't exist")
+	resolve(byName, driveNames)
+	resolve(byID, driveIDs)
+
+	if len(missing) > 0 {
+		available := make([]string, 0, len(drives))
+		for _, drive := range drives {
+			available = append(available, fmt.Sprintf("%s (%s)", drive.Name, drive.ID))
+		}
+		return nil, fmt.Errorf("the following drives were not found on the controller: %s. Available drives: %s",
+			strings.Join(missing, ", "), strings.Join(available, ", "))
 	}
 	return drivesToReturn, nil
 }
 
+// getDriveAsConfigured returns the name, Id or @odata.id of the drive, whichever form it was configured with.
+// Drives that are not configured, e.g. on import, use the @odata.id when the configured drives do, fallback otherwise.
+func getDriveAsConfigured(drive *redfish.Drive, configured types.List, fallback string) string {
+	odataIDs := false
+	for _, value := range configured.Elements() {
+		v, ok := value.(types.String)
+		if !ok {
+			continue
+		}
+		if v.ValueString() == drive.Name || v.ValueString() == drive.ID || v.ValueString() == drive.ODataID {
+			return v.ValueString()
+		}
+		odataIDs = odataIDs || strings.HasPrefix(v.ValueString(), "/redfish/")
+	}
+	if odataIDs {
+		return drive.ODataID
+	}
+	return fallback
+}
+
+// checkProtectionInformationSupport checks that the controller and all the member drives are T10 PI capable.
+func checkProtectionInformationSupport(storage *redfish.Storage, drives []*redfish.Drive) error {
+	storageExtended, err := dell.Storage(storage)
+	if err != nil {
+		return fmt.Errorf("couldn't retrieve the controller details: %w", err)
+	}
+	if capability := storageExtended.OemData.DellController.T10PICapability; capability != t10PICapable {
+		return fmt.Errorf("storage controller %s is not T10 PI capable (T10PICapability: %s)", storage.ID, capability)
+	}
+
+	for _, drive := range drives {
+		driveExtended, err := dell.Drive(drive)
+		if err != nil {
+			return fmt.Errorf("couldn't retrieve the details of drive %s: %w", drive.Name, err)
+		}
+		if capability := driveExtended.Oem.Dell.DellPhysicalDisk.T10PICapability; capability != t10PICapable {
+			return fmt.Errorf("drive %s is not formatted with T10 PI (T10PICapability: %s)", drive.Name, capability)
+		}
+	}
+	return nil
+}
+
+// hbaControllerMode is the Dell controller mode in which the drives are passed through and no volume can be created
+const hbaControllerMode = "HBA"
+
+// checkControllerRaidMode returns an error when the controller reports being in HBA mode.
+func checkControllerRaidMode(storage *redfish.Storage) error {
+	storageExtended, err := dell.Storage(storage)
+	if err != nil {
+		return fmt.Errorf("couldn't retrieve the controller details: %w", err)
+	}
+	if mode := storageExtended.OemData.DellController.CurrentControllerMode; mode == hbaControllerMode {
+		return fmt.Errorf("storage controller %s is in %s mode, volumes can only be created in RAID mode."+
+			" Set `controller_mode` to `RAID` with the redfish_storage_controller resource first", storage.ID, mode)
+	}
+	return nil
+}
+
+// isMaintenanceWindowApplyTime reports whether the apply time schedules the volume jobs in the maintenance window.
+func isMaintenanceWindowApplyTime(applyTime string) bool {
+	return applyTime == string(redfishcommon.AtMaintenanceWindowStartApplyTime) ||
+		applyTime == string(redfishcommon.InMaintenanceWindowOnResetApplyTime)
+}
+
+// maintenanceWindowPayload returns the maintenance window properties of the apply time annotations.
+func maintenanceWindowPayload(window *models.MaintenanceWindow) map[string]interface{} {
+	return map[string]interface{}{
+		"MaintenanceWindowStartTime":         window.StartTime.ValueString(),
+		"MaintenanceWindowDurationInSeconds": window.Duration.ValueInt64(),
+	}
+}
+
+// isTaskDone reports whether the task reached a final state.
+func isTaskDone(task *redfish.Task) bool {
+	switch task.TaskState {
+	case redfish.CompletedTaskState, redfish.ExceptionTaskState, redfish.KilledTaskState, redfish.CancelledTaskState:
+		return true
+	}
+	return false
+}
+
 func checkSettingsApplyTime(storage *redfish.Storage, applyTime string) error {
 	operationApplyTimes, err := storage.GetOperationApplyTimeValues()
 	if err != nil {
@@ -857,6 +2235,9 @@ func getStorage(service *gofish.Service, sysID string, storageID string) (*redfi
 	if err != nil {
 		return nil, system, fmt.Errorf("error when retreiving the Storage from the Redfish API: %w", err)
 	}
+	if len(storageControllers) == 0 {
+		return nil, system, fmt.Errorf("%w: system %s has no storage controller on which %s could be found", errNoStorageSubsystem, system.ID, storageID)
+	}
 
 	storage, err := getStorageController(storageControllers, storageID)
 	if err != nil {
@@ -868,40 +2249,53 @@ func getStorage(service *gofish.Service, sysID string, storageID string) (*redfi
 /*
 createVolume creates a virtualdisk on a disk controller by using the redfish API
 */
+// createVolume returns the job creating the volume, or the volume itself when the service
+// created it synchronously and answered 201 Created.
 func createVolume(service *gofish.Service,
 	storageLink string,
 	newVolume map[string]interface{},
-) (jobID string, err error) {
+) (jobID string, volumeID string, err error) {
 	volumesURL := fmt.Sprintf("%v/Volumes", storageLink)
 
 	res, err := service.GetClient().Post(volumesURL, newVolume)
 	if err != nil {
-		return "", err
+		return "", "", volumeRequestError(err)
 	}
 	defer res.Body.Close()
-	if res.StatusCode != http.StatusAccepted {
-		return "", fmt.Errorf("the query was unsucessfull")
-	}
-	jobID = res.Header.Get("Location")
-	if len(jobID) == 0 {
-		return "", fmt.Errorf("there was some error when retreiving the jobID")
+	location := res.Header.Get("Location")
+	switch res.StatusCode {
+	case http.StatusAccepted:
+		if len(location) == 0 {
+			return "", "", fmt.Errorf("there was some error when retreiving the jobID")
+		}
+		return location, "", nil
+	case http.StatusCreated:
+		if len(location) == 0 {
+			return "", "", fmt.Errorf("there was some error when retreiving the volume ID")
+		}
+		return "", location, nil
 	}
-	return jobID, nil
+	return "", "", volumeResponseError(res)
 }
 
 func updateVolume(service *gofish.Service,
 	storageLink string,
 	payload map[string]interface{},
+	etag string,
 ) (jobID string, err error) {
 	volumesURL := fmt.Sprintf("%v/Settings", storageLink)
 
-	res, err := service.GetClient().Patch(volumesURL, payload)
+	headers := map[string]string{}
+	if etag != "" {
+		headers["If-Match"] = etag
+	}
+	res, err := service.GetClient().PatchWithHeaders(volumesURL, payload, headers)
 	if err != nil {
-		return "", err
+		return "", volumeUpdateError(err)
 	}
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusAccepted {
-		return "", fmt.Errorf("the query was unsucessfull")
+		return "", volumeResponseError(res)
 	}
 	jobID = res.Header.Get("Location")
 	if len(jobID) == 0 {
@@ -910,14 +2304,66 @@ func updateVolume(service *gofish.Service,
 	return jobID, nil
 }
 
+// getVolumeStorage returns the storage which the given volume belongs to.
+func getVolumeStorage(service *gofish.Service, volumeURI string) (*redfish.Storage, error) {
+	index := strings.Index(volumeURI, "/Volumes/")
+	if index < 0 {
+		return nil, fmt.Errorf("couldn't resolve the storage of volume %s", volumeURI)
+	}
+	return redfish.GetStorage(service.GetClient(), volumeURI[:index])
+}
+
+// getControllerCacheSizeMiB returns the total cache size of the first controller of the storage.
+func getControllerCacheSizeMiB(storage *redfish.Storage) int64 {
+	if len(storage.StorageControllers) > 0 {
+		return int64(storage.StorageControllers[0].CacheSummary.TotalCacheSizeMiB)
+	}
+	controllers, err := storage.Controllers()
+	if err != nil || len(controllers) == 0 {
+		return 0
+	}
+	return int64(controllers[0].CacheSummary.TotalCacheSizeMiB)
+}
+
 func getVolumeID(volumes []*redfish.Volume, volumeName string) (volumeLink string, err error) {
+	volume, err := getVolumeByName(volumes, volumeName)
+	if err != nil {
+		return "", err
+	}
+	return volume.ODataID, nil
+}
+
+// getVolumeByName returns the volume with the given name.
+func getVolumeByName(volumes []*redfish.Volume, volumeName string) (*redfish.Volume, error) {
 	for _, v := range volumes {
 		if v.Name == volumeName {
-			volumeLink = v.ODataID
-			return volumeLink, nil
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("couldn't find a volume with the provided name: %s", volumeName)
+}
+
+// waitForVolume polls the storage volumes every interval seconds until the one with the given name
+// is listed, timeout seconds have elapsed or the context is done.
+func waitForVolume(ctx context.Context, storage *redfish.Storage, volumeName string, interval int64, timeout int64) (*redfish.Volume, error) {
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	for {
+		volumes, err := storage.Volumes()
+		if err == nil {
+			var volume *redfish.Volume
+			if volume, err = getVolumeByName(volumes, volumeName); err == nil {
+				return volume, nil
+			}
+		}
+		if time.Now().Add(time.Duration(interval) * time.Second).After(deadline) {
+			return nil, err
+		}
+		select {
+		case <-time.After(time.Duration(interval) * time.Second):
+		case <-ctx.Done():
+			return nil, ctx.Err()
 		}
 	}
-	return "", fmt.Errorf("couldn't find a volume with the provided name: %s", volumeName)
 }
 
 func checkOperationApplyTimes(optionToCheck string, storageOperationApplyTimes []redfishcommon.OperationApplyTime) (result bool) {
@@ -928,3 +2374,46 @@ func checkOperationApplyTimes(optionToCheck string, storageOperationApplyTimes [
 	}
 	return false
 }
+
+// waitPreRebootDelay waits pre_reboot_delay seconds, falling back to the default for states written
+// before the attribute existed, or until the context is done.
+func waitPreRebootDelay(ctx context.Context, d *models.RedfishStorageVolume) error {
+	delay := defaultStorageVolumePreRebootDelay
+	if !d.PreRebootDelay.IsNull() && !d.PreRebootDelay.IsUnknown() {
+		delay = d.PreRebootDelay.ValueInt64()
+	}
+	select {
+	case <-time.After(time.Duration(delay) * time.Second):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// getLCReadyTimeout returns the configured lc_ready_timeout, falling back to the default for states written
+// before the attribute existed.
+func getLCReadyTimeout(d *models.RedfishStorageVolume) int64 {
+	if d.LCReadyTimeout.IsNull() || d.LCReadyTimeout.IsUnknown() {
+		return defaultStorageVolumeLCReadyTimeout
+	}
+	return d.LCReadyTimeout.ValueInt64()
+}
+
+// getJobCheckMaxInterval returns the configured job_check_max_interval. The interval between two status checks
+// only grows when it is set above job_check_interval.
+func getJobCheckMaxInterval(d *models.RedfishStorageVolume) int64 {
+	interval := getJobCheckInterval(d)
+	if d.JobCheckMaxInterval.IsNull() || d.JobCheckMaxInterval.IsUnknown() || d.JobCheckMaxInterval.ValueInt64() < interval {
+		return interval
+	}
+	return d.JobCheckMaxInterval.ValueInt64()
+}
+
+// getJobCheckInterval returns the configured job_check_interval, falling back to the default
+// for states written before the attribute existed.
+func getJobCheckInterval(d *models.RedfishStorageVolume) int64 {
+	if d.JobCheckInterval.IsNull() || d.JobCheckInterval.IsUnknown() || d.JobCheckInterval.ValueInt64() < 1 {
+		return defaultStorageVolumeJobCheckInterval
+	}
+	return d.JobCheckInterval.ValueInt64()
+}
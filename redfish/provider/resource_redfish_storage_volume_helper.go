@@ -0,0 +1,1372 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-redfish/common"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// errVolumeModifiedExternally is returned when the volume changed since it was last read.
+var errVolumeModifiedExternally = errors.New("volume was modified externally, refresh and retry")
+
+// readCachePolicyAliases maps the read cache policy names reported by the different firmware
+// to the canonical values accepted by the schema. Keys are compared in their folded form.
+var readCachePolicyAliases = map[string]string{
+	"off":               string(redfish.OffReadCachePolicyType),
+	"noreadahead":       string(redfish.OffReadCachePolicyType),
+	"readahead":         string(redfish.ReadAheadReadCachePolicyType),
+	"adaptivereadahead": string(redfish.AdaptiveReadAheadReadCachePolicyType),
+	"adaptive":          string(redfish.AdaptiveReadAheadReadCachePolicyType),
+}
+
+// writeCachePolicyAliases maps the write cache policy names reported by the different firmware
+// to the canonical values accepted by the schema. Keys are compared in their folded form.
+var writeCachePolicyAliases = map[string]string{
+	"writethrough":         string(redfish.WriteThroughWriteCachePolicyType),
+	"unprotectedwriteback": string(redfish.UnprotectedWriteBackWriteCachePolicyType),
+	"writeback":            string(redfish.UnprotectedWriteBackWriteCachePolicyType),
+	"protectedwriteback":   string(redfish.ProtectedWriteBackWriteCachePolicyType),
+	"forcewriteback":       string(redfish.ProtectedWriteBackWriteCachePolicyType),
+	"writebackforce":       string(redfish.ProtectedWriteBackWriteCachePolicyType),
+}
+
+// legacyReadCachePolicyNames maps the canonical read cache policies to the names expected by older firmware
+var legacyReadCachePolicyNames = map[string]string{
+	string(redfish.OffReadCachePolicyType):               "NoReadAhead",
+	string(redfish.ReadAheadReadCachePolicyType):         "ReadAhead",
+	string(redfish.AdaptiveReadAheadReadCachePolicyType): "AdaptiveReadAhead",
+}
+
+// legacyWriteCachePolicyNames maps the canonical write cache policies to the names expected by older firmware
+var legacyWriteCachePolicyNames = map[string]string{
+	string(redfish.WriteThroughWriteCachePolicyType):         "WriteThrough",
+	string(redfish.UnprotectedWriteBackWriteCachePolicyType): "WriteBack",
+	string(redfish.ProtectedWriteBackWriteCachePolicyType):   "ForceWriteBack",
+}
+
+// foldCachePolicy lower cases the policy and strips the separators some firmware put in between words
+func foldCachePolicy(policy string) string {
+	return strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(policy))
+}
+
+// normalizeCachePolicy returns the canonical schema value for a cache policy reported by the firmware.
+// Unknown values are returned as reported.
+func normalizeCachePolicy(reported string, aliases map[string]string) string {
+	if canonical, ok := aliases[foldCachePolicy(reported)]; ok {
+		return canonical
+	}
+	return reported
+}
+
+// normalizeReadCachePolicy returns the canonical schema value for a read cache policy reported by the firmware
+func normalizeReadCachePolicy(reported string) string {
+	return normalizeCachePolicy(reported, readCachePolicyAliases)
+}
+
+// normalizeWriteCachePolicy returns the canonical schema value for a write cache policy reported by the firmware
+func normalizeWriteCachePolicy(reported string) string {
+	return normalizeCachePolicy(reported, writeCachePolicyAliases)
+}
+
+// usesLegacyCachePolicyNames reports whether the given volumes expose cache policies
+// with names other than the canonical ones, meaning the firmware expects the legacy names.
+func usesLegacyCachePolicyNames(volumes []*redfish.Volume) bool {
+	for _, volume := range volumes {
+		readCachePolicy := string(volume.ReadCachePolicy)
+		if _, ok := legacyReadCachePolicyNames[readCachePolicy]; readCachePolicy != "" && !ok {
+			return true
+		}
+		writeCachePolicy := string(volume.WriteCachePolicy)
+		if _, ok := legacyWriteCachePolicyNames[writeCachePolicy]; writeCachePolicy != "" && !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// firmwareCachePolicy maps a canonical cache policy to the name expected by the firmware
+func firmwareCachePolicy(canonical string, legacy bool, legacyNames map[string]string) string {
+	if !legacy {
+		return canonical
+	}
+	if name, ok := legacyNames[canonical]; ok {
+		return name
+	}
+	return canonical
+}
+
+// checkCacheDrives fails when one of the drives of a cache volume isn't an SSD.
+func checkCacheDrives(drives []*redfish.Drive) error {
+	var others []string
+	for _, drive := range drives {
+		if drive.MediaType != redfish.SSDMediaType {
+			others = append(others, fmt.Sprintf("%s (%s)", drive.Name, drive.MediaType))
+		}
+	}
+	if len(others) > 0 {
+		return fmt.Errorf("a cache volume only accepts SSDs, these drives aren't: %s", strings.Join(others, ", "))
+	}
+	return nil
+}
+
+// checkMixedDrives fails when the drives don't share the same media type and protocol, which most
+// controllers reject once the volume job runs. Drives not reporting them are ignored.
+func checkMixedDrives(drives []*redfish.Drive) error {
+	mediaTypes := map[string][]string{}
+	protocols := map[string][]string{}
+	for _, drive := range drives {
+		if drive.MediaType != "" {
+			mediaTypes[string(drive.MediaType)] = append(mediaTypes[string(drive.MediaType)], drive.Name)
+		}
+		if drive.Protocol != "" {
+			protocols[string(drive.Protocol)] = append(protocols[string(drive.Protocol)], drive.Name)
+		}
+	}
+
+	var mixed []string
+	if len(mediaTypes) > 1 {
+		mixed = append(mixed, "media types "+describeDriveGroups(mediaTypes))
+	}
+	if len(protocols) > 1 {
+		mixed = append(mixed, "protocols "+describeDriveGroups(protocols))
+	}
+	if len(mixed) > 0 {
+		return fmt.Errorf("the drives have distinct %s, set allow_mixed_drives to create the volume anyway",
+			strings.Join(mixed, " and "))
+	}
+	return nil
+}
+
+// describeDriveGroups lists the drives of every group, sorted by group, e.g. "HDD (Disk 0), SSD (Disk 1)".
+func describeDriveGroups(groups map[string][]string) string {
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	descriptions := make([]string, 0, len(keys))
+	for _, key := range keys {
+		descriptions = append(descriptions, fmt.Sprintf("%s (%s)", key, strings.Join(groups[key], ", ")))
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// validateDriveCountForRaid checks that the number of drives is valid for the RAID level,
+// so that an invalid layout is reported before the controller job fails.
+func validateDriveCountForRaid(raidType string, n int) error {
+	switch raidType {
+	case "RAID0":
+		if n < 1 {
+			return fmt.Errorf("%s requires at least 1 drive, got %d", raidType, n)
+		}
+	case "RAID1":
+		if n != 2 {
+			return fmt.Errorf("%s requires exactly 2 drives, got %d", raidType, n)
+		}
+	case "RAID5":
+		if n < 3 {
+			return fmt.Errorf("%s requires at least 3 drives, got %d", raidType, n)
+		}
+	case "RAID6":
+		if n < 4 {
+			return fmt.Errorf("%s requires at least 4 drives, got %d", raidType, n)
+		}
+	case "RAID10":
+		if n < 4 || n%2 != 0 {
+			return fmt.Errorf("%s requires an even number of at least 4 drives, got %d", raidType, n)
+		}
+	case "RAID50":
+		if n < 6 {
+			return fmt.Errorf("%s requires at least 6 drives, got %d", raidType, n)
+		}
+	case "RAID60":
+		if n < 8 {
+			return fmt.Errorf("%s requires at least 8 drives, got %d", raidType, n)
+		}
+	}
+	return nil
+}
+
+// spannedRaidTypes are the nested RAID levels whose drives are laid out in spans
+var spannedRaidTypes = map[string]bool{
+	"RAID10": true,
+	"RAID50": true,
+	"RAID60": true,
+}
+
+// validateSpans checks the span layout of the volume. A zero spanCount means the controller picks the layout.
+func validateSpans(raidType string, spanCount, spanLength int64, n int) error {
+	if spanCount == 0 {
+		return nil
+	}
+	if !spannedRaidTypes[raidType] {
+		return fmt.Errorf("%s does not support spans, span_count and span_length are only valid for RAID10, RAID50 and RAID60", raidType)
+	}
+	if spanCount*spanLength != int64(n) {
+		return fmt.Errorf("span_count * span_length must be the number of drives: %d * %d != %d", spanCount, spanLength, n)
+	}
+	return nil
+}
+
+// isBOSSController reports whether the storage is a Dell BOSS controller, whose volumes always span the whole drives.
+func isBOSSController(storage *redfish.Storage) bool {
+	names := []string{storage.ID, storage.Name}
+	for _, controller := range storage.StorageControllers {
+		names = append(names, controller.Name, controller.Model)
+	}
+	for _, name := range names {
+		if strings.Contains(strings.ToUpper(name), "BOSS") {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultSpanCount is the number of spans the controllers use for RAID50 and RAID60 when it is not set
+const defaultSpanCount int64 = 2
+
+// setVolumeEncryptionAndCapacity sets the encryption and the capacity of the create payload of a volume.
+// Unset or 0 requests the full capacity, which is left to the controller since it reserves some for its
+// metadata. BOSS controllers don't accept a capacity.
+func setVolumeEncryptionAndCapacity(newVolume map[string]interface{}, encrypted bool, encryptionTypes []string, capacityBytes int, boss bool) {
+	newVolume["Encrypted"] = encrypted
+	if encrypted {
+		newVolume["EncryptionTypes"] = encryptionTypes
+	}
+	if capacityBytes > 0 && !boss {
+		newVolume["CapacityBytes"] = capacityBytes
+	}
+}
+
+// usableCapacityBytes returns the capacity available to a volume of the RAID level built on the drives.
+// Every drive contributes the capacity of the smallest one, minus the drives used for redundancy.
+func usableCapacityBytes(raidType string, drives []*redfish.Drive, spanCount int64) int64 {
+	n := int64(len(drives))
+	if n == 0 {
+		return 0
+	}
+	smallest := drives[0].CapacityBytes
+	for _, drive := range drives[1:] {
+		if drive.CapacityBytes < smallest {
+			smallest = drive.CapacityBytes
+		}
+	}
+
+	dataDrives := n
+	switch raidType {
+	case "RAID1", "RAID10":
+		dataDrives = n / 2
+	case "RAID5", "RAID6":
+		dataDrives = n - raidRedundantDriveCount[raidType]
+	case "RAID50", "RAID60":
+		if spanCount == 0 {
+			spanCount = defaultSpanCount
+		}
+		dataDrives = n - spanCount*raidRedundantDriveCount[raidType]
+	}
+	if dataDrives < 0 {
+		return 0
+	}
+	return dataDrives * smallest
+}
+
+// initializationMethods maps the initialization attribute to the Redfish InitializeMethod of the new volume
+var initializationMethods = map[string]redfish.InitializeMethod{
+	initializationNone: redfish.SkipInitializeMethod,
+	initializationFast: redfish.BackgroundInitializeMethod,
+	initializationFull: redfish.ForegroundInitializeMethod,
+}
+
+// eraseNotSupported is the Dell SystemEraseCapability of drives that can't be erased
+const eraseNotSupported = "NotSupported"
+
+// getVolumeMemberDrives returns the drives the volume is built on.
+func getVolumeMemberDrives(service *gofish.Service, volumeURI string) ([]*redfish.Drive, error) {
+	volume, err := redfish.GetVolume(service.GetClient(), volumeURI)
+	if err != nil {
+		return nil, fmt.Errorf("error when retrieving the volume %s: %w", volumeURI, err)
+	}
+	drives, err := volume.Drives()
+	if err != nil {
+		return nil, fmt.Errorf("error when retrieving the drives of volume %s: %w", volumeURI, err)
+	}
+	return drives, nil
+}
+
+// checkDrivesSecureErase returns an error listing the drives whose controller reports no erase capability.
+func checkDrivesSecureErase(drives []*redfish.Drive) error {
+	unsupported := []string{}
+	for _, drive := range drives {
+		dellDrive, err := dell.Drive(drive)
+		if err != nil {
+			return err
+		}
+		if dellDrive.Oem.Dell.DellPhysicalDisk.SystemEraseCapability == eraseNotSupported {
+			unsupported = append(unsupported, drive.ID)
+		}
+	}
+	if len(unsupported) > 0 {
+		return fmt.Errorf("secure erase is not supported on drives: %s", strings.Join(unsupported, ", "))
+	}
+	return nil
+}
+
+// checkDrivesCryptographicErase returns an error listing the drives that are not self-encrypting drives.
+func checkDrivesCryptographicErase(drives []*redfish.Drive) error {
+	unsupported := []string{}
+	for _, drive := range drives {
+		if drive.EncryptionAbility == "" || drive.EncryptionAbility == redfish.NoneEncryptionAbility {
+			unsupported = append(unsupported, drive.ID)
+		}
+	}
+	if len(unsupported) > 0 {
+		return fmt.Errorf("cryptographic erase requires self-encrypting drives, these drives are not: %s",
+			strings.Join(unsupported, ", "))
+	}
+	return nil
+}
+
+// secureEraseDrive starts the secure erase of the drive and returns the URI of its job.
+// When sanitizationType is empty, the drive picks its default erase method.
+func secureEraseDrive(service *gofish.Service, drive *redfish.Drive, sanitizationType redfish.DataSanitizationType) (jobID string, err error) {
+	target := fmt.Sprintf("%s/Actions/Drive.SecureErase", drive.ODataID)
+	payload := map[string]interface{}{}
+	if sanitizationType != "" {
+		payload["SanitizationType"] = sanitizationType
+	}
+	res, err := service.GetClient().Post(target, payload)
+	if err != nil {
+		return "", fmt.Errorf("error while secure erasing the drive %s: %w", drive.ID, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusAccepted {
+		return "", fmt.Errorf("the secure erase of drive %s was not successful. Return code %d was different from 202 ACCEPTED",
+			drive.ID, res.StatusCode)
+	}
+	jobID = res.Header.Get("Location")
+	if len(jobID) == 0 {
+		return "", fmt.Errorf("there was some error when retreiving the jobID")
+	}
+	return jobID, nil
+}
+
+// setVolumeStatus copies the health, state and running operations of the volume into the model.
+func setVolumeStatus(d *models.RedfishStorageVolume, volume *redfish.Volume) {
+	d.Health = types.StringValue(string(volume.Status.Health))
+	d.State = types.StringValue(string(volume.Status.State))
+	operations := []string{}
+	for _, operation := range volume.Operations {
+		operations = append(operations, fmt.Sprintf("%s: %d%%", operation.OperationName, operation.PercentageComplete))
+	}
+	d.OperationProgress = types.StringValue(strings.Join(operations, ", "))
+	d.InitializationProgress = types.Int64Value(initializationProgress(volume))
+}
+
+// initializationProgress returns the completion percentage of the initialization listed in the operations
+// of the volume, 100 when it isn't initializing.
+func initializationProgress(volume *redfish.Volume) int64 {
+	for _, operation := range volume.Operations {
+		if strings.Contains(strings.ToLower(operation.OperationName), "initializ") {
+			return int64(operation.PercentageComplete)
+		}
+	}
+	return 100
+}
+
+// waitForVolumeInitialization waits for the volume to be initialized, at most timeout seconds. The volume
+// exists already, so not being initialized in time is only a warning, as is the context being done.
+func waitForVolumeInitialization(ctx context.Context, service *gofish.Service, volumeURI string, interval, timeout int64,
+) (*redfish.Volume, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	for {
+		volume, err := redfish.GetVolume(service.GetClient(), volumeURI)
+		if err == nil && initializationProgress(volume) == 100 {
+			return volume, diags
+		}
+		if time.Now().Add(time.Duration(interval) * time.Second).After(deadline) {
+			diags.AddWarning(volumeInitializationMsg,
+				fmt.Sprintf("The volume %s was not initialized after %d seconds, initialization_progress tells when it is.", volumeURI, timeout))
+			return volume, diags
+		}
+		select {
+		case <-time.After(time.Duration(interval) * time.Second):
+		case <-ctx.Done():
+			diags.AddWarning(volumeInitializationMsg,
+				fmt.Sprintf("Stopped waiting for the initialization of the volume %s: %s", volumeURI, ctx.Err()))
+			return nil, diags
+		}
+	}
+}
+
+// refreshVolumeStatus sets the status of the volume after it is created or updated.
+// The status is left empty while the volume is not available yet, e.g. when its job is still running.
+// The volume is only fetched when it isn't given, e.g. when it was already retrieved after its creation.
+func refreshVolumeStatus(service *gofish.Service, d *models.RedfishStorageVolume, volume *redfish.Volume) {
+	d.ETag = types.StringValue("")
+	if isVolumeJobPending(d) {
+		setVolumeStatus(d, &redfish.Volume{})
+		d.InitializationProgress = types.Int64Value(0)
+		// The capacity is read once the job attached on the next refresh has created the volume
+		if d.CapacityBytes.IsUnknown() {
+			d.CapacityBytes = types.Int64Value(0)
+		}
+		return
+	}
+	if volume == nil {
+		volume = &redfish.Volume{}
+		if v, err := redfish.GetVolume(service.GetClient(), d.ID.ValueString()); err == nil {
+			volume = v
+		}
+	}
+	d.ETag = types.StringValue(getVolumeETag(service, d.ID.ValueString()))
+	setVolumeStatus(d, volume)
+	// An unset capacity_bytes is sized by the controller
+	if d.CapacityBytes.IsUnknown() {
+		d.CapacityBytes = types.Int64Value(int64(volume.CapacityBytes))
+	}
+}
+
+// lockStorageController locks the storage controller of the server, so that operations on distinct
+// controllers run concurrently while the ones on the same controller are serialized. Operations resetting
+// the server take the server lock used by the other resources, the other ones hold it shared so that they
+// still wait for the resources resetting the server. The returned function releases the locks.
+func lockStorageController(endpoint, controllerID string, resetsServer bool) (unlock func()) {
+	controllerKey := endpoint + "/" + controllerID
+	if resetsServer {
+		redfishMutexKV.Lock(endpoint)
+	} else {
+		redfishMutexKV.RLock(endpoint)
+	}
+	redfishMutexKV.Lock(controllerKey)
+	return func() {
+		redfishMutexKV.Unlock(controllerKey)
+		if resetsServer {
+			redfishMutexKV.Unlock(endpoint)
+		} else {
+			redfishMutexKV.RUnlock(endpoint)
+		}
+	}
+}
+
+// volumeOemSettings are the volume settings only available through vendor extensions.
+type volumeOemSettings struct {
+	DiskCachePolicy string
+	T10PI           bool
+	SpanCount       int64
+	SpanLength      int64
+	Cache           bool
+}
+
+// volumePayloadBuilder builds the vendor specific parts of the volume payloads.
+type volumePayloadBuilder interface {
+	// oem returns the OEM block of the volume payload, nil when there is none.
+	oem(settings volumeOemSettings) (map[string]interface{}, error)
+	// setDrives sets the member drives of the volume in the create payload.
+	setDrives(payload map[string]interface{}, drives []map[string]string)
+}
+
+// newVolumePayloadBuilder returns the payload builder matching the vendor of the service.
+func newVolumePayloadBuilder(service *gofish.Service) (volumePayloadBuilder, error) {
+	if !isDellService(service) {
+		return genericVolumePayloadBuilder{}, nil
+	}
+	isGenerationSeventeenAndAbove, err := isServerGenerationSeventeenAndAbove(service)
+	if err != nil {
+		return nil, err
+	}
+	return dellVolumePayloadBuilder{seventeenGeneration: isGenerationSeventeenAndAbove}, nil
+}
+
+// isDellService reports whether the service root is the one of an iDRAC.
+func isDellService(service *gofish.Service) bool {
+	if strings.Contains(strings.ToLower(service.Vendor), "dell") {
+		return true
+	}
+	var oem map[string]json.RawMessage
+	if err := json.Unmarshal(service.Oem, &oem); err != nil {
+		return false
+	}
+	_, ok := oem["Dell"]
+	return ok
+}
+
+// lifecycleControllerReady is the status reported by the Dell LC service once it accepts new jobs.
+const lifecycleControllerReady = "Ready"
+
+// remoteServicesAPIStatus is the response of the DellLCService.GetRemoteServicesAPIStatus action.
+type remoteServicesAPIStatus struct {
+	LCStatus string
+	RTStatus string
+}
+
+// ready reports whether both the Lifecycle Controller and the real time services accept new jobs.
+func (s remoteServicesAPIStatus) ready() bool {
+	return s.LCStatus == lifecycleControllerReady && s.RTStatus == lifecycleControllerReady
+}
+
+// getRemoteServicesAPIStatus returns the Lifecycle Controller status reported by the Dell LC service.
+func getRemoteServicesAPIStatus(service *gofish.Service, lcServiceURI string) (status remoteServicesAPIStatus, err error) {
+	res, err := service.GetClient().Post(lcServiceURI+"/Actions/DellLCService.GetRemoteServicesAPIStatus", map[string]interface{}{})
+	if err != nil {
+		return status, err
+	}
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&status)
+	return status, err
+}
+
+// getControllerJobs returns the jobs of the Dell job queue that configure the storage controller.
+// Other vendors have no job queue, so no job is returned.
+func getControllerJobs(service *gofish.Service, controllerID string) ([]common.DellJobQueueEntry, error) {
+	if !isDellService(service) {
+		return nil, nil
+	}
+	dellManager, err := getDellManager(service)
+	if err != nil {
+		return nil, err
+	}
+	if dellManager.JobsURI() == "" {
+		return nil, nil
+	}
+	jobs, err := common.GetDellJobQueue(service, dellManager.JobsURI())
+	if err != nil {
+		return nil, err
+	}
+	return filterControllerJobs(jobs, controllerID), nil
+}
+
+// filterControllerJobs returns the jobs targeting the controller, the iDRAC names them after the controller FQDD,
+// e.g. Configure: RAID.Integrated.1-1.
+func filterControllerJobs(jobs []common.DellJobQueueEntry, controllerID string) []common.DellJobQueueEntry {
+	var controllerJobs []common.DellJobQueueEntry
+	for _, job := range jobs {
+		if strings.Contains(job.Name, controllerID) || strings.Contains(job.TargetSettingsURI, controllerID) {
+			controllerJobs = append(controllerJobs, job)
+		}
+	}
+	return controllerJobs
+}
+
+// checkPendingControllerJobs fails when configuration jobs are still queued for the controller, since the
+// controller rejects new jobs until they are applied. The pending jobs are deleted instead when cancel is set.
+func checkPendingControllerJobs(service *gofish.Service, controllerID string, cancel bool) error {
+	jobs, err := getControllerJobs(service, controllerID)
+	if err != nil {
+		return err
+	}
+	var pending []string
+	for _, job := range jobs {
+		if !job.Pending() {
+			continue
+		}
+		if cancel {
+			if err := common.DeleteDellJob(service, job.ID); err != nil {
+				return fmt.Errorf("could not delete the pending job %s: %w", job.ID, err)
+			}
+			continue
+		}
+		pending = append(pending, fmt.Sprintf("%s (%s, %s)", job.ID, job.Name, job.JobState))
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("the controller %s has pending configuration jobs: %s. They are applied on the next server"+
+			" reset, or can be deleted by setting cancel_pending_jobs", controllerID, strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// waitForLifecycleControllerReady polls the Dell LC service every interval seconds until the Lifecycle Controller
+// is ready, so that the storage jobs are not rejected because it is busy. Other vendors have no such service
+// and are considered ready.
+func waitForLifecycleControllerReady(ctx context.Context, service *gofish.Service, interval int64, timeout int64) error {
+	if !isDellService(service) {
+		return nil
+	}
+	dellManager, err := getDellManager(service)
+	if err != nil {
+		return err
+	}
+	lcServiceURI := dellManager.DellLCServiceURI()
+	if lcServiceURI == "" {
+		return nil
+	}
+
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	for {
+		status, err := getRemoteServicesAPIStatus(service, lcServiceURI)
+		if err == nil && status.ready() {
+			return nil
+		}
+		if time.Now().Add(time.Duration(interval) * time.Second).After(deadline) {
+			if err != nil {
+				return fmt.Errorf("could not retrieve the Lifecycle Controller status within %d seconds: %w", timeout, err)
+			}
+			return fmt.Errorf("the Lifecycle Controller is not ready after %d seconds, its status is %s and the real time"+
+				" services status is %s", timeout, status.LCStatus, status.RTStatus)
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for the Lifecycle Controller, its status is %s and the real time services status is %s",
+			status.LCStatus, status.RTStatus))
+		select {
+		case <-time.After(time.Duration(interval) * time.Second):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// dellVolumePayloadBuilder builds the payloads expected by the iDRAC.
+type dellVolumePayloadBuilder struct {
+	seventeenGeneration bool
+}
+
+func (dellVolumePayloadBuilder) oem(settings volumeOemSettings) (map[string]interface{}, error) {
+	dellVolume := map[string]interface{}{
+		"DiskCachePolicy": settings.DiskCachePolicy,
+	}
+	if settings.T10PI {
+		dellVolume["T10PIStatus"] = "Enabled"
+	}
+	if settings.SpanCount > 0 {
+		dellVolume["SpanDepth"] = settings.SpanCount
+		dellVolume["SpanLength"] = settings.SpanLength
+	}
+	if settings.Cache {
+		dellVolume["Cachecade"] = dellCachecadeVolume
+	}
+	return map[string]interface{}{
+		"Dell": map[string]interface{}{
+			"DellVolume": dellVolume,
+		},
+	}, nil
+}
+
+func (b dellVolumePayloadBuilder) setDrives(payload map[string]interface{}, drives []map[string]string) {
+	// For 17G, have Drives as part of Links
+	if b.seventeenGeneration {
+		payload["Links"] = map[string]interface{}{"Drives": drives}
+		return
+	}
+	payload["Drives"] = drives
+}
+
+// genericVolumePayloadBuilder builds standard Redfish payloads, without any OEM block.
+// The apply time relies on the standard @Redfish.OperationApplyTime annotation.
+type genericVolumePayloadBuilder struct{}
+
+func (genericVolumePayloadBuilder) oem(settings volumeOemSettings) (map[string]interface{}, error) {
+	if settings.T10PI {
+		return nil, fmt.Errorf("protection_information %s is only supported on Dell controllers", protectionInformationT10DIF)
+	}
+	if settings.SpanCount > 0 {
+		return nil, fmt.Errorf("span_count and span_length are only supported on Dell controllers")
+	}
+	if settings.Cache {
+		return nil, fmt.Errorf("is_cache is only supported on Dell controllers")
+	}
+	return nil, nil
+}
+
+func (genericVolumePayloadBuilder) setDrives(payload map[string]interface{}, drives []map[string]string) {
+	payload["Links"] = map[string]interface{}{"Drives": drives}
+}
+
+// getVolumeDocument returns the volume as reported by the service.
+func getVolumeDocument(service *gofish.Service, volumeURI string) (map[string]interface{}, error) {
+	res, err := service.GetClient().Get(volumeURI)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	var document map[string]interface{}
+	if err := json.NewDecoder(res.Body).Decode(&document); err != nil {
+		return nil, fmt.Errorf("couldn't decode volume %s: %w", volumeURI, err)
+	}
+	return document, nil
+}
+
+// getVolumeDrives returns the drives of the volume. When gofish returns none, the drives are read from the
+// volume document, under Links.Drives on 17G servers and under Drives on the older ones, either layout
+// being used as a fallback for the other.
+func getVolumeDrives(service *gofish.Service, volume *redfish.Volume) ([]*redfish.Drive, error) {
+	drives, err := volume.Drives()
+	if err == nil && len(drives) > 0 {
+		return drives, nil
+	}
+
+	document, err := getVolumeDocument(service, volume.ODataID)
+	if err != nil {
+		return nil, err
+	}
+	isGenerationSeventeenAndAbove, err := isServerGenerationSeventeenAndAbove(service)
+	if err != nil {
+		return nil, err
+	}
+
+	drives = []*redfish.Drive{}
+	for _, uri := range volumeDriveURIs(document, isGenerationSeventeenAndAbove) {
+		drive, err := redfish.GetDrive(service.GetClient(), uri)
+		if err != nil {
+			return nil, err
+		}
+		drives = append(drives, drive)
+	}
+	return drives, nil
+}
+
+// volumeDriveURIs returns the URIs of the drives listed in the volume document. The layout of the server
+// generation is tried first, Links.Drives for 17G and Drives for the older ones, then the other one.
+func volumeDriveURIs(document map[string]interface{}, isGenerationSeventeenAndAbove bool) []string {
+	links, _ := document["Links"].(map[string]interface{})
+	layouts := []map[string]interface{}{document, links}
+	if isGenerationSeventeenAndAbove {
+		layouts = []map[string]interface{}{links, document}
+	}
+
+	for _, layout := range layouts {
+		members, _ := layout["Drives"].([]interface{})
+		uris := make([]string, 0, len(members))
+		for _, member := range members {
+			if m, ok := member.(map[string]interface{}); ok {
+				if uri, ok := m["@odata.id"].(string); ok && uri != "" {
+					uris = append(uris, uri)
+				}
+			}
+		}
+		if len(uris) > 0 {
+			return uris
+		}
+	}
+	return nil
+}
+
+// getVolumeCapabilities returns the volume creation capabilities advertised by the volume collection of the
+// controller, nil when it advertises none.
+func getVolumeCapabilities(service *gofish.Service, storage *redfish.Storage) (map[string]interface{}, error) {
+	res, err := service.GetClient().Get(storage.ODataID + "/Volumes")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	var volumes struct {
+		CollectionCapabilities struct {
+			Capabilities []struct {
+				CapabilitiesObject struct {
+					ODataID string `json:"@odata.id"`
+				}
+				UseCase string
+			}
+		} `json:"@Redfish.CollectionCapabilities"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&volumes); err != nil {
+		return nil, fmt.Errorf("couldn't decode the volumes of %s: %w", storage.ID, err)
+	}
+	for _, capabilities := range volumes.CollectionCapabilities.Capabilities {
+		if capabilities.UseCase == "VolumeCreation" && capabilities.CapabilitiesObject.ODataID != "" {
+			return getVolumeDocument(service, capabilities.CapabilitiesObject.ODataID)
+		}
+	}
+	return nil, nil
+}
+
+// getAllowableStripSizes returns the smallest and the largest strip sizes allowed by the volume capabilities.
+// ok is false when the capabilities don't advertise them.
+func getAllowableStripSizes(capabilities map[string]interface{}) (minSize, maxSize int64, ok bool) {
+	values, _ := capabilities["OptimumIOSizeBytes@Redfish.AllowableValues"].([]interface{})
+	for _, value := range values {
+		var size int64
+		switch v := value.(type) {
+		case float64:
+			size = int64(v)
+		case string:
+			parsed, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				continue
+			}
+			size = parsed
+		default:
+			continue
+		}
+		if !ok || size < minSize {
+			minSize = size
+		}
+		if !ok || size > maxSize {
+			maxSize = size
+		}
+		ok = true
+	}
+	return minSize, maxSize, ok
+}
+
+// checkStripSize fails when the optimum IO size is outside of the strip sizes supported by the controller.
+// Controllers that don't advertise them are assumed to support the common range of 64KiB to 1MiB.
+func checkStripSize(optimumIOSizeBytes int64, capabilities map[string]interface{}) error {
+	minSize, maxSize, ok := getAllowableStripSizes(capabilities)
+	if !ok {
+		minSize, maxSize = minStripSizeBytes, maxStripSizeBytes
+	}
+	if optimumIOSizeBytes < minSize || optimumIOSizeBytes > maxSize {
+		return fmt.Errorf("optimum_io_size_bytes %d is not supported by the controller, it must be between %d and %d bytes",
+			optimumIOSizeBytes, minSize, maxSize)
+	}
+	return nil
+}
+
+// checkEncryptionTypes fails when an encryption type isn't allowed by the volume capabilities of the controller.
+// Controllers that don't advertise the allowed types are left to validate them.
+func checkEncryptionTypes(encryptionTypes []string, capabilities map[string]interface{}) error {
+	allowed, ok := capabilities["EncryptionTypes@Redfish.AllowableValues"].([]interface{})
+	if !ok {
+		return nil
+	}
+	for _, encryptionType := range encryptionTypes {
+		supported := false
+		for _, value := range allowed {
+			if value == encryptionType {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("encryption type %s is not supported by the controller, the supported types are %v",
+				encryptionType, allowed)
+		}
+	}
+	return nil
+}
+
+// powerOfTwoValidator validates that the value is a power of two, like the strip sizes of the controllers
+type powerOfTwoValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v powerOfTwoValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (powerOfTwoValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a power of two"
+}
+
+// ValidateInt64 performs the validation.
+func (v powerOfTwoValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if value := req.ConfigValue.ValueInt64(); value <= 0 || value&(value-1) != 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value))
+	}
+}
+
+// volumeSettingsPatch returns the desired settings that differ from the current volume document.
+// Nested objects are compared key by key, so that only the changed keys of an object like the OEM block are kept.
+func volumeSettingsPatch(current, desired map[string]interface{}) (map[string]interface{}, error) {
+	// Compare the desired settings in their JSON form, as the current document was decoded from JSON
+	raw, err := json.Marshal(desired)
+	if err != nil {
+		return nil, err
+	}
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return nil, err
+	}
+	return diffVolumeSettings(current, normalized), nil
+}
+
+func diffVolumeSettings(current, desired map[string]interface{}) map[string]interface{} {
+	patch := map[string]interface{}{}
+	for key, value := range desired {
+		if nested, ok := value.(map[string]interface{}); ok {
+			currentNested, _ := current[key].(map[string]interface{})
+			if changed := diffVolumeSettings(currentNested, nested); len(changed) > 0 {
+				patch[key] = changed
+			}
+			continue
+		}
+		if !reflect.DeepEqual(current[key], value) {
+			patch[key] = value
+		}
+	}
+	return patch
+}
+
+// getVolumeETag returns the ETag of the volume, empty when the service doesn't report one.
+func getVolumeETag(service *gofish.Service, volumeURI string) string {
+	res, err := service.GetClient().Get(volumeURI)
+	if err != nil {
+		return ""
+	}
+	res.Body.Close() // #nosec G104
+	return res.Header.Get("ETag")
+}
+
+// volumeUpdateError reports an ETag mismatch on a volume update as errVolumeModifiedExternally.
+func volumeUpdateError(err error) error {
+	var redfishErr *redfishcommon.Error
+	if errors.As(err, &redfishErr) && redfishErr.HTTPReturnedStatusCode == http.StatusPreconditionFailed {
+		return errVolumeModifiedExternally
+	}
+	return volumeRequestError(err)
+}
+
+// volumeRequestError replaces the raw Redfish error of a rejected volume request with the messages it holds.
+func volumeRequestError(err error) error {
+	var redfishErr *redfishcommon.Error
+	if !errors.As(err, &redfishErr) {
+		return err
+	}
+	if message := redfishErrorMessage([]byte(redfishErr.Error())); message != "" {
+		return fmt.Errorf("the request failed with status %d: %s", redfishErr.HTTPReturnedStatusCode, message)
+	}
+	return err
+}
+
+// volumeResponseError describes an unexpected response to a volume request, with the messages of its body.
+func volumeResponseError(res *http.Response) error {
+	body, _ := io.ReadAll(res.Body)
+	if message := redfishErrorMessage(body); message != "" {
+		return fmt.Errorf("the query was unsuccessful, status %d: %s", res.StatusCode, message)
+	}
+	return fmt.Errorf("the query was unsuccessful, status %d", res.StatusCode)
+}
+
+// redfishErrorMessage returns the messages, along with their resolution, of the @Message.ExtendedInfo of a
+// Redfish error or response body, or the error message when there are none.
+func redfishErrorMessage(body []byte) string {
+	type extendedInfo struct {
+		Message    string `json:"Message"`
+		Resolution string `json:"Resolution"`
+	}
+	var response struct {
+		Error struct {
+			Message      string         `json:"message"`
+			ExtendedInfo []extendedInfo `json:"@Message.ExtendedInfo"`
+		} `json:"error"`
+		ExtendedInfo []extendedInfo `json:"@Message.ExtendedInfo"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return ""
+	}
+
+	messages := []string{}
+	for _, info := range append(response.Error.ExtendedInfo, response.ExtendedInfo...) {
+		if info.Message == "" {
+			continue
+		}
+		if info.Resolution != "" {
+			messages = append(messages, fmt.Sprintf("%s (resolution: %s)", info.Message, info.Resolution))
+		} else {
+			messages = append(messages, info.Message)
+		}
+	}
+	if len(messages) == 0 {
+		return response.Error.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// splitDriveNamesAndIDs splits a list mixing drive names and IDs, e.g. dedicated_hot_spares, into the names
+// and the IDs to look up with getDrives. Values matching no drive name are looked up as IDs.
+func splitDriveNamesAndIDs(drives []*redfish.Drive, values []string) (names, ids []string) {
+	driveNames := make(map[string]bool, len(drives))
+	for _, drive := range drives {
+		driveNames[drive.Name] = true
+	}
+	for _, value := range values {
+		if driveNames[value] {
+			names = append(names, value)
+		} else {
+			ids = append(ids, value)
+		}
+	}
+	return names, ids
+}
+
+// validateDedicatedHotSpares checks that the spares are not members of the volume and can replace any of its drives.
+func validateDedicatedHotSpares(spares, members []*redfish.Drive) error {
+	var memberCapacity int64
+	memberIDs := map[string]bool{}
+	for _, member := range members {
+		memberIDs[member.ODataID] = true
+		if member.CapacityBytes > memberCapacity {
+			memberCapacity = member.CapacityBytes
+		}
+	}
+	for _, spare := range spares {
+		if memberIDs[spare.ODataID] {
+			return fmt.Errorf("drive %s is a member of the volume and can't be its hot spare", spare.ID)
+		}
+		if spare.CapacityBytes < memberCapacity {
+			return fmt.Errorf("drive %s has a capacity of %d bytes, smaller than the %d bytes of the volume drives",
+				spare.ID, spare.CapacityBytes, memberCapacity)
+		}
+	}
+	return nil
+}
+
+// assignDedicatedHotSpares assigns the spares to the volume through the Dell RAID service and waits for the jobs.
+// Failures are reported as warnings since the volume exists at this point and must be kept in the state.
+func assignDedicatedHotSpares(ctx context.Context, service *gofish.Service, system *redfish.ComputerSystem,
+	spares []*redfish.Drive, d *models.RedfishStorageVolume,
+) (diags diag.Diagnostics) {
+	volumeID := d.ID.ValueString()
+	volumeFQDD := volumeID[strings.LastIndex(volumeID, "/")+1:]
+	url := system.ODataID + "/Oem/Dell/DellRaidService/Actions/DellRaidService.AssignSpare"
+	for _, spare := range spares {
+		postBody := map[string]interface{}{
+			"TargetFQDD":       spare.ID,
+			"VirtualDiskArray": []string{volumeFQDD},
+		}
+		res, err := service.GetClient().Post(url, postBody)
+		if err != nil {
+			diags.AddWarning("Error when assigning the dedicated hot spares", err.Error())
+			return diags
+		}
+		res.Body.Close()
+		jobID := res.Header.Get("Location")
+		if len(jobID) == 0 {
+			diags.AddWarning("Error when assigning the dedicated hot spares", "there was some error when retreiving the jobID")
+			return diags
+		}
+		err = common.WaitForTaskToFinishWithBackoff(ctx, service, jobID, getJobCheckInterval(d), getJobCheckMaxInterval(d), d.VolumeJobTimeout.ValueInt64())
+		if err != nil {
+			diags.AddWarning(fmt.Sprintf("Error when assigning drive %s as dedicated hot spare", spare.ID), err.Error())
+			return diags
+		}
+	}
+	return diags
+}
+
+// reconfigureVirtualDisksAction is the Dell RAID service action expanding or migrating a volume
+const reconfigureVirtualDisksAction = "ReconfigureVirtualDisks"
+
+// dellRaidServiceSupports reports whether the Dell RAID service of the system advertises the action.
+func dellRaidServiceSupports(service *gofish.Service, system *redfish.ComputerSystem, action string) bool {
+	res, err := service.GetClient().Get(system.ODataID + "/Oem/Dell/DellRaidService")
+	if err != nil {
+		return false
+	}
+	defer res.Body.Close()
+	var raidService struct {
+		Actions map[string]json.RawMessage
+	}
+	if err := json.NewDecoder(res.Body).Decode(&raidService); err != nil {
+		return false
+	}
+	_, ok := raidService.Actions["#DellRaidService."+action]
+	return ok
+}
+
+// runControllerRaidAction submits the Dell RAID service action targeting the controller and waits for its job.
+func runControllerRaidAction(ctx context.Context, service *gofish.Service, system *redfish.ComputerSystem,
+	action, controllerID string, jobTimeout int64,
+) error {
+	return runDellRaidServiceAction(ctx, service, system, action, controllerID,
+		map[string]interface{}{"TargetFQDD": controllerID}, jobTimeout)
+}
+
+// runDellRaidServiceAction submits the Dell RAID service action with the given parameters and waits for its job.
+// target is the FQDD of the controller or volume the action applies to, used in the errors.
+func runDellRaidServiceAction(ctx context.Context, service *gofish.Service, system *redfish.ComputerSystem,
+	action, target string, parameters map[string]interface{}, jobTimeout int64,
+) error {
+	jobID, err := submitDellRaidServiceAction(service, system, action, target, parameters)
+	if err != nil {
+		return err
+	}
+	if err := common.WaitForTaskToFinishWithContext(ctx, service, jobID, defaultStorageVolumeJobCheckInterval, jobTimeout); err != nil {
+		return fmt.Errorf("%s: %w", RedfishJobErrorMsg, err)
+	}
+	return nil
+}
+
+// submitDellRaidServiceAction submits the Dell RAID service action with the given parameters and returns the URI of its job.
+func submitDellRaidServiceAction(service *gofish.Service, system *redfish.ComputerSystem,
+	action, target string, parameters map[string]interface{},
+) (string, error) {
+	url := system.ODataID + "/Oem/Dell/DellRaidService/Actions/DellRaidService." + action
+	res, err := service.GetClient().Post(url, parameters)
+	if err != nil {
+		return "", fmt.Errorf("%s of %s failed: %w", action, target, err)
+	}
+	defer res.Body.Close()
+	jobID := res.Header.Get("Location")
+	if len(jobID) == 0 {
+		return "", fmt.Errorf("there was some error when retreiving the jobID of %s for %s", action, target)
+	}
+	return jobID, nil
+}
+
+// getDellStorageController returns the controller of the storage with its Dell OEM data.
+func getDellStorageController(storage *redfish.Storage) (*dell.StorageControllerExtended, error) {
+	controllers, err := storage.Controllers()
+	if err != nil {
+		return nil, err
+	}
+	if len(controllers) == 0 {
+		return nil, fmt.Errorf("storage %s has no controller", storage.ID)
+	}
+	controller := controllers[0]
+	for _, c := range controllers {
+		if c.ID == storage.ID {
+			controller = c
+			break
+		}
+	}
+	return dell.StorageController(controller)
+}
+
+// controllerKeyAssigned reports whether the controller has a security key, either a local one (LKM)
+// or one from a key manager (SEKM).
+func controllerKeyAssigned(controller dell.DellStorageController) bool {
+	switch controller.EncryptionMode {
+	case "LocalKeyManagement", "SecureEnterpriseKeyManager":
+		return true
+	}
+	return controller.SecurityStatus == "SecurityKeyAssigned"
+}
+
+// checkControllerKey fails when the controller has no security key, which encrypted volumes require.
+func checkControllerKey(storage *redfish.Storage) error {
+	controller, err := getDellStorageController(storage)
+	if err != nil {
+		return err
+	}
+	if !controllerKeyAssigned(controller.Oem.Dell.DellStorageController) {
+		return fmt.Errorf("encrypted volumes require a security key on controller %s, which has none."+
+			" Set one with the redfish_controller_key resource", storage.ID)
+	}
+	return nil
+}
+
+// raidLevelMigrations lists the RAID levels each level can be migrated to in place
+var raidLevelMigrations = map[string][]string{
+	"RAID0": {"RAID1", "RAID5", "RAID6"},
+	"RAID1": {"RAID0", "RAID5", "RAID6", "RAID10"},
+	"RAID5": {"RAID0", "RAID6"},
+	"RAID6": {"RAID0", "RAID5"},
+}
+
+// isRaidLevelMigration reports whether a volume can be migrated in place between the RAID levels.
+func isRaidLevelMigration(from, to string) bool {
+	for _, target := range raidLevelMigrations[from] {
+		if target == to {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRaidLevelMigration returns an error when the volume can't be migrated in place between the RAID levels.
+// Controllers that don't report their supported RAID levels are assumed to support the target level.
+func checkRaidLevelMigration(storage *redfish.Storage, from, to string) error {
+	if !isRaidLevelMigration(from, to) {
+		return fmt.Errorf("migrating a volume from %s to %s is not supported, the volume must be replaced", from, to)
+	}
+	reported := false
+	for _, controller := range storage.StorageControllers {
+		for _, raidType := range controller.SupportedRAIDTypes {
+			if string(raidType) == to {
+				return nil
+			}
+			reported = true
+		}
+	}
+	if reported {
+		return fmt.Errorf("the controller doesn't support %s, the volume must be replaced", to)
+	}
+	return nil
+}
+
+// getVolumeReconfiguration returns the drives and the RAID level of the volume when drives were added to it or its
+// RAID level changed. The drives are nil when nothing has to be reconfigured, and the RAID level is empty when it is
+// unchanged. Removing drives can't be done in place and is rejected.
+func getVolumeReconfiguration(ctx context.Context, service *gofish.Service, system *redfish.ComputerSystem,
+	storage *redfish.Storage, plan, state *models.RedfishStorageVolume,
+) ([]*redfish.Drive, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var planNames, planIDs, stateNames, stateIDs []string
+	diags.Append(plan.Drives.ElementsAs(ctx, &planNames, true)...)
+	diags.Append(plan.DriveIDs.ElementsAs(ctx, &planIDs, true)...)
+	diags.Append(state.Drives.ElementsAs(ctx, &stateNames, true)...)
+	diags.Append(state.DriveIDs.ElementsAs(ctx, &stateIDs, true)...)
+	if diags.HasError() {
+		return nil, "", diags
+	}
+
+	allStorageDrives, err := storage.Drives()
+	if err != nil {
+		diags.AddError("Error when getting the drives attached to controller", err.Error())
+		return nil, "", diags
+	}
+	planDrives, err := getDrives(allStorageDrives, planNames, planIDs)
+	if err != nil {
+		diags.AddError("Error when getting the drives", err.Error())
+		return nil, "", diags
+	}
+	stateDrives, err := getDrives(allStorageDrives, stateNames, stateIDs)
+	if err != nil {
+		diags.AddError("Error when getting the drives", err.Error())
+		return nil, "", diags
+	}
+
+	added, removed := diffVolumeDrives(planDrives, stateDrives)
+	if len(removed) > 0 {
+		diags.AddError("Drives can't be removed from a volume",
+			fmt.Sprintf("shrinking a volume is not supported, the volume must be replaced to remove drives: %s", strings.Join(removed, ", ")))
+		return nil, "", diags
+	}
+
+	raidType := state.RaidType.ValueString()
+	migrateTo := ""
+	if plan.RaidType.ValueString() != "" && plan.RaidType.ValueString() != raidType {
+		migrateTo = plan.RaidType.ValueString()
+		if err := checkRaidLevelMigration(storage, raidType, migrateTo); err != nil {
+			diags.AddError("RAID level migration is not supported", err.Error())
+			return nil, "", diags
+		}
+		raidType = migrateTo
+	}
+	if len(added) == 0 && migrateTo == "" {
+		return nil, "", diags
+	}
+
+	if err := validateDriveCountForRaid(raidType, len(planDrives)); err != nil {
+		diags.AddError("Invalid number of drives for the RAID level", err.Error())
+		return nil, "", diags
+	}
+	if !plan.AllowMixedDrives.ValueBool() {
+		if err := checkMixedDrives(planDrives); err != nil {
+			diags.AddError(mixedDrivesMsg, err.Error())
+			return nil, "", diags
+		}
+	}
+	if !dellRaidServiceSupports(service, system, reconfigureVirtualDisksAction) {
+		diags.AddError("Volume reconfiguration is not supported",
+			"the controller doesn't support reconfiguring volumes, the volume must be replaced to add drives or change its RAID level")
+		return nil, "", diags
+	}
+	return planDrives, migrateTo, diags
+}
+
+// getAdoptionConflicts describes how the existing volume differs from the configured one. The capacity is only
+// compared when it is configured.
+func getAdoptionConflicts(volume *redfish.Volume, volumeDrives []*redfish.Drive, raidType string, drives []*redfish.Drive,
+	capacityBytes int64, encrypted bool,
+) []string {
+	var conflicts []string
+	if volume.RAIDType != "" && string(volume.RAIDType) != raidType {
+		conflicts = append(conflicts, fmt.Sprintf("raid_type is %s, not %s", volume.RAIDType, raidType))
+	}
+	if added, removed := diffVolumeDrives(drives, volumeDrives); len(added) > 0 || len(removed) > 0 {
+		ids := make([]string, 0, len(volumeDrives))
+		for _, drive := range volumeDrives {
+			ids = append(ids, drive.ID)
+		}
+		conflicts = append(conflicts, fmt.Sprintf("drives are %s", strings.Join(ids, ", ")))
+	}
+	if capacityBytes > 0 && int64(volume.CapacityBytes) != capacityBytes {
+		conflicts = append(conflicts, fmt.Sprintf("capacity_bytes is %d, not %d", volume.CapacityBytes, capacityBytes))
+	}
+	if volume.Encrypted != encrypted {
+		conflicts = append(conflicts, fmt.Sprintf("encrypted is %t, not %t", volume.Encrypted, encrypted))
+	}
+	return conflicts
+}
+
+// diffVolumeDrives returns the IDs of the drives added to and removed from the volume.
+func diffVolumeDrives(planDrives, stateDrives []*redfish.Drive) (added, removed []string) {
+	planIDs, stateIDs := []string{}, []string{}
+	for _, drive := range planDrives {
+		planIDs = append(planIDs, drive.ID)
+	}
+	for _, drive := range stateDrives {
+		stateIDs = append(stateIDs, drive.ID)
+	}
+	return setDiff(planIDs, stateIDs), setDiff(stateIDs, planIDs)
+}
+
+// reconfigureVolume submits a Dell RAID service reconfigure job for the volume with its new drives and,
+// when raidType is set, its new RAID level, then waits for the job. Expanding a volume can take hours.
+func reconfigureVolume(ctx context.Context, service *gofish.Service, system *redfish.ComputerSystem,
+	d *models.RedfishStorageVolume, drives []*redfish.Drive, raidType string,
+) (diags diag.Diagnostics) {
+	volumeID := d.ID.ValueString()
+	driveIDs := []string{}
+	for _, drive := range drives {
+		driveIDs = append(driveIDs, drive.ID)
+	}
+	postBody := map[string]interface{}{
+		"TargetFQDD": volumeID[strings.LastIndex(volumeID, "/")+1:],
+		"PDArray":    driveIDs,
+	}
+	if raidType != "" {
+		postBody["RAIDLevel"] = strings.TrimPrefix(raidType, "RAID")
+	}
+
+	url := system.ODataID + "/Oem/Dell/DellRaidService/Actions/DellRaidService." + reconfigureVirtualDisksAction
+	res, err := service.GetClient().Post(url, postBody)
+	if err != nil {
+		diags.AddError("Error when reconfiguring the volume", err.Error())
+		return diags
+	}
+	res.Body.Close()
+	jobID := res.Header.Get("Location")
+	if len(jobID) == 0 {
+		diags.AddError("Error when reconfiguring the volume", "there was some error when retreiving the jobID")
+		return diags
+	}
+
+	jobCheckInterval := getJobCheckInterval(d)
+	if d.SettingsApplyTime.ValueString() == string(redfishcommon.OnResetApplyTime) {
+		pOp := powerOperator{ctx, service, system.ID}
+		_, err := pOp.PowerOperation(d.ResetType.ValueString(), d.ResetTimeout.ValueInt64(), jobCheckInterval)
+		if err != nil {
+			diags.AddError(RedfishJobErrorMsg, err.Error())
+			return diags
+		}
+	}
+
+	err = common.WaitForTaskToFinishWithBackoff(ctx, service, jobID, jobCheckInterval, getJobCheckMaxInterval(d), d.VolumeJobTimeout.ValueInt64())
+	if err != nil {
+		diags.AddError(RedfishJobErrorMsg, err.Error())
+		return diags
+	}
+	return diags
+}
@@ -0,0 +1,939 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"terraform-provider-redfish/common"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+func TestNormalizeReadCachePolicy(t *testing.T) {
+	tests := map[string]string{
+		"Off":               "Off",
+		"NoReadAhead":       "Off",
+		"No Read Ahead":     "Off",
+		"ReadAhead":         "ReadAhead",
+		"READ_AHEAD":        "ReadAhead",
+		"AdaptiveReadAhead": "AdaptiveReadAhead",
+		"Adaptive":          "AdaptiveReadAhead",
+		"SomethingElse":     "SomethingElse",
+		"":                  "",
+	}
+	for reported, expected := range tests {
+		t.Run(reported, func(t *testing.T) {
+			if got := normalizeReadCachePolicy(reported); got != expected {
+				t.Errorf("normalizeReadCachePolicy(%q) = %q, expected %q", reported, got, expected)
+			}
+		})
+	}
+}
+
+func TestNormalizeWriteCachePolicy(t *testing.T) {
+	tests := map[string]string{
+		"WriteThrough":         "WriteThrough",
+		"Write-Through":        "WriteThrough",
+		"UnprotectedWriteBack": "UnprotectedWriteBack",
+		"WriteBack":            "UnprotectedWriteBack",
+		"writeback":            "UnprotectedWriteBack",
+		"ProtectedWriteBack":   "ProtectedWriteBack",
+		"ForceWriteBack":       "ProtectedWriteBack",
+		"WriteBackForce":       "ProtectedWriteBack",
+		"SomethingElse":        "SomethingElse",
+	}
+	for reported, expected := range tests {
+		t.Run(reported, func(t *testing.T) {
+			if got := normalizeWriteCachePolicy(reported); got != expected {
+				t.Errorf("normalizeWriteCachePolicy(%q) = %q, expected %q", reported, got, expected)
+			}
+		})
+	}
+}
+
+func TestCachePolicyRoundTrip(t *testing.T) {
+	for canonical := range legacyReadCachePolicyNames {
+		legacy := firmwareCachePolicy(canonical, true, legacyReadCachePolicyNames)
+		if got := normalizeReadCachePolicy(legacy); got != canonical {
+			t.Errorf("read cache policy %q sent as %q reads back as %q", canonical, legacy, got)
+		}
+		if got := firmwareCachePolicy(canonical, false, legacyReadCachePolicyNames); got != canonical {
+			t.Errorf("read cache policy %q should be sent as is to current firmware, got %q", canonical, got)
+		}
+	}
+	for canonical := range legacyWriteCachePolicyNames {
+		legacy := firmwareCachePolicy(canonical, true, legacyWriteCachePolicyNames)
+		if got := normalizeWriteCachePolicy(legacy); got != canonical {
+			t.Errorf("write cache policy %q sent as %q reads back as %q", canonical, legacy, got)
+		}
+		if got := firmwareCachePolicy(canonical, false, legacyWriteCachePolicyNames); got != canonical {
+			t.Errorf("write cache policy %q should be sent as is to current firmware, got %q", canonical, got)
+		}
+	}
+}
+
+func TestUsesLegacyCachePolicyNames(t *testing.T) {
+	t.Run("no volumes", func(t *testing.T) {
+		if usesLegacyCachePolicyNames(nil) {
+			t.Error("expected current names when there are no volumes")
+		}
+	})
+	t.Run("current names", func(t *testing.T) {
+		volumes := []*redfish.Volume{{
+			ReadCachePolicy:  redfish.OffReadCachePolicyType,
+			WriteCachePolicy: redfish.UnprotectedWriteBackWriteCachePolicyType,
+		}}
+		if usesLegacyCachePolicyNames(volumes) {
+			t.Error("expected current names")
+		}
+	})
+	t.Run("legacy read policy", func(t *testing.T) {
+		volumes := []*redfish.Volume{{ReadCachePolicy: "NoReadAhead"}}
+		if !usesLegacyCachePolicyNames(volumes) {
+			t.Error("expected legacy names")
+		}
+	})
+	t.Run("legacy write policy", func(t *testing.T) {
+		volumes := []*redfish.Volume{
+			{WriteCachePolicy: redfish.WriteThroughWriteCachePolicyType},
+			{WriteCachePolicy: "WriteBack"},
+		}
+		if !usesLegacyCachePolicyNames(volumes) {
+			t.Error("expected legacy names")
+		}
+	})
+}
+
+func TestValidateDriveCountForRaid(t *testing.T) {
+	tests := []struct {
+		raidType string
+		n        int
+		valid    bool
+	}{
+		{"RAID0", 0, false},
+		{"RAID0", 1, true},
+		{"RAID0", 5, true},
+		{"RAID1", 1, false},
+		{"RAID1", 2, true},
+		{"RAID1", 3, false},
+		{"RAID5", 2, false},
+		{"RAID5", 3, true},
+		{"RAID6", 3, false},
+		{"RAID6", 4, true},
+		{"RAID10", 2, false},
+		{"RAID10", 4, true},
+		{"RAID10", 5, false},
+		{"RAID10", 6, true},
+		{"RAID50", 5, false},
+		{"RAID50", 6, true},
+		{"RAID60", 7, false},
+		{"RAID60", 8, true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s with %d drives", tt.raidType, tt.n), func(t *testing.T) {
+			err := validateDriveCountForRaid(tt.raidType, tt.n)
+			if tt.valid && err != nil {
+				t.Errorf("expected no error, got %s", err)
+			}
+			if !tt.valid && err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestCheckDrivesSecureErase(t *testing.T) {
+	newDrive := func(id, capability string) *redfish.Drive {
+		oem, _ := json.Marshal(map[string]interface{}{
+			"Dell": map[string]interface{}{
+				"DellPhysicalDisk": map[string]interface{}{"SystemEraseCapability": capability},
+			},
+		})
+		drive := &redfish.Drive{Oem: oem}
+		drive.ID = id
+		return drive
+	}
+
+	supported := []*redfish.Drive{newDrive("Disk.Bay.0", "SecureErasePD"), newDrive("Disk.Bay.1", "CryptographicErasePD")}
+	if err := checkDrivesSecureErase(supported); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	unsupported := append(supported, newDrive("Disk.Bay.2", eraseNotSupported))
+	err := checkDrivesSecureErase(unsupported)
+	if err == nil || err.Error() != "secure erase is not supported on drives: Disk.Bay.2" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCheckDrivesCryptographicErase(t *testing.T) {
+	newDrive := func(id string, ability redfish.EncryptionAbility) *redfish.Drive {
+		drive := &redfish.Drive{EncryptionAbility: ability}
+		drive.ID = id
+		return drive
+	}
+
+	seds := []*redfish.Drive{newDrive("Disk.Bay.0", redfish.SelfEncryptingDriveEncryptionAbility)}
+	if err := checkDrivesCryptographicErase(seds); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	mixed := append(seds, newDrive("Disk.Bay.1", redfish.NoneEncryptionAbility), newDrive("Disk.Bay.2", ""))
+	err := checkDrivesCryptographicErase(mixed)
+	if err == nil || err.Error() != "cryptographic erase requires self-encrypting drives, these drives are not: Disk.Bay.1, Disk.Bay.2" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestInitializationMethods(t *testing.T) {
+	tests := map[string]redfish.InitializeMethod{
+		"None": redfish.SkipInitializeMethod,
+		"Fast": redfish.BackgroundInitializeMethod,
+		"Full": redfish.ForegroundInitializeMethod,
+	}
+	for initialization, expected := range tests {
+		if got := initializationMethods[initialization]; got != expected {
+			t.Errorf("initializationMethods[%q] = %q, expected %q", initialization, got, expected)
+		}
+	}
+}
+
+func TestSetVolumeStatus(t *testing.T) {
+	volume := &redfish.Volume{
+		Status: redfishcommon.Status{Health: redfishcommon.WarningHealth, State: redfishcommon.UpdatingState},
+		Operations: []redfishcommon.Operations{
+			{OperationName: "Rebuilding", PercentageComplete: 45},
+		},
+	}
+	var d models.RedfishStorageVolume
+	setVolumeStatus(&d, volume)
+	if d.Health.ValueString() != "Warning" || d.State.ValueString() != "Updating" {
+		t.Errorf("unexpected status: health %q, state %q", d.Health.ValueString(), d.State.ValueString())
+	}
+	if d.OperationProgress.ValueString() != "Rebuilding: 45%" {
+		t.Errorf("unexpected operation_progress %q", d.OperationProgress.ValueString())
+	}
+	if d.InitializationProgress.ValueInt64() != 100 {
+		t.Errorf("expected an initialization_progress of 100 while rebuilding, got %d", d.InitializationProgress.ValueInt64())
+	}
+
+	volume.Operations = []redfishcommon.Operations{{OperationName: "Background Initialization", PercentageComplete: 30}}
+	setVolumeStatus(&d, volume)
+	if d.InitializationProgress.ValueInt64() != 30 {
+		t.Errorf("unexpected initialization_progress %d", d.InitializationProgress.ValueInt64())
+	}
+
+	setVolumeStatus(&d, &redfish.Volume{})
+	if d.OperationProgress.IsNull() || d.OperationProgress.ValueString() != "" {
+		t.Errorf("expected an empty operation_progress, got %q", d.OperationProgress.ValueString())
+	}
+}
+
+func TestValidateSpans(t *testing.T) {
+	tests := []struct {
+		raidType   string
+		spanCount  int64
+		spanLength int64
+		drives     int
+		valid      bool
+	}{
+		{"RAID0", 0, 0, 2, true},
+		{"RAID10", 0, 0, 4, true},
+		{"RAID10", 2, 2, 4, true},
+		{"RAID50", 2, 3, 6, true},
+		{"RAID60", 2, 4, 8, true},
+		{"RAID60", 2, 4, 10, false},
+		{"RAID5", 2, 2, 4, false},
+		{"RAID1", 2, 1, 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s_%dx%d_%d", tt.raidType, tt.spanCount, tt.spanLength, tt.drives), func(t *testing.T) {
+			err := validateSpans(tt.raidType, tt.spanCount, tt.spanLength, tt.drives)
+			if tt.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestSetVolumeEncryptionAndCapacity(t *testing.T) {
+	tests := []struct {
+		name          string
+		encrypted     bool
+		capacityBytes int
+		boss          bool
+		want          map[string]interface{}
+	}{
+		{
+			name: "encrypted with a capacity", encrypted: true, capacityBytes: 1073741824,
+			want: map[string]interface{}{"Encrypted": true, "EncryptionTypes": []string{"NativeDriveEncryption"}, "CapacityBytes": 1073741824},
+		},
+		{
+			name: "encrypted with the full capacity", encrypted: true,
+			want: map[string]interface{}{"Encrypted": true, "EncryptionTypes": []string{"NativeDriveEncryption"}},
+		},
+		{
+			name: "not encrypted with a capacity", capacityBytes: 1073741824,
+			want: map[string]interface{}{"Encrypted": false, "CapacityBytes": 1073741824},
+		},
+		{
+			name: "BOSS controller", capacityBytes: 1073741824, boss: true,
+			want: map[string]interface{}{"Encrypted": false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newVolume := map[string]interface{}{}
+			setVolumeEncryptionAndCapacity(newVolume, tt.encrypted, []string{"NativeDriveEncryption"}, tt.capacityBytes, tt.boss)
+			if !reflect.DeepEqual(newVolume, tt.want) {
+				t.Errorf("payload = %v, expected %v", newVolume, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateDedicatedHotSpares(t *testing.T) {
+	newDrive := func(id string, capacity int64) *redfish.Drive {
+		drive := &redfish.Drive{CapacityBytes: capacity}
+		drive.ID = id
+		drive.ODataID = "/redfish/v1/Systems/System.Embedded.1/Storage/RAID.Integrated.1-1/Drives/" + id
+		return drive
+	}
+	members := []*redfish.Drive{newDrive("Disk.Bay.0", 1000), newDrive("Disk.Bay.1", 2000)}
+
+	if err := validateDedicatedHotSpares([]*redfish.Drive{newDrive("Disk.Bay.2", 2000)}, members); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := validateDedicatedHotSpares([]*redfish.Drive{newDrive("Disk.Bay.1", 2000)}, members); err == nil {
+		t.Errorf("expected an error for a spare that is a member of the volume")
+	}
+	if err := validateDedicatedHotSpares([]*redfish.Drive{newDrive("Disk.Bay.2", 1500)}, members); err == nil {
+		t.Errorf("expected an error for a spare smaller than the volume drives")
+	}
+}
+
+func TestCheckMixedDrives(t *testing.T) {
+	newDrive := func(name string, mediaType redfish.MediaType, protocol redfishcommon.Protocol) *redfish.Drive {
+		drive := &redfish.Drive{MediaType: mediaType, Protocol: protocol}
+		drive.Name = name
+		return drive
+	}
+	ssdSAS := newDrive("Disk 0", redfish.SSDMediaType, redfishcommon.SASProtocol)
+	ssdSATA := newDrive("Disk 1", redfish.SSDMediaType, redfishcommon.SATAProtocol)
+	hddSAS := newDrive("Disk 2", redfish.HDDMediaType, redfishcommon.SASProtocol)
+	unknown := newDrive("Disk 3", "", "")
+
+	tests := []struct {
+		name    string
+		drives  []*redfish.Drive
+		wantErr string
+	}{
+		{name: "homogeneous", drives: []*redfish.Drive{ssdSAS, ssdSAS, unknown}},
+		{name: "mixed media types", drives: []*redfish.Drive{ssdSAS, hddSAS}, wantErr: "media types HDD (Disk 2), SSD (Disk 0)"},
+		{name: "mixed protocols", drives: []*redfish.Drive{ssdSAS, ssdSATA}, wantErr: "protocols SAS (Disk 0), SATA (Disk 1)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkMixedDrives(tt.drives)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("checkMixedDrives() error = %v, expected it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckCacheDrives(t *testing.T) {
+	newDrive := func(name string, mediaType redfish.MediaType) *redfish.Drive {
+		drive := &redfish.Drive{MediaType: mediaType}
+		drive.Name = name
+		return drive
+	}
+	ssd := newDrive("Disk 0", redfish.SSDMediaType)
+	hdd := newDrive("Disk 1", redfish.HDDMediaType)
+
+	if err := checkCacheDrives([]*redfish.Drive{ssd, ssd}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	err := checkCacheDrives([]*redfish.Drive{ssd, hdd})
+	if err == nil || !strings.Contains(err.Error(), "Disk 1 (HDD)") {
+		t.Errorf("checkCacheDrives() error = %v, expected it to report the HDD", err)
+	}
+}
+
+func TestSplitDriveNamesAndIDs(t *testing.T) {
+	drive := &redfish.Drive{}
+	drive.ID = "Disk.Bay.2"
+	drive.Name = "Physical Disk 0:1:2"
+	drive.ODataID = "/redfish/v1/Systems/System.Embedded.1/Storage/RAID.Integrated.1-1/Drives/Disk.Bay.2"
+	drives := []*redfish.Drive{drive}
+
+	for _, value := range []string{drive.ID, drive.Name, drive.ODataID} {
+		names, ids := splitDriveNamesAndIDs(drives, []string{value})
+		found, err := getDrives(drives, names, ids)
+		if err != nil || len(found) != 1 {
+			t.Errorf("expected %q to match the drive, got %v, %v", value, found, err)
+		}
+	}
+	names, ids := splitDriveNamesAndIDs(drives, []string{"Disk.Bay.3"})
+	if _, err := getDrives(drives, names, ids); err == nil {
+		t.Errorf("expected an error for a missing drive")
+	}
+}
+
+func TestUsableCapacityBytes(t *testing.T) {
+	newDrives := func(capacities ...int64) []*redfish.Drive {
+		drives := []*redfish.Drive{}
+		for _, capacity := range capacities {
+			drives = append(drives, &redfish.Drive{CapacityBytes: capacity})
+		}
+		return drives
+	}
+	tests := []struct {
+		raidType  string
+		drives    []*redfish.Drive
+		spanCount int64
+		expected  int64
+	}{
+		{"RAID0", newDrives(1000), 0, 1000},
+		{"RAID0", newDrives(1000, 1000, 1000), 0, 3000},
+		{"RAID0", newDrives(1000, 800), 0, 1600},
+		{"RAID1", newDrives(1000, 1000), 0, 1000},
+		{"RAID1", newDrives(1000, 1200), 0, 1000},
+		{"RAID5", newDrives(1000, 1000, 1000), 0, 2000},
+		{"RAID5", newDrives(1000, 1000, 1000, 500), 0, 1500},
+		{"RAID6", newDrives(1000, 1000, 1000, 1000), 0, 2000},
+		{"RAID10", newDrives(1000, 1000, 1000, 1000), 0, 2000},
+		{"RAID50", newDrives(1000, 1000, 1000, 1000, 1000, 1000), 0, 4000},
+		{"RAID50", newDrives(1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000), 3, 6000},
+		{"RAID60", newDrives(1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000), 0, 4000},
+		{"RAID0", newDrives(), 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s_%d_drives", tt.raidType, len(tt.drives)), func(t *testing.T) {
+			if got := usableCapacityBytes(tt.raidType, tt.drives, tt.spanCount); got != tt.expected {
+				t.Errorf("usableCapacityBytes() = %d, expected %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsBOSSController(t *testing.T) {
+	boss := &redfish.Storage{StorageControllers: []redfish.StorageController{{Model: "BOSS-S2"}}}
+	boss.ID = "AHCI.SL.6-1"
+	if !isBOSSController(boss) {
+		t.Errorf("expected %q to be a BOSS controller", boss.ID)
+	}
+	perc := &redfish.Storage{StorageControllers: []redfish.StorageController{{Model: "PERC H755 Front"}}}
+	perc.ID = "RAID.Integrated.1-1"
+	if isBOSSController(perc) {
+		t.Errorf("expected %q not to be a BOSS controller", perc.ID)
+	}
+}
+
+func TestDiffVolumeDrives(t *testing.T) {
+	newDrives := func(ids ...string) []*redfish.Drive {
+		drives := []*redfish.Drive{}
+		for _, id := range ids {
+			drive := &redfish.Drive{}
+			drive.ID = id
+			drives = append(drives, drive)
+		}
+		return drives
+	}
+
+	added, removed := diffVolumeDrives(newDrives("Disk.Bay.0", "Disk.Bay.1", "Disk.Bay.2"), newDrives("Disk.Bay.0", "Disk.Bay.1"))
+	if len(added) != 1 || added[0] != "Disk.Bay.2" || len(removed) != 0 {
+		t.Errorf("expansion: unexpected added %v, removed %v", added, removed)
+	}
+	added, removed = diffVolumeDrives(newDrives("Disk.Bay.0"), newDrives("Disk.Bay.0", "Disk.Bay.1"))
+	if len(added) != 0 || len(removed) != 1 || removed[0] != "Disk.Bay.1" {
+		t.Errorf("shrink: unexpected added %v, removed %v", added, removed)
+	}
+	added, removed = diffVolumeDrives(newDrives("Disk.Bay.1", "Disk.Bay.0"), newDrives("Disk.Bay.0", "Disk.Bay.1"))
+	if len(added) != 0 || len(removed) != 0 {
+		t.Errorf("reorder: unexpected added %v, removed %v", added, removed)
+	}
+}
+
+func TestCheckRaidLevelMigration(t *testing.T) {
+	storage := &redfish.Storage{StorageControllers: []redfish.StorageController{
+		{SupportedRAIDTypes: []redfish.RAIDType{redfish.RAID0RAIDType, redfish.RAID1RAIDType, redfish.RAID5RAIDType}},
+	}}
+	tests := []struct {
+		from, to string
+		valid    bool
+	}{
+		{"RAID0", "RAID5", true},
+		{"RAID1", "RAID0", true},
+		{"RAID0", "RAID6", false},
+		{"RAID5", "RAID1", false},
+		{"RAID10", "RAID0", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from+"_to_"+tt.to, func(t *testing.T) {
+			err := checkRaidLevelMigration(storage, tt.from, tt.to)
+			if tt.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+
+	// The target level only has to be supported by one of the controllers
+	storage.StorageControllers = append([]redfish.StorageController{
+		{SupportedRAIDTypes: []redfish.RAIDType{redfish.RAID1RAIDType}},
+	}, storage.StorageControllers...)
+	if err := checkRaidLevelMigration(storage, "RAID0", "RAID5"); err != nil {
+		t.Errorf("unexpected error with several controllers: %v", err)
+	}
+}
+
+func TestCheckResetTypeSupported(t *testing.T) {
+	system := &redfish.ComputerSystem{SupportedResetTypes: []redfish.ResetType{redfish.OnResetType, redfish.ForceRestartResetType, redfish.NmiResetType}}
+	if err := checkResetTypeSupported(system, string(redfish.NmiResetType)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := checkResetTypeSupported(system, string(redfish.PushPowerButtonResetType)); err == nil {
+		t.Errorf("expected an error for an unsupported reset type")
+	}
+	if err := checkResetTypeSupported(&redfish.ComputerSystem{}, string(redfish.PushPowerButtonResetType)); err != nil {
+		t.Errorf("systems without allowable values must accept any reset type: %v", err)
+	}
+}
+
+func TestVolumeUpdateError(t *testing.T) {
+	preconditionFailed := &redfishcommon.Error{HTTPReturnedStatusCode: http.StatusPreconditionFailed}
+	if err := volumeUpdateError(preconditionFailed); !errors.Is(err, errVolumeModifiedExternally) {
+		t.Errorf("expected errVolumeModifiedExternally, got %v", err)
+	}
+
+	badRequest := &redfishcommon.Error{HTTPReturnedStatusCode: http.StatusBadRequest}
+	if err := volumeUpdateError(badRequest); !errors.Is(err, badRequest) {
+		t.Errorf("expected the original error, got %v", err)
+	}
+
+	connectionErr := fmt.Errorf("connection reset")
+	if err := volumeUpdateError(connectionErr); !errors.Is(err, connectionErr) {
+		t.Errorf("expected the original error, got %v", err)
+	}
+}
+
+func TestVolumeSettingsPatch(t *testing.T) {
+	var current map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"Name": "data01",
+		"ReadCachePolicy": "Off",
+		"WriteCachePolicy": "WriteThrough",
+		"Encrypted": false,
+		"Description": "managed elsewhere",
+		"Oem": {"Dell": {"DellVolume": {"DiskCachePolicy": "Disabled", "T10PIStatus": "Enabled"}}}
+	}`), &current)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	patch, err := volumeSettingsPatch(current, map[string]interface{}{
+		"Name":             "data01",
+		"ReadCachePolicy":  "ReadAhead",
+		"WriteCachePolicy": "WriteThrough",
+		"Encrypted":        false,
+		"Oem": map[string]map[string]map[string]interface{}{
+			"Dell": {"DellVolume": {"DiskCachePolicy": "Enabled"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"ReadCachePolicy": "ReadAhead",
+		"Oem":             map[string]interface{}{"Dell": map[string]interface{}{"DellVolume": map[string]interface{}{"DiskCachePolicy": "Enabled"}}},
+	}
+	if !reflect.DeepEqual(patch, expected) {
+		t.Fatalf("expected patch %v, got %v", expected, patch)
+	}
+
+	// The unmanaged settings survive the patch being merged into the volume
+	updated := mergeVolumeSettings(current, patch)
+	if updated["Description"] != "managed elsewhere" {
+		t.Errorf("expected Description to be kept, got %v", updated["Description"])
+	}
+	dellVolume := updated["Oem"].(map[string]interface{})["Dell"].(map[string]interface{})["DellVolume"].(map[string]interface{})
+	if dellVolume["T10PIStatus"] != "Enabled" || dellVolume["DiskCachePolicy"] != "Enabled" {
+		t.Errorf("expected T10PIStatus to be kept and DiskCachePolicy updated, got %v", dellVolume)
+	}
+
+	patch, err = volumeSettingsPatch(updated, map[string]interface{}{"Name": "data01", "ReadCachePolicy": "ReadAhead"})
+	if err != nil || len(patch) != 0 {
+		t.Errorf("expected an empty patch, got %v, %v", patch, err)
+	}
+}
+
+// mergeVolumeSettings merges a patch into a volume document the way the service applies a PATCH.
+func mergeVolumeSettings(document, patch map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for key, value := range document {
+		merged[key] = value
+	}
+	for key, value := range patch {
+		if nested, ok := value.(map[string]interface{}); ok {
+			current, _ := merged[key].(map[string]interface{})
+			merged[key] = mergeVolumeSettings(current, nested)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
+
+func TestIsDellService(t *testing.T) {
+	tests := []struct {
+		service  *gofish.Service
+		expected bool
+	}{
+		{&gofish.Service{Vendor: "Dell"}, true},
+		{&gofish.Service{Oem: json.RawMessage(`{"Dell": {"ServiceTag": "ABC1234"}}`)}, true},
+		{&gofish.Service{Vendor: "HPE", Oem: json.RawMessage(`{"Hpe": {}}`)}, false},
+		{&gofish.Service{Vendor: "Lenovo"}, false},
+	}
+	for _, test := range tests {
+		if got := isDellService(test.service); got != test.expected {
+			t.Errorf("isDellService(%s) = %v, expected %v", test.service.Vendor, got, test.expected)
+		}
+	}
+}
+
+func TestRemoteServicesAPIStatusReady(t *testing.T) {
+	tests := []struct {
+		status   remoteServicesAPIStatus
+		expected bool
+	}{
+		{remoteServicesAPIStatus{LCStatus: "Ready", RTStatus: "Ready"}, true},
+		{remoteServicesAPIStatus{LCStatus: "InUse", RTStatus: "Ready"}, false},
+		{remoteServicesAPIStatus{LCStatus: "Ready", RTStatus: "NotReady"}, false},
+		{remoteServicesAPIStatus{}, false},
+	}
+	for _, test := range tests {
+		if got := test.status.ready(); got != test.expected {
+			t.Errorf("ready() for %+v = %v, expected %v", test.status, got, test.expected)
+		}
+	}
+}
+
+func TestFilterControllerJobs(t *testing.T) {
+	jobs := []common.DellJobQueueEntry{
+		{ID: "JID_1", Name: "Configure: RAID.Integrated.1-1", JobState: "Scheduled"},
+		{ID: "JID_2", Name: "Configure: RAID.Slot.2-1", JobState: "Scheduled"},
+		{ID: "JID_3", Name: "Configure: BIOS.Setup.1-1", JobState: "Scheduled"},
+		{ID: "JID_4", Name: "Export: Server Configuration Profile",
+			TargetSettingsURI: "/redfish/v1/Systems/System.Embedded.1/Storage/RAID.Integrated.1-1"},
+	}
+	got := filterControllerJobs(jobs, "RAID.Integrated.1-1")
+	if len(got) != 2 || got[0].ID != "JID_1" || got[1].ID != "JID_4" {
+		t.Errorf("filterControllerJobs() = %v, expected JID_1 and JID_4", got)
+	}
+	if got := filterControllerJobs(jobs, "AHCI.Embedded.1-1"); len(got) != 0 {
+		t.Errorf("filterControllerJobs() = %v, expected none", got)
+	}
+}
+
+func TestCheckEncryptionTypes(t *testing.T) {
+	capabilities := map[string]interface{}{
+		"EncryptionTypes@Redfish.AllowableValues": []interface{}{"NativeDriveEncryption", "ControllerAssisted"},
+	}
+	if err := checkEncryptionTypes([]string{"ControllerAssisted"}, capabilities); err != nil {
+		t.Errorf("expected ControllerAssisted to be supported, got %v", err)
+	}
+	if err := checkEncryptionTypes([]string{"NativeDriveEncryption", "SoftwareAssisted"}, capabilities); err == nil {
+		t.Error("expected SoftwareAssisted to be rejected")
+	}
+	if err := checkEncryptionTypes([]string{"SoftwareAssisted"}, nil); err != nil {
+		t.Errorf("expected the types not to be checked without capabilities, got %v", err)
+	}
+}
+
+func TestPowerOfTwoValidator(t *testing.T) {
+	tests := map[int64]bool{
+		65536:   true,
+		1048576: true,
+		1:       true,
+		0:       false,
+		-65536:  false,
+		100000:  false,
+		196608:  false,
+	}
+	for value, valid := range tests {
+		req := validator.Int64Request{Path: path.Root("optimum_io_size_bytes"), ConfigValue: types.Int64Value(value)}
+		resp := &validator.Int64Response{}
+		powerOfTwoValidator{}.ValidateInt64(context.Background(), req, resp)
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("ValidateInt64(%d) errors = %v, expected valid %v", value, resp.Diagnostics, valid)
+		}
+	}
+}
+
+func TestCheckStripSize(t *testing.T) {
+	advertised := map[string]interface{}{
+		"OptimumIOSizeBytes@Redfish.AllowableValues": []interface{}{"65536", "131072", "262144", float64(524288)},
+	}
+	if err := checkStripSize(524288, advertised); err != nil {
+		t.Errorf("expected 512KB to be supported, got %v", err)
+	}
+	if err := checkStripSize(1048576, advertised); err == nil {
+		t.Error("expected 1MB to be rejected when the controller advertises up to 512KB")
+	}
+
+	// Controllers that don't advertise the strip sizes fall back to 64KB to 1MB
+	for _, capabilities := range []map[string]interface{}{nil, {"Name": "Capabilities for VolumeCollection"}} {
+		if err := checkStripSize(1048576, capabilities); err != nil {
+			t.Errorf("expected 1MB to be supported, got %v", err)
+		}
+		if err := checkStripSize(32768, capabilities); err == nil {
+			t.Error("expected 32KB to be rejected")
+		}
+	}
+}
+
+func TestVolumeDriveURIs(t *testing.T) {
+	const (
+		drive0 = "/redfish/v1/Systems/System.Embedded.1/Storage/RAID.Integrated.1-1/Drives/Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1"
+		drive1 = "/redfish/v1/Systems/System.Embedded.1/Storage/RAID.Integrated.1-1/Drives/Disk.Bay.1:Enclosure.Internal.0-1:RAID.Integrated.1-1"
+	)
+	legacy := fmt.Sprintf(`{"Id": "Disk.Virtual.0:RAID.Integrated.1-1", "Drives": [{"@odata.id": %q}, {"@odata.id": %q}]}`, drive0, drive1)
+	links := fmt.Sprintf(`{"Id": "Disk.Virtual.0:RAID.Integrated.1-1", "Drives": [], "Links": {"Drives": [{"@odata.id": %q}]}}`, drive1)
+	both := fmt.Sprintf(`{"Drives": [{"@odata.id": %q}], "Links": {"Drives": [{"@odata.id": %q}]}}`, drive0, drive1)
+
+	tests := []struct {
+		name     string
+		document string
+		is17G    bool
+		want     []string
+	}{
+		{name: "Drives layout", document: legacy, want: []string{drive0, drive1}},
+		{name: "Drives layout on 17G", document: legacy, is17G: true, want: []string{drive0, drive1}},
+		{name: "Links layout on 17G", document: links, is17G: true, want: []string{drive1}},
+		{name: "Links layout", document: links, want: []string{drive1}},
+		{name: "both layouts", document: both, want: []string{drive0}},
+		{name: "both layouts on 17G", document: both, is17G: true, want: []string{drive1}},
+		{name: "no drives", document: `{"Links": {}}`, is17G: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var document map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.document), &document); err != nil {
+				t.Fatal(err)
+			}
+			if got := volumeDriveURIs(document, tt.is17G); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("volumeDriveURIs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolumePayloadBuilders(t *testing.T) {
+	drives := []map[string]string{{"@odata.id": "/redfish/v1/Systems/1/Storage/RAID/Drives/0"}}
+	settings := volumeOemSettings{DiskCachePolicy: "Enabled", SpanCount: 2, SpanLength: 2}
+
+	// Dell controllers get the OEM block, with the drives placed according to the generation
+	oem, err := dellVolumePayloadBuilder{}.oem(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dellVolume := oem["Dell"].(map[string]interface{})["DellVolume"].(map[string]interface{})
+	if dellVolume["DiskCachePolicy"] != "Enabled" || dellVolume["SpanDepth"] != int64(2) {
+		t.Errorf("unexpected Dell OEM block %v", dellVolume)
+	}
+	payload := map[string]interface{}{}
+	dellVolumePayloadBuilder{}.setDrives(payload, drives)
+	if _, ok := payload["Drives"]; !ok {
+		t.Errorf("expected top level Drives, got %v", payload)
+	}
+	payload = map[string]interface{}{}
+	dellVolumePayloadBuilder{seventeenGeneration: true}.setDrives(payload, drives)
+	if _, ok := payload["Links"]; !ok {
+		t.Errorf("expected Links.Drives, got %v", payload)
+	}
+
+	// Generic controllers only get standard properties
+	oem, err = genericVolumePayloadBuilder{}.oem(volumeOemSettings{DiskCachePolicy: "Enabled"})
+	if err != nil || oem != nil {
+		t.Errorf("expected no OEM block, got %v, %v", oem, err)
+	}
+	if _, err = (genericVolumePayloadBuilder{}).oem(settings); err == nil {
+		t.Errorf("expected spans to be rejected on a generic controller")
+	}
+	if _, err = (genericVolumePayloadBuilder{}).oem(volumeOemSettings{T10PI: true}); err == nil {
+		t.Errorf("expected T10 PI to be rejected on a generic controller")
+	}
+	payload = map[string]interface{}{}
+	genericVolumePayloadBuilder{}.setDrives(payload, drives)
+	if _, ok := payload["Links"]; !ok {
+		t.Errorf("expected Links.Drives, got %v", payload)
+	}
+}
+
+func TestLockStorageController(t *testing.T) {
+	const endpoint = "https://lock-storage-controller.test"
+	acquired := func(lock func() func()) bool {
+		done := make(chan func())
+		go func() { done <- lock() }()
+		select {
+		case unlock := <-done:
+			unlock()
+			return true
+		case <-time.After(100 * time.Millisecond):
+			go func() { (<-done)() }()
+			return false
+		}
+	}
+
+	unlock := lockStorageController(endpoint, "RAID.Integrated.1-1", false)
+	if !acquired(func() func() { return lockStorageController(endpoint, "RAID.Slot.2-1", false) }) {
+		t.Error("expected a distinct controller not to be locked")
+	}
+	if acquired(func() func() { return lockStorageController(endpoint, "RAID.Integrated.1-1", false) }) {
+		t.Error("expected the same controller to be locked")
+	}
+	unlock()
+
+	unlock = lockStorageController(endpoint, "RAID.Integrated.1-1", true)
+	if acquired(func() func() {
+		redfishMutexKV.Lock(endpoint)
+		return func() { redfishMutexKV.Unlock(endpoint) }
+	}) {
+		t.Error("expected the server to be locked while it is reset")
+	}
+	unlock()
+}
+
+func TestMaintenanceWindowPayload(t *testing.T) {
+	for applyTime, want := range map[string]bool{
+		string(redfishcommon.ImmediateApplyTime):                  false,
+		string(redfishcommon.OnResetApplyTime):                    false,
+		string(redfishcommon.AtMaintenanceWindowStartApplyTime):   true,
+		string(redfishcommon.InMaintenanceWindowOnResetApplyTime): true,
+	} {
+		if got := isMaintenanceWindowApplyTime(applyTime); got != want {
+			t.Errorf("%s: expected %v, got %v", applyTime, want, got)
+		}
+	}
+
+	payload := maintenanceWindowPayload(&models.MaintenanceWindow{
+		StartTime: types.StringValue("2024-10-15T22:45:00-05:00"),
+		Duration:  types.Int64Value(3600),
+	})
+	want := map[string]interface{}{
+		"MaintenanceWindowStartTime":         "2024-10-15T22:45:00-05:00",
+		"MaintenanceWindowDurationInSeconds": int64(3600),
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("expected %v, got %v", want, payload)
+	}
+}
+
+func TestIsTaskDone(t *testing.T) {
+	for state, want := range map[redfish.TaskState]bool{
+		redfish.RunningTaskState:   false,
+		redfish.NewTaskState:       false,
+		redfish.CompletedTaskState: true,
+		redfish.ExceptionTaskState: true,
+		redfish.KilledTaskState:    true,
+		redfish.CancelledTaskState: true,
+	} {
+		if got := isTaskDone(&redfish.Task{TaskState: state}); got != want {
+			t.Errorf("%s: expected %v, got %v", state, want, got)
+		}
+	}
+}
+
+func TestGetAdoptionConflicts(t *testing.T) {
+	newDrives := func(ids ...string) []*redfish.Drive {
+		drives := []*redfish.Drive{}
+		for _, id := range ids {
+			drive := &redfish.Drive{}
+			drive.ID = id
+			drives = append(drives, drive)
+		}
+		return drives
+	}
+	volume := &redfish.Volume{RAIDType: redfish.RAID1RAIDType, CapacityBytes: 1 << 30}
+	volumeDrives := newDrives("Disk.Bay.0", "Disk.Bay.1")
+
+	if conflicts := getAdoptionConflicts(volume, volumeDrives, "RAID1", newDrives("Disk.Bay.1", "Disk.Bay.0"), 0, false); len(conflicts) != 0 {
+		t.Errorf("expected no conflict, got %v", conflicts)
+	}
+	if conflicts := getAdoptionConflicts(volume, volumeDrives, "RAID1", newDrives("Disk.Bay.0", "Disk.Bay.1"), 1<<30, false); len(conflicts) != 0 {
+		t.Errorf("expected no conflict for the same capacity, got %v", conflicts)
+	}
+	conflicts := getAdoptionConflicts(volume, volumeDrives, "RAID0", newDrives("Disk.Bay.0", "Disk.Bay.2"), 1<<31, true)
+	if len(conflicts) != 4 {
+		t.Errorf("expected raid_type, drives, capacity_bytes and encrypted conflicts, got %v", conflicts)
+	}
+}
+
+func TestRedfishErrorMessage(t *testing.T) {
+	body := []byte(`{"error":{"code":"Base.1.12.GeneralError","message":"A general error has occurred.",` +
+		`"@Message.ExtendedInfo":[{"Message":"Unable to create the virtual disk because the physical disk is already in use.",` +
+		`"Resolution":"Select another physical disk and retry the operation."}]}}`)
+	want := "Unable to create the virtual disk because the physical disk is already in use." +
+		" (resolution: Select another physical disk and retry the operation.)"
+	if got := redfishErrorMessage(body); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	if got := redfishErrorMessage([]byte(`{"error":{"message":"A general error has occurred."}}`)); got != "A general error has occurred." {
+		t.Errorf("expected the error message without extended info, got %q", got)
+	}
+	if got := redfishErrorMessage([]byte("<html></html>")); got != "" {
+		t.Errorf("expected no message for a body that isn't JSON, got %q", got)
+	}
+
+	res := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"@Message.ExtendedInfo":[{"Message":"The volume name is too long."}]}`)),
+	}
+	if err := volumeResponseError(res); err == nil || !strings.Contains(err.Error(), "status 200: The volume name is too long.") {
+		t.Errorf("expected the extended info in the error, got %v", err)
+	}
+}
@@ -18,15 +18,22 @@ limitations under the License.
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
+	"terraform-provider-redfish/common"
 	"testing"
 	"time"
 
 	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	redfishcommon "github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 // getVolumeImportConf returns the import configuration for the storage volume
@@ -39,6 +46,128 @@ func getVolumeImportConf(d *terraform.State, creds TestingServerCredentials) (st
 		id, creds.Username, creds.Password, creds.Endpoint), nil
 }
 
+func TestVolumeTypeMap(t *testing.T) {
+	expected := map[string]string{
+		string(redfish.NonRedundantVolumeType):             "RAID0",
+		string(redfish.MirroredVolumeType):                 "RAID1",
+		string(redfish.StripedWithParityVolumeType):        "RAID5",
+		string(redfish.SpannedMirrorsVolumeType):           "RAID10",
+		string(redfish.SpannedStripesWithParityVolumeType): "RAID50",
+	}
+	mapped := map[string]bool{}
+	for volumeType, raidType := range volumeTypeMap {
+		if raidType != expected[volumeType] {
+			t.Errorf("volume type %s is mapped to %s, expected %q", volumeType, raidType, expected[volumeType])
+		}
+		if _, ok := raidRedundantDriveCount[raidType]; !ok {
+			t.Errorf("volume type %s is mapped to unsupported raid type %s", volumeType, raidType)
+		}
+		mapped[raidType] = true
+	}
+	for volumeType := range expected {
+		if _, ok := volumeTypeMap[volumeType]; !ok {
+			t.Errorf("volume type %s is not mapped", volumeType)
+		}
+	}
+	// Redfish has no volume type for dual parity, so RAID6 and RAID60 are the only RAID levels
+	// that can't be requested through volume_type.
+	for raidType := range raidRedundantDriveCount {
+		if unmappable := raidType == "RAID6" || raidType == "RAID60"; mapped[raidType] == unmappable {
+			t.Errorf("raid type %s: expected a volume type mapping %v, got %v", raidType, !unmappable, mapped[raidType])
+		}
+	}
+}
+
+func TestCheckVolumeTypeConsistency(t *testing.T) {
+	tests := []struct {
+		volumeType, raidType types.String
+		wantErr              bool
+	}{
+		{types.StringValue("Mirrored"), types.StringValue("RAID1"), false},
+		{types.StringValue("Mirrored"), types.StringValue("RAID0"), true},
+		{types.StringValue("Mirrored"), types.StringNull(), false},
+		{types.StringNull(), types.StringValue("RAID6"), false},
+		{types.StringValue("NonRedundant"), types.StringUnknown(), false},
+	}
+	for _, test := range tests {
+		if err := checkVolumeTypeConsistency(test.volumeType, test.raidType); (err != nil) != test.wantErr {
+			t.Errorf("volume_type %s, raid_type %s: expected error %v, got %v", test.volumeType, test.raidType, test.wantErr, err)
+		}
+	}
+}
+
+func TestGetDrives(t *testing.T) {
+	drives := []*redfish.Drive{
+		{Entity: redfishcommon.Entity{ID: "Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1", Name: "Physical Disk 0:1:0"}},
+		{Entity: redfishcommon.Entity{ID: "Disk.Bay.1:Enclosure.Internal.0-1:RAID.Integrated.1-1", Name: "Physical Disk 0:1:1"}},
+	}
+
+	t.Run("all found", func(t *testing.T) {
+		found, err := getDrives(drives, []string{"Physical Disk 0:1:0"}, []string{"Disk.Bay.1:Enclosure.Internal.0-1:RAID.Integrated.1-1"})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+		if len(found) != 2 {
+			t.Errorf("expected 2 drives, got %d", len(found))
+		}
+	})
+	t.Run("drive requested twice is returned once", func(t *testing.T) {
+		found, err := getDrives(drives, []string{"Physical Disk 0:1:1"}, []string{"Disk.Bay.1:Enclosure.Internal.0-1:RAID.Integrated.1-1"})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+		if len(found) != 1 {
+			t.Errorf("expected 1 drive, got %d", len(found))
+		}
+	})
+	t.Run("names do not match IDs", func(t *testing.T) {
+		if _, err := getDrives(drives, []string{"Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1"}, nil); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+	t.Run("missing drives are listed", func(t *testing.T) {
+		_, err := getDrives(drives, []string{"Physical Disk 0:1:2"}, []string{"Disk.Bay.3"})
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		for _, expected := range []string{"Physical Disk 0:1:2", "Disk.Bay.3", "Available drives: Physical Disk 0:1:0"} {
+			if !strings.Contains(err.Error(), expected) {
+				t.Errorf("expected error %q to contain %q", err, expected)
+			}
+		}
+	})
+}
+
+func TestCheckControllerRaidMode(t *testing.T) {
+	for mode, wantErr := range map[string]bool{"RAID": false, "EnhancedHBA": false, "HBA": true, "": false} {
+		storage := &redfish.Storage{
+			Entity: redfishcommon.Entity{ID: "RAID.Integrated.1-1"},
+			OEM:    json.RawMessage(`{"Dell":{"DellController":{"CurrentControllerMode":"` + mode + `"}}}`),
+		}
+		if err := checkControllerRaidMode(storage); (err != nil) != wantErr {
+			t.Errorf("mode %q: expected error %v, got %v", mode, wantErr, err)
+		}
+	}
+}
+
+func TestGetVolumeByName(t *testing.T) {
+	volumes := []*redfish.Volume{
+		{Entity: redfishcommon.Entity{ODataID: "/redfish/v1/Systems/System.Embedded.1/Storage/RAID.Integrated.1-1/Volumes/Disk.Virtual.0", Name: "Volume0"}},
+		{Entity: redfishcommon.Entity{ODataID: "/redfish/v1/Systems/System.Embedded.1/Storage/RAID.Integrated.1-1/Volumes/Disk.Virtual.1", Name: "Volume1"}},
+	}
+
+	volume, err := getVolumeByName(volumes, "Volume1")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if volume != volumes[1] {
+		t.Errorf("expected volume %s, got %s", volumes[1].ODataID, volume.ODataID)
+	}
+	if _, err := getVolumeByName(volumes, "Volume2"); err == nil {
+		t.Error("expected an error for a missing volume")
+	}
+}
+
 func TestAccRedfishStorageVolume_InvalidController(t *testing.T) {
 	version := os.Getenv("TF_TESTING_REDFISH_VERSION")
 	if version == "17" {
@@ -70,6 +199,105 @@ func TestAccRedfishStorageVolume_InvalidController(t *testing.T) {
 	})
 }
 
+func TestAccRedfishStorageVolume_SmallCapacity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// a 512MB volume can be planned
+			{
+				Config: testAccRedfishResourceStorageVolumeConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+					"Immediate",
+					"Off",
+					"UnprotectedWriteBack",
+					"PowerCycle",
+					100,
+					200,
+					512*1024*1024,
+					131072,
+				),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRedfishResourceStorageVolumeConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+					"Immediate",
+					"Off",
+					"UnprotectedWriteBack",
+					"PowerCycle",
+					100,
+					200,
+					1024,
+					131072,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+		},
+	})
+}
+
+func TestAccRedfishStorageVolume_InvalidInitialization(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceStorageVolumeInitializationConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+					"Slow",
+				),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func TestAccRedfishStorageVolume_CapacityExceedsDrives(t *testing.T) {
+	version := os.Getenv("TF_TESTING_REDFISH_VERSION")
+	if version == "17" {
+		t.Skip("Skipping StorageVolume Tests for 17G")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceStorageVolumeConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+					"Immediate",
+					"Off",
+					"UnprotectedWriteBack",
+					"PowerCycle",
+					100,
+					200,
+					1<<60,
+					131072,
+				),
+				ExpectError: regexp.MustCompile("exceeds the usable capacity of the drives"),
+			},
+		},
+	})
+}
+
 func TestAccRedfishStorageVolume_InvalidDrive(t *testing.T) {
 	version := os.Getenv("TF_TESTING_REDFISH_VERSION")
 	if version == "17" {
@@ -129,7 +357,117 @@ func TestAccRedfishStorageVolume_InvalidVolumeType(t *testing.T) {
 					1073323223,
 					131072,
 				),
-				ExpectError: regexp.MustCompile("Error when creating the virtual disk on disk controller"),
+				ExpectError: regexp.MustCompile("Invalid number of drives for the RAID level"),
+			},
+		},
+	})
+}
+
+func TestAccRedfishStorageVolume_InvalidProtectionInformation(t *testing.T) {
+	version := os.Getenv("TF_TESTING_REDFISH_VERSION")
+	if version == "17" {
+		t.Skip("Skipping StorageVolume Tests for 17G")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceStorageVolumeProtectionInformationConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+					"T10DIF",
+				),
+				ExpectError: regexp.MustCompile("Error while checking support for protection_information"),
+			},
+		},
+	})
+}
+
+func TestAccRedfishStorageVolume_MissingDrives(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceStorageVolumeNoDrivesConfig(creds, "RAID.Integrated.1-1", "TerraformVol1"),
+				ExpectError: regexp.MustCompile("At least one attribute out of"),
+			},
+		},
+	})
+}
+
+func TestAccRedfishStorageVolume_InvalidPreRebootDelay(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceStorageVolumePreRebootDelayConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+					-1,
+				),
+				ExpectError: regexp.MustCompile("Attribute pre_reboot_delay value must be at least 0"),
+			},
+		},
+	})
+}
+
+func TestAccRedfishStorageVolume_InvalidJobCheckInterval(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceStorageVolumeJobCheckIntervalConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+					0,
+					1200,
+				),
+				ExpectError: regexp.MustCompile("Attribute job_check_interval value must be at least 1"),
+			},
+			{
+				Config: testAccRedfishResourceStorageVolumeJobCheckIntervalConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+					300,
+					300,
+				),
+				ExpectError: regexp.MustCompile("must be less than volume_job_timeout"),
+			},
+		},
+	})
+}
+
+func TestAccRedfishStorageVolume_MissingMaintenanceWindow(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceStorageVolumeApplyTimeConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+					"AtMaintenanceWindowStart",
+				),
+				ExpectError: regexp.MustCompile("maintenance_window must be set"),
 			},
 		},
 	})
@@ -214,6 +552,283 @@ func TestAccRedfishStorageVolumeUpdate_basic(t *testing.T) {
 	})
 }
 
+// Renaming a volume updates it in place, the volume is tracked by its ID rather than its name
+func TestAccRedfishStorageVolumeUpdate_rename(t *testing.T) {
+	version := os.Getenv("TF_TESTING_REDFISH_VERSION")
+	if version == "17" {
+		t.Skip("Skipping StorageVolume Tests for 17G")
+	}
+	var volumeID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceStorageVolumeConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"data01",
+					"RAID0",
+					drive,
+					"Immediate",
+					"AdaptiveReadAhead",
+					"UnprotectedWriteBack",
+					"PowerCycle",
+					100,
+					1200,
+					1073323222,
+					131072,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_storage_volume.volume", "volume_name", "data01"),
+					func(s *terraform.State) error {
+						volumeID = s.RootModule().Resources["redfish_storage_volume.volume"].Primary.ID
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRedfishResourceStorageVolumeConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"data02",
+					"RAID0",
+					drive,
+					"Immediate",
+					"AdaptiveReadAhead",
+					"UnprotectedWriteBack",
+					"PowerCycle",
+					100,
+					1200,
+					1073323222,
+					131072,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_storage_volume.volume", "volume_name", "data02"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["redfish_storage_volume.volume"].Primary.ID; id != volumeID {
+							return fmt.Errorf("volume was recreated on rename: %s != %s", id, volumeID)
+						}
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// The update job has to be awaited with volume_job_timeout, a small reset_timeout must not cut it short
+func TestAccRedfishStorageVolumeUpdate_volumeJobTimeout(t *testing.T) {
+	version := os.Getenv("TF_TESTING_REDFISH_VERSION")
+	if version == "17" {
+		t.Skip("Skipping StorageVolume Tests for 17G")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceStorageVolumeConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+					"Immediate",
+					"AdaptiveReadAhead",
+					"UnprotectedWriteBack",
+					"PowerCycle",
+					100,
+					1200,
+					1073323222,
+					131072,
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRedfishResourceStorageVolumeConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+					"Immediate",
+					"AdaptiveReadAhead",
+					"WriteThrough",
+					"PowerCycle",
+					1,
+					1200,
+					1073323222,
+					131072,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_storage_volume.volume", "write_cache_policy", "WriteThrough"),
+					resource.TestCheckResourceAttr("redfish_storage_volume.volume", "reset_timeout", "1"),
+					resource.TestCheckResourceAttr("redfish_storage_volume.volume", "volume_job_timeout", "1200"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// An apply interrupted while waiting for the volume job keeps the job in state and the next refresh attaches to it
+func TestAccRedfishStorageVolume_ResumeJob(t *testing.T) {
+	version := os.Getenv("TF_TESTING_REDFISH_VERSION")
+	if version == "17" {
+		t.Skip("Skipping StorageVolume Tests for 17G")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(common.WaitForTaskToFinishWithBackoff).
+						Return(fmt.Errorf("stopped waiting for the job to finish: %w", context.Canceled)).Build()
+				},
+				Config: testAccRedfishResourceStorageVolumeMinConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("redfish_storage_volume.volume", "id", "redfish_storage_volume.volume", "job_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: testAccRedfishResourceStorageVolumeMinConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("redfish_storage_volume.volume", "job_id"),
+					resource.TestMatchResourceAttr("redfish_storage_volume.volume", "id", regexp.MustCompile("/Volumes/")),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+// A create job that times out keeps the volume in state with its job, and fails the apply
+func TestAccRedfishStorageVolume_CreateJobTimeout(t *testing.T) {
+	version := os.Getenv("TF_TESTING_REDFISH_VERSION")
+	if version == "17" {
+		t.Skip("Skipping StorageVolume Tests for 17G")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(common.WaitForTaskToFinishWithBackoff).Return(common.ErrJobTimeout).Build()
+				},
+				Config: testAccRedfishResourceStorageVolumeMinConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+				),
+				ExpectError: regexp.MustCompile("Increase volume_job_timeout"),
+			},
+		},
+	})
+	if FunctionMocker != nil {
+		FunctionMocker.Release()
+	}
+}
+
+func TestAccRedfishStorageVolume_SecureEraseOnDestroy(t *testing.T) {
+	version := os.Getenv("TF_TESTING_REDFISH_VERSION")
+	if version == "17" {
+		t.Skip("Skipping StorageVolume Tests for 17G")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceStorageVolumeSecureEraseConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+					true,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_storage_volume.volume", "secure_erase_on_destroy", "true"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRedfishStorageVolume_EraseOnDestroyConflict(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceStorageVolumeEraseConflictConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+				),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func TestAccRedfishStorageVolume_KeepOnDestroyEraseConflict(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceStorageVolumeKeepOnDestroyConfig(
+					creds,
+					"RAID.Integrated.1-1",
+					"TerraformVol1",
+					"RAID0",
+					drive,
+					true,
+				),
+				ExpectError: regexp.MustCompile("the drives of a volume kept on destroy can't be erased"),
+			},
+		},
+	})
+}
+
 func TestAccRedfishStorageVolumeCreate_basic(t *testing.T) {
 	version := os.Getenv("TF_TESTING_REDFISH_VERSION")
 	if version == "17" {
@@ -233,18 +848,45 @@ func TestAccRedfishStorageVolumeCreate_basic(t *testing.T) {
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("redfish_storage_volume.volume", "storage_controller_id", "RAID.Integrated.1-1"),
+					resource.TestCheckResourceAttrSet("redfish_storage_volume.volume", "controller_cache_size_mb"),
+					resource.TestCheckResourceAttr("redfish_storage_volume.volume", "raid_type", "RAID0"),
+					resource.TestCheckResourceAttr("redfish_storage_volume.volume", "encrypted", "false"),
+					resource.TestCheckResourceAttrSet("redfish_storage_volume.volume", "disk_cache_policy"),
+					resource.TestCheckResourceAttr("redfish_storage_volume.volume", "health", "OK"),
+					resource.TestCheckResourceAttr("redfish_storage_volume.volume", "state", "Enabled"),
 				),
 				// / TBD: non empty plan fix for
 				ExpectNonEmptyPlan: true,
 			},
-			// test import
+			// test import
+			{
+				ResourceName: "redfish_storage_volume.volume",
+				ImportState:  true,
+				ImportStateIdFunc: func(d *terraform.State) (string, error) {
+					return getVolumeImportConf(d, creds)
+				},
+				ExpectError: nil,
+			},
+			// test import with an unknown redfish_alias -Negative
+			{
+				ResourceName:  "redfish_storage_volume.volume",
+				ImportState:   true,
+				ImportStateId: "{\"id\":\"invalid\",\"redfish_alias\":\"invalid\"}",
+				ExpectError:   regexp.MustCompile("Error while resolving redfish_alias"),
+			},
+			// test import by volume name
+			{
+				ResourceName:  "redfish_storage_volume.volume",
+				ImportState:   true,
+				ImportStateId: "{\"storage_controller_id\":\"RAID.Integrated.1-1\",\"volume_name\":\"TerraformVol1\",\"username\":\"" + creds.Username + "\",\"password\":\"" + creds.Password + "\",\"endpoint\":\"" + creds.Endpoint + "\",\"ssl_insecure\":true}",
+				ExpectError:   nil,
+			},
+			// test import by volume name -Negative
 			{
-				ResourceName: "redfish_storage_volume.volume",
-				ImportState:  true,
-				ImportStateIdFunc: func(d *terraform.State) (string, error) {
-					return getVolumeImportConf(d, creds)
-				},
-				ExpectError: nil,
+				ResourceName:  "redfish_storage_volume.volume",
+				ImportState:   true,
+				ImportStateId: "{\"storage_controller_id\":\"RAID.Integrated.1-1\",\"volume_name\":\"invalid\",\"username\":\"" + creds.Username + "\",\"password\":\"" + creds.Password + "\",\"endpoint\":\"" + creds.Endpoint + "\",\"ssl_insecure\":true}",
+				ExpectError:   regexp.MustCompile("Error while resolving the volume to import"),
 			},
 			// test import -Negative
 			{
@@ -525,7 +1167,7 @@ func TestAccRedfishStorageVolume17GInvalidVolumeType(t *testing.T) {
 					107374182400,
 					65536,
 				),
-				ExpectError: regexp.MustCompile("Error when creating the virtual disk on disk controller"),
+				ExpectError: regexp.MustCompile("Invalid number of drives for the RAID level"),
 			},
 		},
 	})
@@ -1081,3 +1723,302 @@ func testAccRedfishResourceStorageVolumeMinConfig(testingInfo TestingServerCrede
 		drives,
 	)
 }
+
+func testAccRedfishResourceStorageVolumeSecureEraseConfig(testingInfo TestingServerCredentials,
+	storage_controller_id string,
+	volume_name string,
+	raid_type string,
+	drives string,
+	secure_erase bool,
+) string {
+	return fmt.Sprintf(`
+	resource "redfish_storage_volume" "volume" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+	    system_id = "System.Embedded.1"
+		storage_controller_id   = "%s"
+		volume_name             = "%s"
+		raid_type               = "%s"
+		drives                  = ["%s"]
+		secure_erase_on_destroy = %t
+	  }
+	  `,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		storage_controller_id,
+		volume_name,
+		raid_type,
+		drives,
+		secure_erase,
+	)
+}
+
+func testAccRedfishResourceStorageVolumeKeepOnDestroyConfig(testingInfo TestingServerCredentials,
+	storage_controller_id string,
+	volume_name string,
+	raid_type string,
+	drives string,
+	secure_erase bool,
+) string {
+	return fmt.Sprintf(`
+	resource "redfish_storage_volume" "volume" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+	    system_id = "System.Embedded.1"
+		storage_controller_id    = "%s"
+		volume_name              = "%s"
+		raid_type                = "%s"
+		drives                   = ["%s"]
+		delete_volume_on_destroy = false
+		secure_erase_on_destroy  = %t
+	  }
+	  `,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		storage_controller_id,
+		volume_name,
+		raid_type,
+		drives,
+		secure_erase,
+	)
+}
+
+func testAccRedfishResourceStorageVolumeEraseConflictConfig(testingInfo TestingServerCredentials,
+	storage_controller_id string,
+	volume_name string,
+	raid_type string,
+	drives string,
+) string {
+	return fmt.Sprintf(`
+	resource "redfish_storage_volume" "volume" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+	    system_id = "System.Embedded.1"
+		storage_controller_id          = "%s"
+		volume_name                    = "%s"
+		raid_type                      = "%s"
+		drives                         = ["%s"]
+		secure_erase_on_destroy        = true
+		cryptographic_erase_on_destroy = true
+	  }
+	  `,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		storage_controller_id,
+		volume_name,
+		raid_type,
+		drives,
+	)
+}
+
+func testAccRedfishResourceStorageVolumeInitializationConfig(testingInfo TestingServerCredentials,
+	storage_controller_id string,
+	volume_name string,
+	raid_type string,
+	drives string,
+	initialization string,
+) string {
+	return fmt.Sprintf(`
+	resource "redfish_storage_volume" "volume" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+	    system_id = "System.Embedded.1"
+		storage_controller_id = "%s"
+		volume_name           = "%s"
+		raid_type             = "%s"
+		drives                = ["%s"]
+		initialization        = "%s"
+	  }
+	  `,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		storage_controller_id,
+		volume_name,
+		raid_type,
+		drives,
+		initialization,
+	)
+}
+
+func testAccRedfishResourceStorageVolumeProtectionInformationConfig(testingInfo TestingServerCredentials,
+	storage_controller_id string,
+	volume_name string,
+	raid_type string,
+	drives string,
+	protection_information string,
+) string {
+	return fmt.Sprintf(`
+	resource "redfish_storage_volume" "volume" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+
+		storage_controller_id  = "%s"
+		volume_name            = "%s"
+		raid_type              = "%s"
+		drives                 = ["%s"]
+		protection_information = "%s"
+	  }
+	  `,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		storage_controller_id,
+		volume_name,
+		raid_type,
+		drives,
+		protection_information,
+	)
+}
+
+func testAccRedfishResourceStorageVolumePreRebootDelayConfig(testingInfo TestingServerCredentials,
+	storage_controller_id string,
+	volume_name string,
+	raid_type string,
+	drives string,
+	pre_reboot_delay int,
+) string {
+	return fmt.Sprintf(`
+	resource "redfish_storage_volume" "volume" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+	    system_id = "System.Embedded.1"
+		storage_controller_id = "%s"
+		volume_name           = "%s"
+		raid_type             = "%s"
+		drives                = ["%s"]
+		settings_apply_time   = "OnReset"
+		pre_reboot_delay      = %d
+	  }
+	  `,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		storage_controller_id,
+		volume_name,
+		raid_type,
+		drives,
+		pre_reboot_delay,
+	)
+}
+
+func testAccRedfishResourceStorageVolumeApplyTimeConfig(testingInfo TestingServerCredentials,
+	storage_controller_id string,
+	volume_name string,
+	raid_type string,
+	drives string,
+	settings_apply_time string,
+) string {
+	return fmt.Sprintf(`
+	resource "redfish_storage_volume" "volume" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+	    system_id = "System.Embedded.1"
+		storage_controller_id = "%s"
+		volume_name           = "%s"
+		raid_type             = "%s"
+		drives                = ["%s"]
+		settings_apply_time   = "%s"
+	  }
+	  `,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		storage_controller_id,
+		volume_name,
+		raid_type,
+		drives,
+		settings_apply_time,
+	)
+}
+
+func testAccRedfishResourceStorageVolumeJobCheckIntervalConfig(testingInfo TestingServerCredentials,
+	storage_controller_id string,
+	volume_name string,
+	raid_type string,
+	drives string,
+	job_check_interval int,
+	volume_job_timeout int,
+) string {
+	return fmt.Sprintf(`
+	resource "redfish_storage_volume" "volume" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+		storage_controller_id = "%s"
+		volume_name           = "%s"
+		raid_type             = "%s"
+		drives                = ["%s"]
+		job_check_interval    = %d
+		volume_job_timeout    = %d
+	  }
+	  `,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		storage_controller_id,
+		volume_name,
+		raid_type,
+		drives,
+		job_check_interval,
+		volume_job_timeout,
+	)
+}
+
+func testAccRedfishResourceStorageVolumeNoDrivesConfig(testingInfo TestingServerCredentials,
+	storage_controller_id string,
+	volume_name string,
+) string {
+	return fmt.Sprintf(`
+	resource "redfish_storage_volume" "volume" {
+		redfish_server {
+		  user         = "%s"
+		  password     = "%s"
+		  endpoint     = "%s"
+		  ssl_insecure = true
+		}
+		storage_controller_id = "%s"
+		volume_name           = "%s"
+	  }
+	  `,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		storage_controller_id,
+		volume_name,
+	)
+}
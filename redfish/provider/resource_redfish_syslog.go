@@ -0,0 +1,400 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	syslogEnableAttribute = "SysLog.1.SysLogEnable"
+	syslogPortAttribute   = "SysLog.1.Port"
+	// syslogServerSlots is the number of SysLog.1.Server<N> attributes of the iDRAC
+	syslogServerSlots = 3
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &syslogResource{}
+	_ resource.ResourceWithImportState = &syslogResource{}
+)
+
+// NewSyslogResource is a helper function to simplify the provider implementation.
+func NewSyslogResource() resource.Resource {
+	return &syslogResource{}
+}
+
+// syslogResource is the resource implementation.
+type syslogResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *syslogResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_syslog configured")
+}
+
+// Metadata returns the resource type name.
+func (*syslogResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "syslog"
+}
+
+// Schema defines the schema for the resource.
+func (*syslogResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to configure the remote syslog forwarding of the iDRAC, through" +
+			" its `SysLog` attributes. The iDRAC is reset when it asks for it to apply the configuration.",
+		Description: "This Terraform resource is used to configure the remote syslog forwarding of the iDRAC, through" +
+			" its SysLog attributes. The iDRAC is reset when it asks for it to apply the configuration.",
+		Attributes: SyslogSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// SyslogSchema to define the syslog schema
+func SyslogSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the syslog resource. It is the URI of the iDRAC attributes.",
+			Description:         "ID of the syslog resource. It is the URI of the iDRAC attributes.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the iDRAC forwards its logs to `servers`, i.e. the `SysLog.1.SysLogEnable` attribute.",
+			Description:         "Whether the iDRAC forwards its logs to servers, i.e. the SysLog.1.SysLogEnable attribute.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"servers": schema.ListAttribute{
+			MarkdownDescription: "IP addresses or host names of the remote syslog servers, i.e. the `SysLog.1.Server1` to" +
+				" `SysLog.1.Server3` attributes. Up to 3 servers, the server attributes beyond the list are cleared.",
+			Description: "IP addresses or host names of the remote syslog servers, i.e. the SysLog.1.Server1 to" +
+				" SysLog.1.Server3 attributes. Up to 3 servers, the server attributes beyond the list are cleared.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(syslogServerSlots),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(hostnameOrIPValidator{}),
+			},
+		},
+		"port": schema.Int64Attribute{
+			MarkdownDescription: "UDP port of the remote syslog servers, i.e. the `SysLog.1.Port` attribute.",
+			Description:         "UDP port of the remote syslog servers, i.e. the SysLog.1.Port attribute.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+		},
+		"reset_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Time in seconds that the provider waits for the iDRAC to come back, when it must be reset" +
+				" to apply the syslog configuration. Default is `300`.",
+			Description: "Time in seconds that the provider waits for the iDRAC to come back, when it must be reset" +
+				" to apply the syslog configuration. Default is 300.",
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(int64(defaultCheckTimeout)),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(int64(managerRestartGracePeriod)),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *syslogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_syslog create: started")
+	var plan models.Syslog
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applySyslog(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_syslog create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *syslogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_syslog read: started")
+	var state models.Syslog
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(readSyslog(ctx, api.Service, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_syslog read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *syslogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_syslog update: started")
+	var plan models.Syslog
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applySyslog(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_syslog update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// The syslog configuration of the iDRAC is left as is.
+func (*syslogResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_syslog delete: started")
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_syslog delete: finished")
+}
+
+// ImportState import state for existing syslog configuration
+func (r *syslogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	type creds struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Endpoint     string `json:"endpoint"`
+		SslInsecure  bool   `json:"ssl_insecure"`
+		RedfishAlias string `json:"redfish_alias"`
+	}
+
+	var c creds
+	err := json.Unmarshal([]byte(req.ID), &c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
+	}
+
+	server := models.RedfishServer{
+		User:         types.StringValue(c.Username),
+		Password:     types.StringValue(c.Password),
+		Endpoint:     types.StringValue(c.Endpoint),
+		SslInsecure:  types.BoolValue(c.SslInsecure),
+		RedfishAlias: types.StringValue(c.RedfishAlias),
+	}
+	state := models.Syslog{
+		RedfishServer: []models.RedfishServer{server},
+		ResetTimeout:  types.Int64Value(int64(defaultCheckTimeout)),
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(readSyslog(ctx, api.Service, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// applySyslog patches the planned syslog configuration and, when the iDRAC asks for it, resets the iDRAC
+// and waits for it to come back before reading the configuration back.
+func (r *syslogResource) applySyslog(ctx context.Context, plan *models.Syslog) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The iDRAC reset interrupts every other operation on the endpoint
+	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
+	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	diags.Append(r.p.tlsDiagnostics()...)
+
+	attributes, d := syslogAttributes(ctx, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		api.Logout()
+		return diags
+	}
+	attributesMap, d := types.MapValueFrom(ctx, types.StringType, attributes)
+	diags.Append(d...)
+	if diags.HasError() {
+		api.Logout()
+		return diags
+	}
+	resetRequired, d := patchRedfishDellIdracAttributes(ctx, api.Service, &models.DellIdracAttributes{Attributes: attributesMap})
+	diags.Append(d...)
+	if diags.HasError() {
+		api.Logout()
+		return diags
+	}
+
+	if !resetRequired {
+		diags.Append(readSyslog(ctx, api.Service, plan)...)
+		api.Logout()
+		return diags
+	}
+
+	tflog.Info(ctx, "the iDRAC asked for a reset to apply the syslog configuration")
+	err = resetIdrac(api.Service, redfish.GracefulRestartResetType)
+	api.Logout()
+	if err != nil {
+		diags.AddError("Error resetting iDRAC", err.Error())
+		return diags
+	}
+	// The session doesn't survive the reset, so a new one is opened on every attempt
+	connect := func() error {
+		api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+		if err != nil {
+			return err
+		}
+		api.Logout()
+		return nil
+	}
+	err = waitForManagerRestart(ctx, connect, time.Duration(defaultCheckInterval)*time.Second,
+		time.Duration(managerRestartGracePeriod)*time.Second, time.Duration(plan.ResetTimeout.ValueInt64())*time.Second)
+	if err != nil {
+		diags.AddError("Error while rebooting iDRAC. Operation may take longer duration to complete", err.Error())
+		return diags
+	}
+
+	api, err = NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	diags.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+	diags.Append(readSyslog(ctx, api.Service, plan)...)
+	return diags
+}
+
+// syslogServerAttribute returns the name of the iDRAC attribute of a syslog server, e.g. SysLog.1.Server1.
+func syslogServerAttribute(slot int) string {
+	return fmt.Sprintf("SysLog.1.Server%d", slot)
+}
+
+// syslogAttributes returns the iDRAC attributes to set for the planned syslog configuration. The servers
+// beyond the list are cleared. Settings left to the iDRAC are unknown in the plan and are not returned.
+func syslogAttributes(ctx context.Context, plan *models.Syslog) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	attributes := make(map[string]string)
+	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() {
+		attributes[syslogEnableAttribute] = "Disabled"
+		if plan.Enabled.ValueBool() {
+			attributes[syslogEnableAttribute] = "Enabled"
+		}
+	}
+	if !plan.Servers.IsNull() && !plan.Servers.IsUnknown() {
+		var servers []string
+		diags.Append(plan.Servers.ElementsAs(ctx, &servers, false)...)
+		for slot := 1; slot <= syslogServerSlots; slot++ {
+			server := ""
+			if slot <= len(servers) {
+				server = servers[slot-1]
+			}
+			attributes[syslogServerAttribute(slot)] = server
+		}
+	}
+	if !plan.Port.IsNull() && !plan.Port.IsUnknown() {
+		attributes[syslogPortAttribute] = strconv.FormatInt(plan.Port.ValueInt64(), 10)
+	}
+	return attributes, diags
+}
+
+// readSyslog sets the live syslog configuration of the iDRAC into d.
+func readSyslog(ctx context.Context, service *gofish.Service, d *models.Syslog) diag.Diagnostics {
+	var diags diag.Diagnostics
+	idracAttributes, err := getDellIdracAttributes(service)
+	if err != nil {
+		diags.AddError("there was an issue when reading the iDRAC syslog configuration", err.Error())
+		return diags
+	}
+
+	stringAttribute := func(name string) string {
+		value, _ := idracAttributes.Attributes[name].(string)
+		return value
+	}
+	servers := []string{}
+	for slot := 1; slot <= syslogServerSlots; slot++ {
+		if server := stringAttribute(syslogServerAttribute(slot)); server != "" {
+			servers = append(servers, server)
+		}
+	}
+	serverList, d2 := types.ListValueFrom(ctx, types.StringType, servers)
+	diags.Append(d2...)
+
+	d.ID = types.StringValue(idracAttributes.ODataID)
+	d.Enabled = types.BoolValue(stringAttribute(syslogEnableAttribute) == "Enabled")
+	d.Servers = serverList
+	// JSON numbers are decoded as float64
+	d.Port = types.Int64Null()
+	if port, ok := idracAttributes.Attributes[syslogPortAttribute].(float64); ok {
+		d.Port = types.Int64Value(int64(port))
+	}
+	return diags
+}
@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to configure the remote syslog servers of the iDRAC and import the resource
+func TestAccRedfishSyslog_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceSyslog(creds, `enabled = true
+				servers = ["192.168.0.30", "syslog.example.com"]
+				port = 514`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_syslog.syslog", "enabled", "true"),
+					resource.TestCheckResourceAttr("redfish_syslog.syslog", "servers.#", "2"),
+					resource.TestCheckResourceAttr("redfish_syslog.syslog", "port", "514"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceSyslog(creds, `enabled = false
+				servers = ["192.168.0.30"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_syslog.syslog", "enabled", "false"),
+					resource.TestCheckResourceAttr("redfish_syslog.syslog", "servers.#", "1"),
+				),
+			},
+			{
+				ResourceName:  "redfish_syslog.syslog",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf(`{"username":"%s","password":"%s","endpoint":"%s","ssl_insecure":true}`, creds.Username, creds.Password, creds.Endpoint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_syslog.syslog", "servers.0", "192.168.0.30"),
+				),
+			},
+		},
+	})
+}
+
+// Test to configure more syslog servers than the iDRAC supports- Negative
+func TestAccRedfishSyslog_TooManyServers_Negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceSyslog(creds, `servers = ["10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"]`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+		},
+	})
+}
+
+func TestSyslogAttributes(t *testing.T) {
+	ctx := context.Background()
+	servers, _ := types.ListValueFrom(ctx, types.StringType, []string{"192.168.0.30"})
+	plan := &models.Syslog{
+		Enabled: types.BoolValue(true),
+		Servers: servers,
+		Port:    types.Int64Unknown(),
+	}
+	want := map[string]string{
+		syslogEnableAttribute: "Enabled",
+		"SysLog.1.Server1":    "192.168.0.30",
+		"SysLog.1.Server2":    "",
+		"SysLog.1.Server3":    "",
+	}
+	got, diags := syslogAttributes(ctx, plan)
+	if diags.HasError() || len(got) != len(want) {
+		t.Fatalf("syslogAttributes() = %v, expected %v", got, want)
+	}
+	for name, value := range want {
+		if got[name] != value {
+			t.Errorf("syslogAttributes()[%s] = %q, expected %q", name, got[name], value)
+		}
+	}
+
+	plan = &models.Syslog{
+		Enabled: types.BoolUnknown(),
+		Servers: types.ListUnknown(types.StringType),
+		Port:    types.Int64Value(1514),
+	}
+	got, _ = syslogAttributes(ctx, plan)
+	if len(got) != 1 || got[syslogPortAttribute] != "1514" {
+		t.Errorf("expected only the port to be set, got %v", got)
+	}
+}
+
+func testAccRedfishResourceSyslog(testingInfo TestingServerCredentials, syslog string) string {
+	return fmt.Sprintf(`
+	resource "redfish_syslog" "syslog" {
+		redfish_server {
+			user         = "%s"
+			password     = "%s"
+			endpoint     = "%s"
+			ssl_insecure = true
+		}
+
+		%s
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		syslog,
+	)
+}
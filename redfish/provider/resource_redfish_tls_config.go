@@ -0,0 +1,504 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &tlsConfigResource{}
+	_ resource.ResourceWithModifyPlan  = &tlsConfigResource{}
+	_ resource.ResourceWithImportState = &tlsConfigResource{}
+)
+
+const (
+	// iDRAC attributes of the web server TLS settings
+	tlsProtocolAttribute        = "WebServer.1.TLSProtocol"
+	cipherSuitePolicyAttribute  = "WebServer.1.SSLEncryptionBitLength"
+	customCipherStringAttribute = "WebServer.1.CustomCipherString"
+	tlsReconnectWarning         = "Changing the TLS settings of the iDRAC may drop the provider's connection"
+)
+
+// tlsProtocolVersionRegex extracts the minimum TLS version of a TLSProtocol value, e.g. "TLS 1.2 and Higher"
+var tlsProtocolVersionRegex = regexp.MustCompile(`TLS ?(1\.[0-3])`)
+
+// NewTLSConfigResource is a helper function to simplify the provider implementation.
+func NewTLSConfigResource() resource.Resource {
+	return &tlsConfigResource{}
+}
+
+// tlsConfigResource is the resource implementation.
+type tlsConfigResource struct {
+	p *redfishProvider
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *tlsConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*redfishProvider)
+	tflog.Trace(ctx, "resource_tls_config configured")
+}
+
+// Metadata returns the resource type name.
+func (*tlsConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "tls_config"
+}
+
+// Schema defines the schema for the resource.
+func (*tlsConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform resource is used to enforce the minimum TLS version and the cipher suite policy " +
+			"of the iDRAC web server. The iDRAC is reset to apply a change. Tightening the TLS settings may drop the " +
+			"connection of the provider itself, which then needs to reconnect with the new settings.",
+		Description: "This Terraform resource is used to enforce the minimum TLS version and the cipher suite policy " +
+			"of the iDRAC web server. The iDRAC is reset to apply a change. Tightening the TLS settings may drop the " +
+			"connection of the provider itself, which then needs to reconnect with the new settings.",
+		Attributes: TLSConfigSchema(),
+		Blocks:     RedfishServerResourceBlockMap(),
+	}
+}
+
+// TLSConfigSchema to define the TLS config schema
+func TLSConfigSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the TLS config resource. It is the URI of the iDRAC attributes.",
+			Description:         "ID of the TLS config resource. It is the URI of the iDRAC attributes.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"min_tls_version": schema.StringAttribute{
+			MarkdownDescription: "Minimum TLS version accepted by the iDRAC web server. Accepted values: `1.1`, `1.2`, `1.3`." +
+				" The version must be offered by the `WebServer.1.TLSProtocol` attribute of the iDRAC firmware.",
+			Description: "Minimum TLS version accepted by the iDRAC web server. Accepted values: 1.1, 1.2, 1.3." +
+				" The version must be offered by the WebServer.1.TLSProtocol attribute of the iDRAC firmware.",
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("1.1", "1.2", "1.3"),
+			},
+		},
+		"tls_protocol": schema.StringAttribute{
+			MarkdownDescription: "TLS protocol setting enforced by the iDRAC, e.g. `TLS 1.2 and Higher`.",
+			Description:         "TLS protocol setting enforced by the iDRAC, e.g. TLS 1.2 and Higher.",
+			Computed:            true,
+		},
+		"cipher_suite_policy": schema.StringAttribute{
+			MarkdownDescription: "Cipher suite policy of the iDRAC web server, i.e. its `WebServer.1.SSLEncryptionBitLength` attribute," +
+				" e.g. `Auto-Negotiate`, `128-Bit or higher`, `168-Bit or higher` or `256-Bit or higher`." +
+				" When unset, the policy of the iDRAC is kept.",
+			Description: "Cipher suite policy of the iDRAC web server, i.e. its WebServer.1.SSLEncryptionBitLength attribute," +
+				" e.g. Auto-Negotiate, 128-Bit or higher, 168-Bit or higher or 256-Bit or higher." +
+				" When unset, the policy of the iDRAC is kept.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"custom_cipher_string": schema.StringAttribute{
+			MarkdownDescription: "OpenSSL cipher string restricting the cipher suites of the iDRAC web server, i.e. its" +
+				" `WebServer.1.CustomCipherString` attribute, e.g. `ALL:!RC4:!3DES`. Only supported by recent iDRAC firmwares." +
+				" When unset, the cipher string of the iDRAC is kept.",
+			Description: "OpenSSL cipher string restricting the cipher suites of the iDRAC web server, i.e. its" +
+				" WebServer.1.CustomCipherString attribute, e.g. ALL:!RC4:!3DES. Only supported by recent iDRAC firmwares." +
+				" When unset, the cipher string of the iDRAC is kept.",
+			Optional: true,
+			Computed: true,
+		},
+		"reset_timeout": schema.Int64Attribute{
+			MarkdownDescription: "Time in seconds that the provider waits for the iDRAC to come back after a reset. Default is `300`.",
+			Description:         "Time in seconds that the provider waits for the iDRAC to come back after a reset. Default is 300.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(int64(defaultCheckTimeout)),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(int64(managerRestartGracePeriod)),
+			},
+		},
+	}
+}
+
+// ModifyPlan warns that the connection of the provider may drop when the TLS settings change.
+func (*tlsConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan models.TLSConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state models.TLSConfig
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.MinTLSVersion.Equal(state.MinTLSVersion) && plan.CipherSuitePolicy.Equal(state.CipherSuitePolicy) &&
+			plan.CustomCipherString.Equal(state.CustomCipherString) {
+			return
+		}
+	}
+	resp.Diagnostics.AddWarning(tlsReconnectWarning,
+		"The iDRAC is reset to apply the TLS settings. If the provider, or any other client of the iDRAC, doesn't support "+
+			"them, its connection is dropped and it has to reconnect with a TLS version and cipher suites the iDRAC still accepts.")
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *tlsConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "resource_tls_config create: started")
+	var plan models.TLSConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyTLSConfig(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_tls_config create: finished")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *tlsConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "resource_tls_config read: started")
+	var state models.TLSConfig
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(readTLSConfig(api.Service, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "resource_tls_config read: finished")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *tlsConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "resource_tls_config update: started")
+	var plan models.TLSConfig
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyTLSConfig(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Trace(ctx, "resource_tls_config update: finished")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// The TLS settings are left as is, as reverting them could weaken the iDRAC.
+func (*tlsConfigResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "resource_tls_config delete: started")
+	resp.State.RemoveResource(ctx)
+	tflog.Trace(ctx, "resource_tls_config delete: finished")
+}
+
+// ImportState import state for existing TLS settings
+func (r *tlsConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	type creds struct {
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		Endpoint     string `json:"endpoint"`
+		SslInsecure  bool   `json:"ssl_insecure"`
+		RedfishAlias string `json:"redfish_alias"`
+	}
+
+	var c creds
+	err := json.Unmarshal([]byte(req.ID), &c)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
+		return
+	}
+
+	server := models.RedfishServer{
+		User:         types.StringValue(c.Username),
+		Password:     types.StringValue(c.Password),
+		Endpoint:     types.StringValue(c.Endpoint),
+		SslInsecure:  types.BoolValue(c.SslInsecure),
+		RedfishAlias: types.StringValue(c.RedfishAlias),
+	}
+	state := models.TLSConfig{
+		RedfishServer: []models.RedfishServer{server},
+		ResetTimeout:  types.Int64Value(int64(defaultCheckTimeout)),
+	}
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+
+	resp.Diagnostics.Append(readTLSConfig(api.Service, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// applyTLSConfig patches the planned TLS settings, resets the iDRAC to apply them and reads them back once
+// the iDRAC is reachable again. Nothing is patched when the iDRAC already enforces the settings.
+func (r *tlsConfigResource) applyTLSConfig(ctx context.Context, plan *models.TLSConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	tlsError := "there was an issue when configuring the iDRAC TLS settings"
+
+	// The iDRAC reset interrupts every other operation on the endpoint
+	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
+	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	diags.Append(r.p.tlsDiagnostics()...)
+	registry, err := getManagerAttributeRegistry(api.Service)
+	if err != nil {
+		api.Logout()
+		diags.AddError(tlsError, err.Error())
+		return diags
+	}
+	attributes, err := tlsConfigAttributes(registry, plan)
+	if err != nil {
+		api.Logout()
+		diags.AddError(tlsError, err.Error())
+		return diags
+	}
+
+	current := *plan
+	diags.Append(readTLSConfig(api.Service, &current)...)
+	if diags.HasError() {
+		api.Logout()
+		return diags
+	}
+	if !tlsConfigChanged(&current, attributes) {
+		api.Logout()
+		*plan = current
+		return diags
+	}
+
+	attributesMap, d := types.MapValueFrom(ctx, types.StringType, attributes)
+	diags.Append(d...)
+	if diags.HasError() {
+		api.Logout()
+		return diags
+	}
+	_, d = patchRedfishDellIdracAttributes(ctx, api.Service, &models.DellIdracAttributes{Attributes: attributesMap})
+	diags.Append(d...)
+	if diags.HasError() {
+		api.Logout()
+		return diags
+	}
+
+	err = resetIdrac(api.Service, redfish.GracefulRestartResetType)
+	api.Logout()
+	if err != nil {
+		diags.AddError("Error resetting iDRAC", err.Error())
+		return diags
+	}
+	// The session doesn't survive the reset, so a new one is opened on every attempt
+	connect := func() error {
+		api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
+		if err != nil {
+			return err
+		}
+		api.Logout()
+		return nil
+	}
+	err = waitForManagerRestart(ctx, connect, time.Duration(defaultCheckInterval)*time.Second,
+		time.Duration(managerRestartGracePeriod)*time.Second, time.Duration(plan.ResetTimeout.ValueInt64())*time.Second)
+	if err != nil {
+		diags.AddError("Error reconnecting to the iDRAC after the TLS change",
+			fmt.Sprintf("%s. %s: the iDRAC may reject the TLS version or the cipher suites of the provider.", err.Error(), tlsReconnectWarning))
+		return diags
+	}
+
+	api, err = NewConfig(ctx, r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError(ServiceErrorMsg, err.Error())
+		return diags
+	}
+	diags.Append(r.p.tlsDiagnostics()...)
+	defer api.Logout()
+	diags.Append(readTLSConfig(api.Service, plan)...)
+	return diags
+}
+
+// tlsConfigAttributes returns the iDRAC attributes to set for the planned TLS settings.
+// Settings left to the iDRAC are unknown in the plan and are not returned.
+func tlsConfigAttributes(registry *dell.ManagerAttributeRegistry, plan *models.TLSConfig) (map[string]string, error) {
+	var protocols []dell.AttributeEnumValue
+	for _, attribute := range registry.Attributes {
+		if attribute.AttributeName == tlsProtocolAttribute {
+			protocols = attribute.Value
+		}
+	}
+	protocol, err := tlsProtocolValue(protocols, plan.MinTLSVersion.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := map[string]string{tlsProtocolAttribute: protocol}
+	if !plan.CipherSuitePolicy.IsNull() && !plan.CipherSuitePolicy.IsUnknown() {
+		attributes[cipherSuitePolicyAttribute] = plan.CipherSuitePolicy.ValueString()
+	}
+	if !plan.CustomCipherString.IsNull() && !plan.CustomCipherString.IsUnknown() {
+		attributes[customCipherStringAttribute] = plan.CustomCipherString.ValueString()
+	}
+	return attributes, nil
+}
+
+// tlsProtocolValue returns the TLSProtocol value enforcing version as the minimum TLS version,
+// preferring "TLS <version> and Higher" over the values only accepting that version.
+func tlsProtocolValue(protocols []dell.AttributeEnumValue, version string) (string, error) {
+	if len(protocols) == 0 {
+		return "", fmt.Errorf("attribute %s was not found", tlsProtocolAttribute)
+	}
+	match := ""
+	names := make([]string, 0, len(protocols))
+	for _, protocol := range protocols {
+		names = append(names, protocol.ValueDisplayName)
+		if minTLSVersion(protocol.ValueDisplayName) != version {
+			continue
+		}
+		if strings.Contains(strings.ToLower(protocol.ValueDisplayName), "higher") {
+			return protocol.ValueDisplayName, nil
+		}
+		if match == "" {
+			match = protocol.ValueDisplayName
+		}
+	}
+	if match == "" {
+		return "", fmt.Errorf("TLS %s is not supported by the iDRAC, its TLS protocols are: %s", version, strings.Join(names, ", "))
+	}
+	return match, nil
+}
+
+// minTLSVersion returns the minimum TLS version of a TLSProtocol value, or an empty string if it has none.
+func minTLSVersion(protocol string) string {
+	if matches := tlsProtocolVersionRegex.FindStringSubmatch(protocol); matches != nil {
+		return matches[1]
+	}
+	return ""
+}
+
+// tlsConfigChanged reports whether the attributes differ from the settings currently enforced by the iDRAC.
+func tlsConfigChanged(current *models.TLSConfig, attributes map[string]string) bool {
+	live := map[string]string{
+		tlsProtocolAttribute:        current.TLSProtocol.ValueString(),
+		cipherSuitePolicyAttribute:  current.CipherSuitePolicy.ValueString(),
+		customCipherStringAttribute: current.CustomCipherString.ValueString(),
+	}
+	for name, value := range attributes {
+		if live[name] != value {
+			return true
+		}
+	}
+	return false
+}
+
+// readTLSConfig sets the TLS settings enforced by the iDRAC into d.
+func readTLSConfig(service *gofish.Service, d *models.TLSConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	tlsError := "there was an issue when reading the iDRAC TLS settings"
+	dellManager, err := getDellManager(service)
+	if err != nil {
+		diags.AddError(tlsError, err.Error())
+		return diags
+	}
+	dellAttributes, err := dellManager.DellAttributes()
+	if err != nil {
+		diags.AddError(tlsError, err.Error())
+		return diags
+	}
+	idracAttributes, err := getIdracAttributes(dellAttributes)
+	if err != nil {
+		diags.AddError(tlsError, err.Error())
+		return diags
+	}
+
+	stringAttribute := func(name string) string {
+		value, _ := idracAttributes.Attributes[name].(string)
+		return value
+	}
+	protocol := stringAttribute(tlsProtocolAttribute)
+	if protocol == "" {
+		diags.AddAttributeError(path.Root("min_tls_version"), tlsError,
+			fmt.Sprintf("attribute %s was not found", tlsProtocolAttribute))
+		return diags
+	}
+
+	d.ID = types.StringValue(idracAttributes.ODataID)
+	d.TLSProtocol = types.StringValue(protocol)
+	if version := minTLSVersion(protocol); version != "" {
+		d.MinTLSVersion = types.StringValue(version)
+	}
+	d.CipherSuitePolicy = types.StringValue(stringAttribute(cipherSuitePolicyAttribute))
+	d.CustomCipherString = types.StringValue(stringAttribute(customCipherStringAttribute))
+	return diags
+}
@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-redfish/gofish/dell"
+	"terraform-provider-redfish/redfish/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test to enforce TLS 1.2 on the iDRAC web server and import the resource
+func TestAccRedfishTLSConfig_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceTLSConfig(creds, `min_tls_version = "1.2"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_tls_config.tls", "min_tls_version", "1.2"),
+					resource.TestCheckResourceAttrSet("redfish_tls_config.tls", "tls_protocol"),
+					resource.TestCheckResourceAttrSet("redfish_tls_config.tls", "cipher_suite_policy"),
+				),
+			},
+			{
+				ResourceName:  "redfish_tls_config.tls",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf(`{"username":"%s","password":"%s","endpoint":"%s","ssl_insecure":true}`, creds.Username, creds.Password, creds.Endpoint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("redfish_tls_config.tls", "min_tls_version", "1.2"),
+				),
+			},
+		},
+	})
+}
+
+// Test to set an unknown TLS version- Negative
+func TestAccRedfishTLSConfig_InvalidVersion_Negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceTLSConfig(creds, `min_tls_version = "1.0"`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func TestTLSProtocolValue(t *testing.T) {
+	protocols := []dell.AttributeEnumValue{
+		{ValueDisplayName: "TLS 1.1 and Higher", ValueName: "TLS 1.1 and Higher"},
+		{ValueDisplayName: "TLS 1.2 Only", ValueName: "TLS 1.2 Only"},
+		{ValueDisplayName: "TLS 1.2 and Higher", ValueName: "TLS 1.2 and Higher"},
+		{ValueDisplayName: "TLS 1.3 only", ValueName: "TLS 1.3 only"},
+	}
+	tests := []struct {
+		version string
+		want    string
+		wantErr bool
+	}{
+		{version: "1.1", want: "TLS 1.1 and Higher"},
+		{version: "1.2", want: "TLS 1.2 and Higher"},
+		{version: "1.3", want: "TLS 1.3 only"},
+	}
+	for _, tt := range tests {
+		got, err := tlsProtocolValue(protocols, tt.version)
+		if err != nil || got != tt.want {
+			t.Errorf("tlsProtocolValue(%s) = %q, %v, expected %q", tt.version, got, err, tt.want)
+		}
+	}
+
+	if _, err := tlsProtocolValue(protocols[:3], "1.3"); err == nil {
+		t.Errorf("expected an error for a TLS version the iDRAC doesn't offer")
+	}
+	if _, err := tlsProtocolValue(nil, "1.2"); err == nil {
+		t.Errorf("expected an error when the iDRAC has no TLS protocol attribute")
+	}
+}
+
+func TestTLSConfigChanged(t *testing.T) {
+	current := &models.TLSConfig{
+		TLSProtocol:        types.StringValue("TLS 1.2 and Higher"),
+		CipherSuitePolicy:  types.StringValue("128-Bit or higher"),
+		CustomCipherString: types.StringValue(""),
+	}
+	if tlsConfigChanged(current, map[string]string{tlsProtocolAttribute: "TLS 1.2 and Higher"}) {
+		t.Errorf("expected no change for the enforced TLS protocol")
+	}
+	if !tlsConfigChanged(current, map[string]string{
+		tlsProtocolAttribute:       "TLS 1.2 and Higher",
+		cipherSuitePolicyAttribute: "256-Bit or higher",
+	}) {
+		t.Errorf("expected a change for a new cipher suite policy")
+	}
+}
+
+func testAccRedfishResourceTLSConfig(testingInfo TestingServerCredentials, tls string) string {
+	return fmt.Sprintf(`
+	resource "redfish_tls_config" "tls" {
+		redfish_server {
+			user         = "%s"
+			password     = "%s"
+			endpoint     = "%s"
+			ssl_insecure = true
+		}
+
+		%s
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		tls,
+	)
+}
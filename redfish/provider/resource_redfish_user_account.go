@@ -159,11 +159,12 @@ func (r *UserAccountResource) Create(ctx context.Context, req resource.CreateReq
 	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
 	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -226,28 +227,26 @@ func (r *UserAccountResource) Create(ctx context.Context, req resource.CreateReq
 			}
 		}
 
-		for _, account := range accountList {
-			if len(account.UserName) == 0 && account.ID != "1" { // ID 1 is reserved
-				if len(userID) > 0 {
-					// update the account.ODataID URL to new account ID
-					account.ID = userID
-					url, _ := filepath.Split(account.ODataID)
-					account.ODataID = url + account.ID
-				} else {
-					userID = account.ID
-				}
-				// Ideally a go routine for each server should be done
-				_, err = service.GetClient().Patch(account.ODataID, payload)
-				if err != nil {
-					resp.Diagnostics.AddError(RedfishAPIErrorMsg, err.Error()) // This error might happen when a user was created outside terraform
-					return
-				}
-				break
-			} else if account.ID == "17" {
-				// No room for new users
-				resp.Diagnostics.AddError("There is no room for new users", "Please remove an existing user to proceed")
-				return
-			}
+		// iDRAC accounts live in fixed slots, a new user takes over an empty one
+		account := findFreeAccountSlot(accountList)
+		if account == nil {
+			// No room for new users
+			resp.Diagnostics.AddError("There is no room for new users", "Please remove an existing user to proceed")
+			return
+		}
+		accountURI := account.ODataID
+		if len(userID) > 0 {
+			// update the account URL to new account ID
+			url, _ := filepath.Split(account.ODataID)
+			accountURI = url + userID
+		} else {
+			userID = account.ID
+		}
+		// Ideally a go routine for each server should be done
+		_, err = service.GetClient().Patch(accountURI, payload)
+		if err != nil {
+			resp.Diagnostics.AddError(RedfishAPIErrorMsg, err.Error()) // This error might happen when a user was created outside terraform
+			return
 		}
 	} else {
 		// Create new user account for generation 17 and above
@@ -320,21 +319,24 @@ func (r *UserAccountResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
 	_, account, err := GetUserAccountFromID(service, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(RedfishFetchErrorMsg, err.Error())
+		return
 	}
 
 	if account == nil { // User doesn't exist. Needs to be recreated.
-		resp.Diagnostics.AddError("Error when retrieving accounts", "User does not exists, needs to be recreated")
+		tflog.Info(ctx, "resource_user_account read: user does not exist anymore, removing it from state")
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -371,11 +373,12 @@ func (r *UserAccountResource) Update(ctx context.Context, req resource.UpdateReq
 	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
 	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
 
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -389,6 +392,11 @@ func (r *UserAccountResource) Update(ctx context.Context, req resource.UpdateReq
 	accountList, account, err := GetUserAccountFromID(service, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(RedfishFetchErrorMsg, err.Error())
+		return
+	}
+	if account == nil {
+		resp.Diagnostics.AddError("Error when retrieving accounts", "User does not exists, needs to be recreated")
+		return
 	}
 
 	if plan.UserID.ValueString() != "" && plan.UserID.ValueString() != account.ID {
@@ -451,11 +459,15 @@ func (r *UserAccountResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	redfishMutexKV.Lock(state.RedfishServer[0].Endpoint.ValueString())
+	defer redfishMutexKV.Unlock(state.RedfishServer[0].Endpoint.ValueString())
+
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -468,12 +480,17 @@ func (r *UserAccountResource) Delete(ctx context.Context, req resource.DeleteReq
 	_, account, err := GetUserAccountFromID(service, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(RedfishFetchErrorMsg, err.Error())
+		return
+	}
+	if account == nil { // User was already removed
+		tflog.Trace(ctx, "resource_user_account delete: user does not exist, nothing to delete")
+		return
 	}
 
 	if !isGenerationSeventeenAndAbove {
 		// First set Role ID as "" and Enabled as false
 		payload := make(map[string]interface{})
-		payload["Enable"] = "false"
+		payload["Enabled"] = false
 		payload["RoleId"] = "None"
 		_, err = service.GetClient().Patch(account.ODataID, payload)
 		if err != nil {
@@ -566,6 +583,16 @@ func getAccount(accountList []*redfish.ManagerAccount, id string) (*redfish.Mana
 	return nil, nil // This will be returned if there are no errors but the user does not exist
 }
 
+// findFreeAccountSlot returns the first empty account slot, skipping the reserved ID 1
+func findFreeAccountSlot(accountList []*redfish.ManagerAccount) *redfish.ManagerAccount {
+	for _, account := range accountList {
+		if len(account.UserName) == 0 && account.ID != "1" {
+			return account
+		}
+	}
+	return nil
+}
+
 // To check if given username is equal to any existing username
 func checkUserNameExists(accountList []*redfish.ManagerAccount, username string) error {
 	for _, account := range accountList {
@@ -147,11 +147,12 @@ func (r *UserAccountPasswordResource) Create(ctx context.Context, req resource.C
 	redfishMutexKV.Lock(redfishServer[0].Endpoint.ValueString())
 	defer redfishMutexKV.Unlock(redfishServer[0].Endpoint.ValueString())
 
-	api, err := NewConfig(r.p, &redfishServer)
+	api, err := NewConfig(ctx, r.p, &redfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -181,11 +182,12 @@ func (r *UserAccountPasswordResource) Create(ctx context.Context, req resource.C
 	// update password to new password and check if login is successful
 	redfishServer[0].Password = types.StringValue(plan.NewPassword.ValueString())
 
-	api, err = NewConfig(r.p, &redfishServer)
+	api, err = NewConfig(ctx, r.p, &redfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError("login failed using new password", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service = api.Service
 	defer api.Logout()
 
@@ -26,6 +26,8 @@ import (
 
 	"github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 const userID = "15"
@@ -63,6 +65,23 @@ func init() {
 	})
 }
 
+// Test that the first unused account slot is picked
+func TestFindFreeAccountSlot(t *testing.T) {
+	accounts := []*redfish.ManagerAccount{
+		{Entity: common.Entity{ID: "1"}},
+		{Entity: common.Entity{ID: "2"}, UserName: "root"},
+		{Entity: common.Entity{ID: "3"}, UserName: "admin"},
+		{Entity: common.Entity{ID: "4"}},
+		{Entity: common.Entity{ID: "5"}},
+	}
+	if slot := findFreeAccountSlot(accounts); slot == nil || slot.ID != "4" {
+		t.Fatalf("expected slot 4, got %v", slot)
+	}
+	if slot := findFreeAccountSlot(accounts[:3]); slot != nil {
+		t.Fatalf("expected no free slot, got %s", slot.ID)
+	}
+}
+
 // Test to create and update redfish user - Positive
 func TestAccRedfishUser_basic(t *testing.T) {
 	version := os.Getenv("TF_TESTING_REDFISH_VERSION")
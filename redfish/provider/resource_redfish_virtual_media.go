@@ -135,6 +135,28 @@ func VirtualMediaSchema() map[string]schema.Attribute {
 			Computed:            true,
 			Optional:            true,
 		},
+		"media_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the media to attach, used to select the virtual media slot. Applicable values are 'CD', 'DVD' and 'USBStick'." +
+				" When not set, the slot is selected from the image extension.",
+			Description: "Type of the media to attach, used to select the virtual media slot. Applicable values are 'CD', 'DVD' and 'USBStick'." +
+				" When not set, the slot is selected from the image extension.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					string(redfish.CDMediaType),
+					string(redfish.DVDMediaType),
+					string(redfish.USBStickMediaType),
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"connected_via": schema.StringAttribute{
+			MarkdownDescription: "Current virtual media connection method",
+			Description:         "Current virtual media connection method",
+			Computed:            true,
+		},
 		"username": schema.StringAttribute{
 			MarkdownDescription: "Username for the Redfish server",
 			Description:         "Username for the Redfish server",
@@ -194,12 +216,18 @@ func (r *virtualMediaResource) Create(ctx context.Context, req resource.CreateRe
 		UserName:             plan.UserName.ValueString(),
 		Password:             plan.Password.ValueString(),
 	}
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
+
+	// Lock the mutex to avoid concurrent mounts on the same server
+	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
+	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+
 	// Get Systems details
 	system, err := getSystemResource(service, plan.SystemID.ValueString())
 	if err != nil {
@@ -218,6 +246,9 @@ func (r *virtualMediaResource) Create(ctx context.Context, req resource.CreateRe
 		// This implementation is added to support iDRAC firmware version 6.x/7.x.
 		plan.SystemID = types.StringValue(env.System.ID)
 		for index := range virtualMediaCollection {
+			if !helper.SupportsMediaType(virtualMediaCollection[index], plan.MediaType.ValueString()) {
+				continue
+			}
 			virtualMedia, err := helper.InsertMedia(virtualMediaCollection[index].ID, virtualMediaCollection, virtualMediaConfig, service)
 			if err != nil {
 				resp.Diagnostics.AddError("Error while inserting virtual media", err.Error())
@@ -239,6 +270,11 @@ func (r *virtualMediaResource) Create(ctx context.Context, req resource.CreateRe
 		plan.SystemID = types.StringValue("")
 		if !plan.VirtualMediaID.IsNull() {
 			virtualMediaID = plan.VirtualMediaID.ValueString()
+		} else if !plan.MediaType.IsNull() {
+			virtualMediaID = "CD"
+			if plan.MediaType.ValueString() == string(redfish.USBStickMediaType) {
+				virtualMediaID = "RemovableDisk"
+			}
 		} else if strings.HasSuffix(plan.Image.ValueString(), ".iso") {
 			virtualMediaID = "CD"
 		} else {
@@ -277,11 +313,12 @@ func (r *virtualMediaResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get service
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
@@ -292,7 +329,8 @@ func (r *virtualMediaResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	if len(virtualMedia.Image) == 0 { // Nothing is mounted here
+	if len(virtualMedia.Image) == 0 { // Nothing is mounted here, the media has to be inserted again
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -333,11 +371,12 @@ func (r *virtualMediaResource) ImportState(ctx context.Context, req resource.Imp
 
 	creds := []models.RedfishServer{server}
 
-	api, err := NewConfig(r.p, &creds)
+	api, err := NewConfig(ctx, r.p, &creds)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	// Get Systems details
 	system, err := getSystemResource(service, c.SystemID)
@@ -381,10 +420,6 @@ func (r *virtualMediaResource) ImportState(ctx context.Context, req resource.Imp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *virtualMediaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Lock the mutex to avoid race conditions with other resources
-	// redfishMutexKV.Lock(getRedfishServerEndpoint(d))
-	// defer redfishMutexKV.Unlock(getRedfishServerEndpoint(d))
-
 	tflog.Trace(ctx, "resource_virtual_media update: started")
 	// Get state Data
 	var plan, state models.VirtualMedia
@@ -402,14 +437,19 @@ func (r *virtualMediaResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Get service
-	api, err := NewConfig(r.p, &plan.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &plan.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
+	// Lock the mutex to avoid concurrent mounts on the same server
+	redfishMutexKV.Lock(plan.RedfishServer[0].Endpoint.ValueString())
+	defer redfishMutexKV.Unlock(plan.RedfishServer[0].Endpoint.ValueString())
+
 	// Validate image extension
 	image := plan.Image.ValueString()
 	if !strings.HasSuffix(image, ".iso") && !strings.HasSuffix(image, ".img") {
@@ -483,14 +523,19 @@ func (r *virtualMediaResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Get service
-	api, err := NewConfig(r.p, &state.RedfishServer)
+	api, err := NewConfig(ctx, r.p, &state.RedfishServer)
 	if err != nil {
 		resp.Diagnostics.AddError(ServiceErrorMsg, err.Error())
 		return
 	}
+	resp.Diagnostics.Append(r.p.tlsDiagnostics()...)
 	service := api.Service
 	defer api.Logout()
 
+	// Lock the mutex to avoid race conditions with other resources
+	redfishMutexKV.Lock(state.RedfishServer[0].Endpoint.ValueString())
+	defer redfishMutexKV.Unlock(state.RedfishServer[0].Endpoint.ValueString())
+
 	// Get virtual media details and Eject Media
 	virtualMedia, err := helper.GetNejectVirtualMedia(service, state.ID.ValueString())
 	if err != nil {
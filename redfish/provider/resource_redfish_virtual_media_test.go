@@ -152,6 +152,20 @@ func TestAccRedfishVirtualMedia_InvalidImage_Negative(t *testing.T) {
 	})
 }
 
+// Test to create redfish virtual media with an invalid media type - Negative
+func TestAccRedfishVirtualMedia_InvalidMediaType_Negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceVirtualMediaMediaTypeConfig(creds, image64Boot, "Floppy"),
+				ExpectError: regexp.MustCompile("Attribute media_type value must be one of"),
+			},
+		},
+	})
+}
+
 // Test to create redfish virtual media with invalid transfer method - Negative
 func TestAccRedfishVirtualMedia_InvalidTransferMethod_Negative(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -622,6 +636,32 @@ func testAccRedfishResourceVirtualMediaConfig(testingInfo TestingServerCredentia
 	)
 }
 
+func testAccRedfishResourceVirtualMediaMediaTypeConfig(testingInfo TestingServerCredentials,
+	image string,
+	media_type string,
+) string {
+	return fmt.Sprintf(`
+
+		resource "redfish_virtual_media" "virtual_media" {
+
+		  redfish_server {
+			user = "%s"
+			password = "%s"
+			endpoint = "%s"
+			ssl_insecure = true
+		  }
+		  image = "%s"
+		  media_type = "%s"
+		}
+		`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		image,
+		media_type,
+	)
+}
+
 func testAccRedfishResourceVirtualMediaConfigServer5x(testingInfo TestingServerCredentials,
 	resource_name string,
 	image string,